@@ -0,0 +1,135 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) changePasswordHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.ChangePasswordDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.AccountService.ChangePassword(r.Context(), claims.ID, claims.SessionID, requestBody.Data); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) requestEmailChangeHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.ChangeEmailDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.AccountService.RequestEmailChange(r.Context(), claims.ID, requestBody.Data); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	// The change is only applied once the new address is confirmed.
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (app *Application) confirmEmailChangeHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.ConfirmEmailChangeDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	user, err := app.AccountService.ConfirmEmailChange(r.Context(), claims.ID, claims.SessionID, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.GetUserProfileSuccessResponse{
+		Data: mapDomainToApiUser(user),
+	})
+}
+
+func (app *Application) deleteAccountHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.PasswordConfirmationDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	deletion, err := app.AccountService.ScheduleDeletion(r.Context(), claims.ID, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	// Every session was revoked, including this one.
+	app.clearAuthCookies(w)
+
+	writeJSONResponse(w, http.StatusAccepted, apitypes.AccountDeletionSuccessResponse{
+		Data: apitypes.AccountDeletion{PurgeAt: deletion.PurgeAt},
+	})
+}
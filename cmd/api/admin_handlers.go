@@ -0,0 +1,97 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) updateUserRoleHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	userId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid user id"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.UpdateUserRoleDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	user, err := app.AdminService.UpdateUserRole(r.Context(), claims.ID, userId, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.UpdateUserRoleSuccessResponse{
+		Data: mapDomainToApiUser(user),
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) listModerationLogHandler(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := readLimitOffset(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	entries, err := app.AdminService.ListModerationLog(r.Context(), limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiEntries := make([]apitypes.ModerationLogEntry, len(entries))
+	for i, entry := range entries {
+		apiEntries[i] = mapDomainToApiModerationLogEntry(&entry)
+	}
+
+	response := apitypes.ListModerationLogSuccessResponse{
+		Data: apiEntries,
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func mapDomainToApiUserRole(role domain.Role) *apitypes.UserRole {
+	if role == "" {
+		return nil
+	}
+	apiRole := apitypes.UserRole(role)
+	return &apiRole
+}
+
+func mapDomainToApiModerationLogEntry(entry *domain.ModerationLogEntry) apitypes.ModerationLogEntry {
+	return apitypes.ModerationLogEntry{
+		Id:              entry.ID,
+		ActorId:         entry.ActorID,
+		ActorRole:       apitypes.UserRole(entry.ActorRole),
+		Action:          apitypes.ModerationLogEntryAction(entry.Action),
+		TargetType:      apitypes.ModerationLogEntryTargetType(entry.TargetType),
+		TargetId:        entry.TargetID,
+		TargetUserId:    entry.TargetUserID,
+		PreviousContent: entry.PreviousContent,
+		CreatedAt:       entry.CreatedAt,
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/floroz/go-social/cmd/middlewares"
 	"github.com/floroz/go-social/internal/apitypes"
@@ -24,25 +29,60 @@ import (
 )
 
 type Application struct {
-	Config         *Config
-	AuthService    interfaces.AuthService
-	UserService    interfaces.UserService
-	PostService    interfaces.PostService
-	CommentService interfaces.CommentService
+	Config                     *Config
+	CookiePolicy               *domain.CookiePolicy
+	AvatarPolicy               *domain.AvatarPolicy
+	AuthService                interfaces.AuthService
+	PasswordResetService       interfaces.PasswordResetService
+	EmailVerificationService   interfaces.EmailVerificationService
+	TwoFactorService           interfaces.TwoFactorService
+	PersonalAccessTokenService interfaces.PersonalAccessTokenService
+	AdminService               interfaces.AdminService
+	AccountService             interfaces.AccountService
+	OIDCService                interfaces.OIDCService
+	MagicLinkService           interfaces.MagicLinkService
+	ImpersonationService       interfaces.ImpersonationService
+	AvatarService              interfaces.AvatarService
+	FollowService              interfaces.FollowService
+	FeedService                interfaces.FeedService
+	TagService                 interfaces.TagService
+	BlockService               interfaces.BlockService
+	ReactionService            interfaces.ReactionService
+	UserService                interfaces.UserService
+	PostService                interfaces.PostService
+	CommentService             interfaces.CommentService
 }
 
 type Config struct {
 	Port string
 }
 
+// cookiePolicy returns the configured cookie policy, or the default one when none is set.
+func (app *Application) cookiePolicy() *domain.CookiePolicy {
+	if app.CookiePolicy == nil {
+		return domain.DefaultCookiePolicy()
+	}
+	return app.CookiePolicy
+}
+
 func (app *Application) Routes() http.Handler {
 	r := chi.NewRouter()
 
+	// Authentication and token management only accept sessions; resource routes also accept
+	// personal access tokens, limited to their scopes. Requests authenticated by cookie must
+	// also pass the CSRF check. Every request made while impersonating a user is audited, and
+	// sensitive routes reject impersonation sessions.
+	csrfMiddleware := middlewares.NewCSRFMiddleware(app.cookiePolicy(), refreshTokenMaxDuration)
+	auditMiddleware := middlewares.NewImpersonationAuditMiddleware(app.ImpersonationService)
+	authMiddleware := chi.Chain(middlewares.NewAuthMiddleware(app.AuthService), auditMiddleware, csrfMiddleware).Handler
+	tokenAuthMiddleware := chi.Chain(middlewares.NewTokenAuthMiddleware(app.AuthService, app.PersonalAccessTokenService), auditMiddleware, csrfMiddleware).Handler
+	sensitiveAuthMiddleware := chi.Chain(authMiddleware, middlewares.BlockImpersonation).Handler
+
 	// Add CORS middleware
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:5173", "http://127.0.0.1:5173"}, // Allow frontend dev server
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", tokenDeliveryHeader},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
@@ -55,6 +95,7 @@ func (app *Application) Routes() http.Handler {
 
 	r.Route("/api", func(apiRouter chi.Router) {
 		apiRouter.Get("/healthz", app.healthCheckHandler)
+		apiRouter.Get("/.well-known/jwks.json", app.jwksHandler)
 
 		// Serve Swagger UI and OpenAPI spec
 		apiRouter.Get("/docs", app.serveDocsHandler)
@@ -65,37 +106,130 @@ func (app *Application) Routes() http.Handler {
 			// Auth routes
 			v1Router.Route("/auth", func(authRouter chi.Router) {
 				authRouter.Post("/login", app.loginHandler)
+				authRouter.Post("/login/mfa", app.verifyMFAChallengeHandler)
 				authRouter.Post("/signup", app.signupHandler)
-				authRouter.Post("/logout", app.logoutHandler)
-				authRouter.Post("/refresh", app.refreshHandler)
+				authRouter.Post("/magic-link", app.requestMagicLinkHandler)
+				authRouter.Post("/magic-link/verify", app.magicLinkLoginHandler)
+				// Both read the refresh token from the cookie when the body does not carry it
+				authRouter.With(csrfMiddleware).Post("/logout", app.logoutHandler)
+				authRouter.With(csrfMiddleware).Post("/refresh", app.refreshHandler)
+				authRouter.Post("/password/forgot", app.forgotPasswordHandler)
+				authRouter.Post("/password/reset", app.resetPasswordHandler)
+				authRouter.Post("/verify-email", app.verifyEmailHandler)
+				authRouter.With(sensitiveAuthMiddleware).Post("/verify-email/resend", app.resendVerificationEmailHandler)
+
+				// Login with external OpenID Connect providers
+				authRouter.Get("/oidc", app.listOIDCProvidersHandler)
+				authRouter.Get("/oidc/{provider}", app.startOIDCLoginHandler)
+				authRouter.Get("/oidc/{provider}/callback", app.oidcCallbackHandler)
+
+				// Two-factor authentication settings of the authenticated user
+				authRouter.Route("/2fa", func(twoFactorRouter chi.Router) {
+					twoFactorRouter.Use(sensitiveAuthMiddleware)
+					twoFactorRouter.Get("/", app.getTwoFactorStatusHandler)
+					twoFactorRouter.Post("/enroll", app.enrollTwoFactorHandler)
+					twoFactorRouter.Post("/confirm", app.confirmTwoFactorHandler)
+					twoFactorRouter.Post("/disable", app.disableTwoFactorHandler)
+					twoFactorRouter.Post("/recovery-codes", app.regenerateRecoveryCodesHandler)
+				})
+
+				// Active sessions of the authenticated user
+				authRouter.Route("/sessions", func(sessionRouter chi.Router) {
+					sessionRouter.Use(sensitiveAuthMiddleware)
+					sessionRouter.Get("/", app.listSessionsHandler)
+					sessionRouter.Delete("/", app.revokeOtherSessionsHandler)
+					sessionRouter.Delete("/{id}", app.revokeSessionHandler)
+				})
 			})
 
 			// User routes
 			v1Router.Route("/users", func(userRouter chi.Router) {
-				userRouter.Use(middlewares.AuthMiddleware)
-				userRouter.Put("/", app.updateUserHandler)
-				userRouter.Get("/", app.getUserProfileHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Put("/", app.updateUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/", app.getUserProfileHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Put("/avatar", app.uploadAvatarHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Delete("/avatar", app.deleteAvatarHandler)
+
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/blocks", app.listBlockedUsersHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/mutes", app.listMutedUsersHandler)
+
+				// Public profiles of other users. The static routes above take precedence over
+				// usernames, which is why their names are reserved usernames.
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/{username}", app.getPublicUserProfileHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopePostsRead)).Get("/{username}/posts", app.listUserPostsHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Post("/{username}/follow", app.followUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Delete("/{username}/follow", app.unfollowUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/{username}/followers", app.listFollowersHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersRead)).Get("/{username}/following", app.listFollowingHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Post("/{username}/block", app.blockUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Delete("/{username}/block", app.unblockUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Post("/{username}/mute", app.muteUserHandler)
+				userRouter.With(tokenAuthMiddleware, requireScope(domain.ScopeUsersWrite)).Delete("/{username}/mute", app.unmuteUserHandler)
+
+				// Credentials can only be changed, and the account deleted, from a session with the
+				// current password, never while impersonating
+				userRouter.With(sensitiveAuthMiddleware).Put("/password", app.changePasswordHandler)
+				userRouter.With(sensitiveAuthMiddleware).Put("/email", app.requestEmailChangeHandler)
+				userRouter.With(sensitiveAuthMiddleware).Post("/email/confirm", app.confirmEmailChangeHandler)
+				userRouter.With(sensitiveAuthMiddleware).Delete("/", app.deleteAccountHandler)
+
+				// Personal access tokens can only be managed from a session. "tokens" is a reserved
+				// username, so the route never shadows the profile of a user.
+				userRouter.Route("/tokens", func(tokenRouter chi.Router) {
+					tokenRouter.Use(sensitiveAuthMiddleware)
+					tokenRouter.Get("/", app.listPersonalAccessTokensHandler)
+					tokenRouter.Post("/", app.createPersonalAccessTokenHandler)
+					tokenRouter.Delete("/{id}", app.revokePersonalAccessTokenHandler)
+				})
+			})
+
+			// Stored profile pictures, public so that they can be embedded as images
+			v1Router.Get("/media/avatars/*", app.serveAvatarHandler)
+
+			// Timelines
+			v1Router.With(tokenAuthMiddleware, requireScope(domain.ScopePostsRead)).Get("/feed/home", app.homeFeedHandler)
+
+			// Hashtags
+			v1Router.Route("/tags", func(tagRouter chi.Router) {
+				tagRouter.Use(tokenAuthMiddleware, requireScope(domain.ScopePostsRead))
+				tagRouter.Get("/trending", app.trendingTagsHandler)
+				tagRouter.Get("/{tag}/posts", app.listTagPostsHandler)
 			})
 
 			// Post routes
 			v1Router.Route("/posts", func(postRouter chi.Router) {
-				postRouter.Use(middlewares.AuthMiddleware)
-				postRouter.Post("/", app.createPostHandler)
-				postRouter.Delete("/{id}", app.deletePostHandler)
-				postRouter.Put("/{id}", app.updatePostHandler)
-				postRouter.Get("/{id}", app.getPostByIdHandler)
-				postRouter.Get("/", app.listPostsHandler)
+				postRouter.Use(tokenAuthMiddleware)
+				postRouter.With(requireScope(domain.ScopePostsWrite), app.requireVerifiedEmail(domain.VerifiedActionPosting)).Post("/", app.createPostHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite)).Delete("/{id}", app.deletePostHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite)).Put("/{id}", app.updatePostHandler)
+				postRouter.With(requireScope(domain.ScopePostsRead)).Get("/{id}", app.getPostByIdHandler)
+				postRouter.With(requireScope(domain.ScopePostsRead)).Get("/", app.listPostsHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite), app.requireVerifiedEmail(domain.VerifiedActionPosting)).Post("/{id}/repost", app.repostHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite)).Delete("/{id}/repost", app.unrepostHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite)).Put("/{id}/reactions/{type}", app.addPostReactionHandler)
+				postRouter.With(requireScope(domain.ScopePostsWrite)).Delete("/{id}/reactions/{type}", app.removePostReactionHandler)
 
 				// Comments sub-route
 				postRouter.Route("/{postId}/comments", func(commentRouter chi.Router) {
 					// Auth middleware is already applied by the parent /posts route
-					commentRouter.Post("/", app.createCommentHandler)
-					commentRouter.Put("/{id}", app.updateCommentHandler)
-					commentRouter.Delete("/{id}", app.deleteCommentHandler)
-					commentRouter.Get("/{id}", app.getCommentByIdHandler)
-					commentRouter.Get("/", app.listByPostIdHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsWrite), app.requireVerifiedEmail(domain.VerifiedActionCommenting)).Post("/", app.createCommentHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsWrite)).Put("/{id}", app.updateCommentHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsWrite)).Delete("/{id}", app.deleteCommentHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsRead)).Get("/{id}", app.getCommentByIdHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsRead)).Get("/", app.listByPostIdHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsWrite)).Put("/{id}/reactions/{type}", app.addCommentReactionHandler)
+					commentRouter.With(requireScope(domain.ScopeCommentsWrite)).Delete("/{id}/reactions/{type}", app.removeCommentReactionHandler)
 				})
 			})
+
+			// Admin routes, restricted by role and only available from a session of the admin
+			v1Router.Route("/admin", func(adminRouter chi.Router) {
+				adminRouter.Use(sensitiveAuthMiddleware)
+				adminRouter.With(middlewares.RequirePermission(domain.PermissionManageRoles)).Put("/users/{id}/role", app.updateUserRoleHandler)
+				adminRouter.With(middlewares.RequirePermission(domain.PermissionReadModerationLog)).Get("/moderation-log", app.listModerationLogHandler)
+				adminRouter.With(middlewares.RequirePermission(domain.PermissionImpersonateUsers)).Post("/users/{id}/impersonate", app.startImpersonationHandler)
+				adminRouter.With(middlewares.RequirePermission(domain.PermissionImpersonateUsers)).Delete("/impersonations/{id}", app.endImpersonationHandler)
+				adminRouter.With(middlewares.RequirePermission(domain.PermissionImpersonateUsers)).Get("/impersonation-log", app.listImpersonationLogHandler)
+			})
 		})
 	})
 
@@ -160,6 +294,30 @@ func handleErrors(w http.ResponseWriter, err error) {
 		writeJSONError(w, http.StatusConflict, err.Error(), errorcodes.CodeConflict, "")
 		return
 	}
+	if errors.Is(err, domain.ErrEmailNotVerified) {
+		writeJSONError(w, http.StatusForbidden, err.Error(), errorcodes.CodeEmailNotVerified, "")
+		return
+	}
+	if errors.Is(err, domain.ErrCannotFollowSelf) {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeCannotFollowSelf, "")
+		return
+	}
+	if errors.Is(err, domain.ErrAlreadyFollowing) {
+		writeJSONError(w, http.StatusConflict, err.Error(), errorcodes.CodeAlreadyFollowing, "")
+		return
+	}
+	if errors.Is(err, domain.ErrCannotBlockSelf) {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeCannotBlockSelf, "")
+		return
+	}
+	if errors.Is(err, domain.ErrBlocked) {
+		writeJSONError(w, http.StatusForbidden, err.Error(), errorcodes.CodeBlocked, "")
+		return
+	}
+	if errors.Is(err, domain.ErrAlreadyReposted) {
+		writeJSONError(w, http.StatusConflict, err.Error(), errorcodes.CodeAlreadyReposted, "")
+		return
+	}
 
 	// Then check for custom error types
 	switch e := err.(type) {
@@ -189,14 +347,89 @@ func handleErrors(w http.ResponseWriter, err error) {
 		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeBadRequest, "")
 	case *domain.NotFoundError:
 		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeNotFound, "")
+	case *domain.UnauthorizedError:
+		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeUnauthorized, "")
 	case *domain.ForbiddenError:
 		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeForbidden, "")
+	case *domain.TooManyRequestsError:
+		setRetryAfter(w, e.RetryAfter)
+		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeTooManyRequests, "")
+	case *domain.PayloadTooLargeError:
+		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodePayloadTooLarge, "")
+	case *domain.UnsupportedMediaTypeError:
+		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeUnsupportedMedia, "")
+	case *domain.AccountLockedError:
+		setRetryAfter(w, e.RetryAfter)
+		writeJSONError(w, e.StatusCode, e.Error(), errorcodes.CodeAccountLocked, "")
 	default:
 		// Fallback for other unknown errors
 		writeJSONError(w, http.StatusInternalServerError, "An unexpected internal server error occurred.", errorcodes.CodeInternalServerError, "")
 	}
 }
 
+// setRetryAfter tells the client how many seconds to wait before retrying.
+func setRetryAfter(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+}
+
+// readLimitOffset reads the optional "limit" and "offset" query parameters. Missing
+// parameters are zero, leaving the defaults to the services.
+func readLimitOffset(r *http.Request) (int, int, error) {
+	limit, err := readLimit(r)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	offset := 0
+	if value := r.URL.Query().Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil {
+			return 0, 0, domain.NewBadRequestError("invalid offset")
+		}
+	}
+
+	return limit, offset, nil
+}
+
+// readPage reads the pagination parameters of a list: the opaque cursor of the page, the
+// limit and the deprecated offset.
+func readPage(r *http.Request) (string, int, int, error) {
+	limit, offset, err := readLimitOffset(r)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return r.URL.Query().Get("cursor"), limit, offset, nil
+}
+
+// setNextLink advertises the next page of a list in an RFC 8288 Link header: the request URL
+// with the cursor of the next page in place of its own cursor or offset. Nothing is set on the
+// last page.
+func setNextLink(w http.ResponseWriter, r *http.Request, nextCursor string) {
+	if nextCursor == "" {
+		return
+	}
+
+	query := r.URL.Query()
+	query.Del("offset")
+	query.Set("cursor", nextCursor)
+	next := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+}
+
+func readLimit(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, domain.NewBadRequestError("invalid limit")
+	}
+
+	return limit, nil
+}
+
 func getUserClaimFromContext(ctx context.Context) (*domain.UserClaims, bool) {
 	claims, ok := ctx.Value(middlewares.ContextKeyUser).(*domain.UserClaims)
 	return claims, ok
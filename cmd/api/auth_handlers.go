@@ -1,13 +1,15 @@
 package api
 
 import (
+	"errors"
+	"io"
+	"net"
 	"net/http"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/floroz/go-social/cmd/middlewares"
 	"github.com/floroz/go-social/internal/apitypes"
 	"github.com/floroz/go-social/internal/domain"
-	"github.com/floroz/go-social/internal/env"
 	"github.com/floroz/go-social/internal/errorcodes"
 	"github.com/rs/zerolog/log"
 )
@@ -17,6 +19,13 @@ const (
 	refreshTokenMaxDuration = 24 * time.Hour
 )
 
+// Clients without cookies send "X-Token-Delivery: body" to receive the refresh token in the
+// body of the login and refresh responses.
+const (
+	tokenDeliveryHeader = "X-Token-Delivery"
+	tokenDeliveryBody   = "body"
+)
+
 func (app *Application) signupHandler(w http.ResponseWriter, r *http.Request) {
 	// TODO: how do we protect this endpoint? This is a public endpoint, but we should consider rate limiting to protect against DDoS attacks
 
@@ -39,32 +48,16 @@ func (app *Application) signupHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessToken, err := app.AuthService.GenerateJWTToken(user, accessTokenMaxDuration)
-	if err != nil {
+	if _, _, err := app.startSession(w, r, user); err != nil {
 		handleErrors(w, err)
 		return
 	}
 
-	refreshToken, err := app.AuthService.GenerateJWTToken(user, refreshTokenMaxDuration)
-	if err != nil {
-		handleErrors(w, err)
-		return
+	// The account is usable right away; a failed email can be retried through the resend endpoint.
+	if err := app.EmailVerificationService.SendVerificationEmail(r.Context(), user); err != nil {
+		log.Error().Err(err).Msg("failed to send verification email on signup")
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    accessToken,
-		HttpOnly: true,
-		Expires:  time.Now().Add(15 * time.Minute),
-	})
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		HttpOnly: true,
-		Expires:  time.Now().Add(24 * time.Hour),
-	})
-
 	// Wrap the user object in the standardized response structure
 	// Map domain.User to apitypes.User
 	apiUser := apitypes.User{
@@ -116,19 +109,49 @@ func (app *Application) loginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := app.AuthService.Login(r.Context(), requestBody.Data)
+	user, err := app.AuthService.Login(r.Context(), requestBody.Data, clientIP(r))
 	if err != nil {
 		handleErrors(w, err)
 		return
 	}
 
-	accessToken, err := app.AuthService.GenerateJWTToken(user, accessTokenMaxDuration)
+	app.authenticatedLogin(w, r, user)
+}
+
+// authenticatedLogin continues a login once the first factor is verified: it either starts
+// the two-factor challenge or the session.
+func (app *Application) authenticatedLogin(w http.ResponseWriter, r *http.Request, user *domain.User) {
+	mfaEnabled, err := app.TwoFactorService.IsEnabled(r.Context(), user.ID)
 	if err != nil {
 		handleErrors(w, err)
 		return
 	}
 
-	refreshToken, err := app.AuthService.GenerateJWTToken(user, refreshTokenMaxDuration)
+	// With two-factor authentication, the session only starts once the challenge is completed.
+	if mfaEnabled {
+		challenge, err := app.TwoFactorService.StartChallenge(r.Context(), user.ID)
+		if err != nil {
+			handleErrors(w, err)
+			return
+		}
+
+		response := apitypes.MFAChallengeSuccessResponse{
+			Data: apitypes.MFAChallenge{
+				MfaRequired:    true,
+				ChallengeToken: challenge.Token,
+				ExpiresAt:      challenge.ExpiresAt,
+			},
+		}
+		writeJSONResponse(w, http.StatusAccepted, response)
+		return
+	}
+
+	app.completeLogin(w, r, user)
+}
+
+// completeLogin starts a session for a fully authenticated user and writes the login response.
+func (app *Application) completeLogin(w http.ResponseWriter, r *http.Request, user *domain.User) {
+	accessToken, refreshToken, err := app.startSession(w, r, user)
 	if err != nil {
 		handleErrors(w, err)
 		return
@@ -144,26 +167,26 @@ func (app *Application) loginHandler(w http.ResponseWriter, r *http.Request) {
 	// The updated LastLogin will be reflected in subsequent requests for user profile.
 	// It's not part of the LoginResponse, so no need to re-fetch user here for this response.
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    accessToken,
-		HttpOnly: true,
-		Expires:  time.Now().Add(15 * time.Minute),
-		Path:     "/",
-	})
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		HttpOnly: true,
-		Expires:  time.Now().Add(24 * time.Hour),
-		Path:     "/",
-	})
+	writeTokensResponse(w, accessToken, refreshToken, wantsTokensInBody(r))
+}
+
+// wantsTokensInBody reports whether the client opted in to receive the refresh token in the
+// response body, with the X-Token-Delivery header.
+func wantsTokensInBody(r *http.Request) bool {
+	return r.Header.Get(tokenDeliveryHeader) == tokenDeliveryBody
+}
 
+// writeTokensResponse returns the access token in the body too, for clients that cannot use
+// the cookies and authenticate with the Authorization header instead. The refresh token is
+// only returned to clients that opt in, so that page scripts cannot read it otherwise.
+func writeTokensResponse(w http.ResponseWriter, accessToken, refreshToken string, includeRefreshToken bool) {
 	// Construct the API response containing the token
 	apiLoginResponse := apitypes.LoginResponse{
 		Token: accessToken, // Use the generated access token
 	}
+	if includeRefreshToken {
+		apiLoginResponse.RefreshToken = &refreshToken
+	}
 
 	// Wrap the login response in the standard success wrapper
 	response := apitypes.LoginSuccessResponse{
@@ -174,74 +197,131 @@ func (app *Application) loginHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func (app *Application) logoutHandler(w http.ResponseWriter, r *http.Request) {
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    "",
-		HttpOnly: true,
-		Expires:  time.Unix(0, 0),
-		Path:     "/",
-	})
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    "",
-		HttpOnly: true,
-		Expires:  time.Unix(0, 0),
-		Path:     "/",
-	})
+	refreshToken, _, err := readRefreshToken(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	if refreshToken != "" {
+		if err := app.AuthService.RevokeRefreshToken(r.Context(), refreshToken); err != nil {
+			handleErrors(w, err)
+			return
+		}
+	}
+
+	app.clearAuthCookies(w)
 
 	w.WriteHeader(http.StatusOK)
 }
 
 func (app *Application) refreshHandler(w http.ResponseWriter, r *http.Request) {
-	cookie, err := r.Cookie("refresh_token")
+	presentedToken, fromBody, err := readRefreshToken(r)
 	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+	if presentedToken == "" {
 		writeJSONError(w, http.StatusUnauthorized, "missing refresh token", errorcodes.CodeUnauthorized, "")
 		return
 	}
 
-	token, err := jwt.ParseWithClaims(cookie.Value, &domain.UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, http.ErrAbortHandler
-		}
-		return []byte(env.GetJWTSecret()), nil
-	})
+	// The presented token is consumed; a new one from the same family replaces it.
+	user, refreshToken, err := app.AuthService.RotateRefreshToken(r.Context(), presentedToken, refreshTokenMaxDuration)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
 
-	if err != nil || !token.Valid {
-		writeJSONError(w, http.StatusUnauthorized, "invalid refresh token", errorcodes.CodeUnauthorized, "")
+	accessToken, err := app.AuthService.GenerateJWTToken(user, refreshToken.FamilyID, accessTokenMaxDuration)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to generate access token", errorcodes.CodeInternalServerError, "")
 		return
 	}
 
-	claims, ok := token.Claims.(*domain.UserClaims)
+	app.setAuthCookies(w, accessToken, refreshToken.Token)
 
-	if !ok || !token.Valid {
-		writeJSONError(w, http.StatusUnauthorized, "invalid refresh token claims", errorcodes.CodeUnauthorized, "")
-		return
+	// Clients that sent the refresh token in the body keep it there.
+	writeTokensResponse(w, accessToken, refreshToken.Token, fromBody || wantsTokensInBody(r))
+}
+
+// readRefreshToken returns the refresh token sent in the request body or, when the body is
+// empty, in the refresh_token cookie, and whether it came in the body. The body wins when both
+// are sent, since a client only puts the token there on purpose. An empty string means no
+// token was sent.
+func readRefreshToken(r *http.Request) (string, bool, error) {
+	var requestBody struct {
+		Data *domain.RefreshTokenDTO `json:"data"`
+	}
+
+	err := readJSON(r.Body, &requestBody)
+	switch {
+	case errors.Is(err, io.EOF):
+		// No body: cookie-based clients
+	case err != nil:
+		return "", false, domain.NewBadRequestError(err.Error())
+	case requestBody.Data == nil:
+		return "", false, domain.NewBadRequestError("missing request data")
+	default:
+		return requestBody.Data.RefreshToken, true, nil
+	}
+
+	if cookie, err := r.Cookie(domain.RefreshTokenCookie); err == nil {
+		return cookie.Value, false, nil
+	}
+
+	return "", false, nil
+}
+
+// startSession creates a session for the authenticated user, sets the auth cookies
+// and returns the access and refresh tokens.
+func (app *Application) startSession(w http.ResponseWriter, r *http.Request, user *domain.User) (string, string, error) {
+	client := &domain.SessionClient{
+		UserAgent: r.UserAgent(),
+		IPAddress: clientIP(r),
 	}
 
-	user := &domain.User{
-		ID:        claims.ID,
-		Username:  claims.Username,
-		FirstName: claims.FirstName,
-		LastName:  claims.LastName,
-		Email:     claims.Email,
-		CreatedAt: claims.CreatedAt,
-		UpdatedAt: claims.UpdatedAt,
+	refreshToken, err := app.AuthService.StartSession(r.Context(), user.ID, client, refreshTokenMaxDuration)
+	if err != nil {
+		return "", "", err
 	}
 
-	accessToken, err := app.AuthService.GenerateJWTToken(user, accessTokenMaxDuration)
+	accessToken, err := app.AuthService.GenerateJWTToken(user, refreshToken.FamilyID, accessTokenMaxDuration)
 	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "failed to generate access token", errorcodes.CodeInternalServerError, "")
-		return
+		return "", "", err
 	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    accessToken,
-		HttpOnly: true,
-		Expires:  time.Now().Add(15 * time.Minute),
-		Path:     "/",
-	})
+	app.setAuthCookies(w, accessToken, refreshToken.Token)
 
-	w.WriteHeader(http.StatusOK)
+	return accessToken, refreshToken.Token, nil
+}
+
+// setAuthCookies stores the tokens in cookies for browsers, along with a fresh CSRF token
+// that scripts of the frontend echo on state-changing requests.
+func (app *Application) setAuthCookies(w http.ResponseWriter, accessToken, refreshToken string) {
+	cookiePolicy := app.cookiePolicy()
+
+	http.SetCookie(w, cookiePolicy.NewCookie(domain.AccessTokenCookie, accessToken, time.Now().Add(accessTokenMaxDuration), true))
+	http.SetCookie(w, cookiePolicy.NewCookie(domain.RefreshTokenCookie, refreshToken, time.Now().Add(refreshTokenMaxDuration), true))
+
+	if err := middlewares.IssueCSRFToken(w, cookiePolicy, refreshTokenMaxDuration); err != nil {
+		log.Error().Err(err).Msg("failed to issue CSRF token")
+	}
+}
+
+func (app *Application) clearAuthCookies(w http.ResponseWriter) {
+	cookiePolicy := app.cookiePolicy()
+
+	http.SetCookie(w, cookiePolicy.ExpiredCookie(domain.AccessTokenCookie, true))
+	http.SetCookie(w, cookiePolicy.ExpiredCookie(domain.RefreshTokenCookie, true))
+	http.SetCookie(w, cookiePolicy.ExpiredCookie(domain.CSRFTokenCookie, false))
+}
+
+// clientIP returns the client address, which middleware.RealIP has already resolved
+// from proxy headers when present.
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
 }
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+const (
+	// avatarFormField is the multipart form field holding the uploaded image.
+	avatarFormField = "avatar"
+	// avatarFormOverhead leaves room for the multipart headers and boundaries on top of the
+	// image itself.
+	avatarFormOverhead = 64 << 10
+	// avatarCacheControl lets clients and proxies keep thumbnails forever: every upload is
+	// stored under new keys, so a stored file never changes.
+	avatarCacheControl = "public, max-age=31536000, immutable"
+)
+
+// avatarPolicy returns the configured avatar policy, or the default one when none is set.
+func (app *Application) avatarPolicy() *domain.AvatarPolicy {
+	if app.AvatarPolicy == nil {
+		return domain.DefaultAvatarPolicy()
+	}
+	return app.AvatarPolicy
+}
+
+func (app *Application) uploadAvatarHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	maxBytes := app.avatarPolicy().MaxBytes
+	tooLargeErr := domain.NewPayloadTooLargeError(fmt.Sprintf("image must not be larger than %d bytes", maxBytes))
+	if r.ContentLength > maxBytes+avatarFormOverhead {
+		handleErrors(w, tooLargeErr)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes+avatarFormOverhead)
+
+	file, _, err := r.FormFile(avatarFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleErrors(w, tooLargeErr)
+			return
+		}
+		handleErrors(w, domain.NewBadRequestError(fmt.Sprintf("missing %q file in multipart form", avatarFormField)))
+		return
+	}
+	defer file.Close()
+
+	thumbnails, err := app.AvatarService.Upload(r.Context(), claims.ID, file)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	// The largest thumbnail is the profile picture.
+	avatar := apitypes.Avatar{
+		Thumbnails: make([]apitypes.AvatarThumbnail, len(thumbnails)),
+	}
+	largest := 0
+	for i, thumbnail := range thumbnails {
+		avatar.Thumbnails[i] = apitypes.AvatarThumbnail{Size: thumbnail.Size, Url: thumbnail.URL}
+		if thumbnail.Size > largest {
+			largest = thumbnail.Size
+			avatar.ProfilePictureUrl = thumbnail.URL
+		}
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.UploadAvatarSuccessResponse{Data: avatar})
+}
+
+func (app *Application) deleteAvatarHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.AvatarService.Delete(r.Context(), claims.ID); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveAvatarHandler serves stored thumbnails. It needs no authentication, so that profile
+// pictures can be used as the source of an image element.
+func (app *Application) serveAvatarHandler(w http.ResponseWriter, r *http.Request) {
+	blob, err := app.AvatarService.Open(r.Context(), r.PathValue("*"))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+	defer blob.Body.Close()
+
+	w.Header().Set("Content-Type", blob.ContentType)
+	w.Header().Set("Cache-Control", avatarCacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", blob.ModTime, blob.Body)
+}
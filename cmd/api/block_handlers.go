@@ -0,0 +1,127 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+func (app *Application) blockUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.BlockService.Block(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) unblockUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.BlockService.Unblock(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) listBlockedUsersHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	limit, offset, err := readLimitOffset(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	users, err := app.BlockService.ListBlocked(r.Context(), claims.ID, limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.ListBlockedUsersSuccessResponse{Data: mapDomainToApiBlockedUsers(users)})
+}
+
+func (app *Application) muteUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.BlockService.Mute(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) unmuteUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.BlockService.Unmute(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) listMutedUsersHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	limit, offset, err := readLimitOffset(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	users, err := app.BlockService.ListMuted(r.Context(), claims.ID, limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.ListBlockedUsersSuccessResponse{Data: mapDomainToApiBlockedUsers(users)})
+}
+
+func mapDomainToApiBlockedUsers(users []domain.BlockedUser) []apitypes.BlockedUser {
+	apiUsers := make([]apitypes.BlockedUser, len(users))
+	for i, user := range users {
+		apiUsers[i] = apitypes.BlockedUser{
+			Id:                user.ID,
+			Username:          user.Username,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			ProfilePictureUrl: optionalString(user.ProfilePictureURL),
+			CreatedAt:         user.CreatedAt,
+		}
+	}
+	return apiUsers
+}
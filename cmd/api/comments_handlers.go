@@ -67,6 +67,7 @@ func mapDomainToApiComment(comment *domain.Comment) apitypes.Comment {
 		CreatedAt: &comment.CreatedAt, // Pointer
 		UpdatedAt: &comment.UpdatedAt, // Pointer
 	}
+	apiComment.ReactionCounts, apiComment.MyReactions = mapDomainToApiReactions(comment.Reactions)
 	// Add mapping for other fields if they exist in apitypes.Comment
 	return apiComment
 }
@@ -136,6 +137,13 @@ func (app *Application) updateCommentHandler(w http.ResponseWriter, r *http.Requ
 }
 
 func (app *Application) getCommentByIdHandler(w http.ResponseWriter, r *http.Request) {
+	postId, err := strconv.Atoi(r.PathValue("postId"))
+
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid post id"))
+		return
+	}
+
 	commentId, err := strconv.Atoi(r.PathValue("id"))
 
 	if err != nil {
@@ -143,7 +151,13 @@ func (app *Application) getCommentByIdHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	comment, err := app.CommentService.GetByID(r.Context(), int64(commentId))
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	comment, err := app.CommentService.GetByID(r.Context(), claims.ID, int64(postId), int64(commentId))
 
 	if err != nil {
 		handleErrors(w, err)
@@ -169,26 +183,19 @@ func (app *Application) listByPostIdHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	limitQuery := r.URL.Query().Get("limit")
-	offsetQuery := r.URL.Query().Get("offset")
-
-	limit, err := strconv.Atoi(limitQuery)
-
-	if err != nil {
-		limit = 10
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
 	}
 
-	offset, err := strconv.Atoi(offsetQuery)
-
+	cursor, limit, offset, err := readPage(r)
 	if err != nil {
-		offset = 0
-	}
-
-	if limitQuery == "" {
-		limit = 10
+		handleErrors(w, err)
+		return
 	}
 
-	comments, err := app.CommentService.ListByPostID(r.Context(), int64(postId), limit, offset)
+	page, err := app.CommentService.ListByPostID(r.Context(), claims.ID, int64(postId), cursor, limit, offset)
 
 	if err != nil {
 		handleErrors(w, err)
@@ -196,14 +203,15 @@ func (app *Application) listByPostIdHandler(w http.ResponseWriter, r *http.Reque
 	}
 
 	// Map domain comments to API comments
-	apiComments := mapDomainToApiComments(comments)
+	apiComments := mapDomainToApiComments(page.Comments)
 
 	// Wrap in success response
 	response := apitypes.ListCommentsSuccessResponse{
-		Data: apiComments,
-		// Add metadata here if implementing pagination
+		Data:       apiComments,
+		NextCursor: optionalString(page.NextCursor),
 	}
 
+	setNextLink(w, r, page.NextCursor)
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
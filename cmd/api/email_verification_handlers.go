@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) verifyEmailHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.VerifyEmailDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.EmailVerificationService.VerifyEmail(r.Context(), requestBody.Data); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) resendVerificationEmailHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.EmailVerificationService.ResendVerificationEmail(r.Context(), claims.ID); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// requireVerifiedEmail rejects the request when the email verification policy restricts
+// the action to users with a verified email address. It must run after the auth middleware.
+func (app *Application) requireVerifiedEmail(action domain.VerifiedAction) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := getUserClaimFromContext(r.Context())
+			if !ok {
+				handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+				return
+			}
+
+			if err := app.EmailVerificationService.RequireVerified(r.Context(), claims.ID, action); err != nil {
+				handleErrors(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+func (app *Application) homeFeedHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	limit, err := readLimit(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	page, err := app.FeedService.Home(r.Context(), claims.ID, r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	setNextLink(w, r, page.NextCursor)
+	writeJSONResponse(w, http.StatusOK, apitypes.HomeFeedSuccessResponse{
+		Data:       mapDomainToApiPosts(page.Posts),
+		NextCursor: optionalString(page.NextCursor),
+	})
+}
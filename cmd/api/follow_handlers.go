@@ -0,0 +1,93 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+func (app *Application) followUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.FollowService.Follow(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) unfollowUserHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.FollowService.Unfollow(r.Context(), claims.ID, r.PathValue("username")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (app *Application) listFollowersHandler(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, offset, err := readPage(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	page, err := app.FollowService.ListFollowers(r.Context(), r.PathValue("username"), cursor, limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	setNextLink(w, r, page.NextCursor)
+	writeJSONResponse(w, http.StatusOK, apitypes.ListFollowsSuccessResponse{
+		Data:       mapDomainToApiFollowUsers(page.Users),
+		NextCursor: optionalString(page.NextCursor),
+	})
+}
+
+func (app *Application) listFollowingHandler(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, offset, err := readPage(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	page, err := app.FollowService.ListFollowing(r.Context(), r.PathValue("username"), cursor, limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	setNextLink(w, r, page.NextCursor)
+	writeJSONResponse(w, http.StatusOK, apitypes.ListFollowsSuccessResponse{
+		Data:       mapDomainToApiFollowUsers(page.Users),
+		NextCursor: optionalString(page.NextCursor),
+	})
+}
+
+func mapDomainToApiFollowUsers(users []domain.FollowUser) []apitypes.FollowUser {
+	apiUsers := make([]apitypes.FollowUser, len(users))
+	for i, user := range users {
+		apiUsers[i] = apitypes.FollowUser{
+			Id:                user.ID,
+			Username:          user.Username,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			ProfilePictureUrl: optionalString(user.ProfilePictureURL),
+			FollowedAt:        user.FollowedAt,
+		}
+	}
+	return apiUsers
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+	"github.com/google/uuid"
+)
+
+// startImpersonationHandler returns an access token acting as another user. The token is
+// only returned in the body: setting it as a cookie would replace the session of the admin.
+func (app *Application) startImpersonationHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	userId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid user id"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.StartImpersonationDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	session, subject, err := app.ImpersonationService.Start(r.Context(), claims.ID, userId, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	actor := &domain.Actor{ID: claims.ID, Username: claims.Username}
+	accessToken, err := app.AuthService.GenerateImpersonationToken(subject, actor, session.ID, time.Until(session.ExpiresAt))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.StartImpersonationSuccessResponse{
+		Data: apitypes.Impersonation{
+			ImpersonationId: session.ID,
+			AccessToken:     accessToken,
+			ExpiresAt:       session.ExpiresAt,
+			User:            mapDomainToApiUser(subject),
+		},
+	}
+
+	writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (app *Application) endImpersonationHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	impersonationId := r.PathValue("id")
+	if _, err := uuid.Parse(impersonationId); err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid impersonation id"))
+		return
+	}
+
+	if err := app.ImpersonationService.End(r.Context(), claims.ID, impersonationId); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusNoContent, nil)
+}
+
+func (app *Application) listImpersonationLogHandler(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := readLimitOffset(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	entries, err := app.ImpersonationService.ListAuditLog(r.Context(), limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiEntries := make([]apitypes.ImpersonationAuditEntry, len(entries))
+	for i, entry := range entries {
+		apiEntries[i] = apitypes.ImpersonationAuditEntry{
+			Id:              entry.ID,
+			ImpersonationId: entry.ImpersonationID,
+			ActorId:         entry.ActorID,
+			SubjectId:       entry.SubjectID,
+			Method:          entry.Method,
+			Path:            entry.Path,
+			Status:          entry.Status,
+			CreatedAt:       entry.CreatedAt,
+		}
+	}
+
+	response := apitypes.ListImpersonationLogSuccessResponse{
+		Data: apiEntries,
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
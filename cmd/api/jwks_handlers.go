@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+)
+
+// jwksCacheControl lets verifiers cache the keys. A rotation must keep the previous key for
+// longer than this, on top of the access token lifetime.
+const jwksCacheControl = "public, max-age=300"
+
+// jwksHandler publishes the public keys that verify access tokens, so that other services
+// can verify them without sharing a secret.
+func (app *Application) jwksHandler(w http.ResponseWriter, r *http.Request) {
+	jwks := app.AuthService.JWKS()
+
+	response := apitypes.JSONWebKeySet{
+		Keys: make([]apitypes.JSONWebKey, len(jwks.Keys)),
+	}
+	for i, key := range jwks.Keys {
+		response.Keys[i] = apitypes.JSONWebKey{
+			Kty: key.KeyType,
+			Kid: key.KeyID,
+			Use: key.Use,
+			Alg: apitypes.JSONWebKeyAlg(key.Algorithm),
+			Crv: optionalString(key.Curve),
+			X:   optionalString(key.X),
+			N:   optionalString(key.N),
+			E:   optionalString(key.E),
+		}
+	}
+
+	w.Header().Set("Cache-Control", jwksCacheControl)
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) requestMagicLinkHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.MagicLinkRequestDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.MagicLinkService.RequestLink(r.Context(), requestBody.Data, clientIP(r)); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	// Always accepted, whether or not the email belongs to an account.
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// magicLinkLoginHandler logs in with the token of an emailed link, like loginHandler does
+// with a password.
+func (app *Application) magicLinkLoginHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.MagicLinkLoginDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	user, err := app.MagicLinkService.Redeem(r.Context(), requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	app.authenticatedLogin(w, r, user)
+}
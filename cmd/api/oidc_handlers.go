@@ -0,0 +1,108 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/env"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// listOIDCProvidersHandler lists the external providers the login page can offer.
+func (app *Application) listOIDCProvidersHandler(w http.ResponseWriter, r *http.Request) {
+	response := apitypes.OIDCProvidersSuccessResponse{
+		Data: app.OIDCService.Providers(),
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+// startOIDCLoginHandler sends the browser to the provider. The state also goes into a cookie,
+// so that the callback only completes the login in the browser that started it.
+func (app *Application) startOIDCLoginHandler(w http.ResponseWriter, r *http.Request) {
+	login, err := app.OIDCService.StartLogin(r.Context(), chi.URLParam(r, "provider"))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	http.SetCookie(w, app.oidcStateCookie(login))
+	http.Redirect(w, r, login.AuthURL, http.StatusFound)
+}
+
+// oidcCallbackHandler completes the login when the provider sends the browser back, then
+// sends it to the frontend: logged in, or to the second factor challenge when it is enabled.
+func (app *Application) oidcCallbackHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// The state cookie is single-use, whatever the outcome.
+	stateCookie, _ := r.Cookie(domain.OIDCStateCookie)
+	http.SetCookie(w, app.oidcStateCookie(nil))
+
+	if providerErr := query.Get("error"); providerErr != "" {
+		log.Info().Str("error", providerErr).Str("description", query.Get("error_description")).Msg("identity provider refused the login")
+		handleErrors(w, domain.NewUnauthorizedError("the identity provider did not authenticate the user"))
+		return
+	}
+
+	state := query.Get("state")
+	if stateCookie == nil || state == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(state)) != 1 {
+		handleErrors(w, domain.NewUnauthorizedError("login was not started in this browser"))
+		return
+	}
+
+	user, err := app.OIDCService.CompleteLogin(r.Context(), chi.URLParam(r, "provider"), state, query.Get("code"))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	mfaEnabled, err := app.TwoFactorService.IsEnabled(r.Context(), user.ID)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	if mfaEnabled {
+		challenge, err := app.TwoFactorService.StartChallenge(r.Context(), user.ID)
+		if err != nil {
+			handleErrors(w, err)
+			return
+		}
+
+		// The token stays out of the URL, where it would end up in the browser history and
+		// server logs.
+		http.SetCookie(w, app.mfaChallengeCookie(challenge))
+		http.Redirect(w, r, env.GetAppURL()+"/login/mfa", http.StatusFound)
+		return
+	}
+
+	if _, _, err := app.startSession(w, r, user); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	if err := app.UserService.UpdateLastLogin(r.Context(), user.ID); err != nil {
+		log.Error().Err(err).Msg("failed to update last login")
+	}
+
+	http.Redirect(w, r, env.GetAppURL()+"/", http.StatusFound)
+}
+
+// oidcStateCookie returns the cookie binding a login to the browser, or the cookie deleting
+// it when login is nil. The provider sends the browser back with a cross-site navigation,
+// which strict cookies do not survive.
+func (app *Application) oidcStateCookie(login *domain.OIDCLogin) *http.Cookie {
+	cookiePolicy := *app.cookiePolicy()
+	if cookiePolicy.SameSite == http.SameSiteStrictMode {
+		cookiePolicy.SameSite = http.SameSiteLaxMode
+	}
+
+	if login == nil {
+		return cookiePolicy.ExpiredCookie(domain.OIDCStateCookie, true)
+	}
+	return cookiePolicy.NewCookie(domain.OIDCStateCookie, login.State, login.ExpiresAt, true)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) forgotPasswordHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.ForgotPasswordDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.PasswordResetService.RequestReset(r.Context(), requestBody.Data); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	// Always accepted, whether or not the email belongs to an account.
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (app *Application) resetPasswordHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.ResetPasswordDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if err := app.PasswordResetService.ResetPassword(r.Context(), requestBody.Data); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
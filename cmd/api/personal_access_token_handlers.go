@@ -0,0 +1,136 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+func (app *Application) createPersonalAccessTokenHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.CreatePersonalAccessTokenDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	token, err := app.PersonalAccessTokenService.Create(r.Context(), claims.ID, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiToken := mapDomainToApiPersonalAccessToken(token)
+	response := apitypes.CreatePersonalAccessTokenSuccessResponse{
+		Data: apitypes.CreatedPersonalAccessToken{
+			Id:         apiToken.Id,
+			Name:       apiToken.Name,
+			Scopes:     apiToken.Scopes,
+			ExpiresAt:  apiToken.ExpiresAt,
+			LastUsedAt: apiToken.LastUsedAt,
+			CreatedAt:  apiToken.CreatedAt,
+			Token:      token.Token,
+		},
+	}
+
+	writeJSONResponse(w, http.StatusCreated, response)
+}
+
+func (app *Application) listPersonalAccessTokensHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	personalAccessTokens, err := app.PersonalAccessTokenService.List(r.Context(), claims.ID)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiTokens := make([]apitypes.PersonalAccessToken, len(personalAccessTokens))
+	for i, token := range personalAccessTokens {
+		apiTokens[i] = mapDomainToApiPersonalAccessToken(&token)
+	}
+
+	response := apitypes.ListPersonalAccessTokensSuccessResponse{
+		Data: apiTokens,
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) revokePersonalAccessTokenHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	tokenId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid id"))
+		return
+	}
+
+	if err := app.PersonalAccessTokenService.Revoke(r.Context(), claims.ID, tokenId); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusNoContent, nil)
+}
+
+// requireScope rejects requests made with a personal access token that was not granted the
+// scope. Session-authenticated requests carry every scope. It must run after the auth middleware.
+func requireScope(scope domain.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := getUserClaimFromContext(r.Context())
+			if !ok {
+				handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+				return
+			}
+
+			if !claims.HasScope(scope) {
+				handleErrors(w, domain.NewForbiddenError("token is missing the "+string(scope)+" scope"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func mapDomainToApiPersonalAccessToken(token *domain.PersonalAccessToken) apitypes.PersonalAccessToken {
+	scopes := make([]apitypes.PersonalAccessTokenScope, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = apitypes.PersonalAccessTokenScope(scope)
+	}
+
+	return apitypes.PersonalAccessToken{
+		Id:         token.ID,
+		Name:       token.Name,
+		Scopes:     scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
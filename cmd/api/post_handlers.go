@@ -30,6 +30,7 @@ func (app *Application) createPostHandler(w http.ResponseWriter, r *http.Request
 		EditablePostFields: domain.EditablePostFields{
 			Content: requestBody.Data.Content,
 		},
+		QuotedPostID: requestBody.Data.QuotedPostId,
 	}
 
 	post, err := app.PostService.Create(r.Context(), claims.ID, domainDTO)
@@ -58,10 +59,32 @@ func mapDomainToApiPost(post *domain.Post) apitypes.Post {
 		CreatedAt: &post.CreatedAt, // Pointer
 		UpdatedAt: &post.UpdatedAt, // Pointer
 	}
+	apiPost.ReactionCounts, apiPost.MyReactions = mapDomainToApiReactions(post.Reactions)
+	kind := apitypes.PostKind(post.Kind)
+	apiPost.Kind = &kind
+	if post.ReferencedPostID != nil {
+		apiPost.ReferencedPost = mapDomainToApiReferencedPost(*post.ReferencedPostID, post.ReferencedPost)
+	}
 	// Add mapping for other fields if they exist in apitypes.Post (e.g., author username)
 	return apiPost
 }
 
+// mapDomainToApiReferencedPost maps the post shared by a repost or quote post, or a tombstone
+// when the post is not available.
+func mapDomainToApiReferencedPost(id int64, post *domain.Post) *apitypes.ReferencedPost {
+	deleted := post == nil
+	referenced := &apitypes.ReferencedPost{Id: &id, Deleted: &deleted}
+	if post != nil {
+		kind := apitypes.ReferencedPostKind(post.Kind)
+		referenced.UserId = &post.UserID
+		referenced.Content = &post.Content
+		referenced.Kind = &kind
+		referenced.CreatedAt = &post.CreatedAt
+		referenced.UpdatedAt = &post.UpdatedAt
+	}
+	return referenced
+}
+
 // Helper function to map slice of domain.Post to slice of apitypes.Post
 func mapDomainToApiPosts(posts []domain.Post) []apitypes.Post { // Accept []domain.Post
 	apiPosts := make([]apitypes.Post, len(posts))
@@ -73,9 +96,19 @@ func mapDomainToApiPosts(posts []domain.Post) []apitypes.Post { // Accept []doma
 }
 
 func (app *Application) listPostsHandler(w http.ResponseWriter, r *http.Request) {
-	// TODO: Add pagination query parameter handling (page, limit)
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	cursor, limit, offset, err := readPage(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
 
-	posts, err := app.PostService.List(r.Context(), 10, 0) // Using default limit for now
+	page, err := app.PostService.List(r.Context(), claims.ID, cursor, limit, offset)
 
 	if err != nil {
 		handleErrors(w, err)
@@ -83,14 +116,15 @@ func (app *Application) listPostsHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Map domain posts to API posts
-	apiPosts := mapDomainToApiPosts(posts)
+	apiPosts := mapDomainToApiPosts(page.Posts)
 
 	// Wrap in success response
 	response := apitypes.ListPostsSuccessResponse{
-		Data: apiPosts,
-		// Add metadata here if implementing pagination
+		Data:       apiPosts,
+		NextCursor: optionalString(page.NextCursor),
 	}
 
+	setNextLink(w, r, page.NextCursor)
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
@@ -177,7 +211,13 @@ func (app *Application) getPostByIdHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	post, err := app.PostService.GetByID(r.Context(), int64(postId))
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	post, err := app.PostService.GetByID(r.Context(), claims.ID, int64(postId))
 
 	if err != nil {
 		handleErrors(w, err)
@@ -194,3 +234,46 @@ func (app *Application) getPostByIdHandler(w http.ResponseWriter, r *http.Reques
 
 	writeJSONResponse(w, http.StatusOK, response)
 }
+
+func (app *Application) repostHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	postId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid id"))
+		return
+	}
+
+	repost, err := app.PostService.Repost(r.Context(), claims.ID, postId)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusCreated, apitypes.CreatePostSuccessResponse{Data: mapDomainToApiPost(repost)})
+}
+
+func (app *Application) unrepostHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	postId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid id"))
+		return
+	}
+
+	if err := app.PostService.Unrepost(r.Context(), claims.ID, postId); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+func (app *Application) addPostReactionHandler(w http.ResponseWriter, r *http.Request) {
+	app.handlePostReaction(w, r, app.ReactionService.AddPostReaction)
+}
+
+func (app *Application) removePostReactionHandler(w http.ResponseWriter, r *http.Request) {
+	app.handlePostReaction(w, r, app.ReactionService.RemovePostReaction)
+}
+
+func (app *Application) addCommentReactionHandler(w http.ResponseWriter, r *http.Request) {
+	app.handleCommentReaction(w, r, app.ReactionService.AddCommentReaction)
+}
+
+func (app *Application) removeCommentReactionHandler(w http.ResponseWriter, r *http.Request) {
+	app.handleCommentReaction(w, r, app.ReactionService.RemoveCommentReaction)
+}
+
+// handlePostReaction reads the post and reaction type of a reaction route, and applies the
+// reaction change of the caller.
+func (app *Application) handlePostReaction(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, userId, postId int64, reactionType string) error) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	postId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid id"))
+		return
+	}
+
+	if err := change(r.Context(), claims.ID, postId, r.PathValue("type")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleCommentReaction reads the post, comment and reaction type of a reaction route, and
+// applies the reaction change of the caller.
+func (app *Application) handleCommentReaction(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, userId, postId, commentId int64, reactionType string) error) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	postId, err := strconv.ParseInt(r.PathValue("postId"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid post id"))
+		return
+	}
+
+	commentId, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid id"))
+		return
+	}
+
+	if err := change(r.Context(), claims.ID, postId, commentId, r.PathValue("type")); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mapDomainToApiReactions maps reactions to the fields of posts and comments, which are
+// empty rather than absent without reactions.
+func mapDomainToApiReactions(reactions domain.Reactions) (*map[string]int64, *[]string) {
+	counts := reactions.Counts
+	if counts == nil {
+		counts = map[string]int64{}
+	}
+	mine := reactions.Mine
+	if mine == nil {
+		mine = []string{}
+	}
+	return &counts, &mine
+}
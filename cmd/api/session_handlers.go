@@ -0,0 +1,83 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/google/uuid"
+)
+
+func (app *Application) listSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	sessions, err := app.AuthService.ListSessions(r.Context(), claims.ID)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiSessions := make([]apitypes.Session, len(sessions))
+	for i, session := range sessions {
+		apiSessions[i] = mapDomainToApiSession(&session, claims.SessionID)
+	}
+
+	response := apitypes.ListSessionsSuccessResponse{
+		Data: apiSessions,
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) revokeSessionHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	sessionId := r.PathValue("id")
+	if _, err := uuid.Parse(sessionId); err != nil {
+		handleErrors(w, domain.NewBadRequestError("invalid session id"))
+		return
+	}
+
+	if err := app.AuthService.RevokeSession(r.Context(), claims.ID, sessionId); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusNoContent, nil)
+}
+
+// revokeOtherSessionsHandler logs the caller out everywhere except the current device.
+func (app *Application) revokeOtherSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	if err := app.AuthService.RevokeOtherSessions(r.Context(), claims.ID, claims.SessionID); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusNoContent, nil)
+}
+
+func mapDomainToApiSession(session *domain.Session, currentSessionId string) apitypes.Session {
+	current := session.ID == currentSessionId
+	return apitypes.Session{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		Current:    current,
+	}
+}
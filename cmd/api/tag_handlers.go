@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+func (app *Application) listTagPostsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	limit, err := readLimit(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	page, err := app.TagService.ListPosts(r.Context(), claims.ID, r.PathValue("tag"), r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	setNextLink(w, r, page.NextCursor)
+	writeJSONResponse(w, http.StatusOK, apitypes.ListTagPostsSuccessResponse{
+		Data:       mapDomainToApiPosts(page.Posts),
+		NextCursor: optionalString(page.NextCursor),
+	})
+}
+
+func (app *Application) trendingTagsHandler(w http.ResponseWriter, r *http.Request) {
+	limit, err := readLimit(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	tags, err := app.TagService.Trending(r.Context(), limit)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	apiTags := make([]apitypes.TrendingTag, len(tags))
+	for i, tag := range tags {
+		apiTags[i] = apitypes.TrendingTag{Tag: tag.Tag, PostCount: tag.PostCount}
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.TrendingTagsSuccessResponse{Data: apiTags})
+}
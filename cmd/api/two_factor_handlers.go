@@ -0,0 +1,193 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+)
+
+// verifyMFAChallengeHandler completes a login that returned a two-factor challenge.
+func (app *Application) verifyMFAChallengeHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Data *domain.VerifyMFAChallengeDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	// Logins with an external provider deliver the challenge token in a cookie
+	challengeCookie, _ := r.Cookie(domain.MFAChallengeCookie)
+	if requestBody.Data.ChallengeToken == "" && challengeCookie != nil {
+		requestBody.Data.ChallengeToken = challengeCookie.Value
+	}
+
+	user, err := app.TwoFactorService.VerifyChallenge(r.Context(), requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	if challengeCookie != nil {
+		http.SetCookie(w, app.mfaChallengeCookie(nil))
+	}
+	app.completeLogin(w, r, user)
+}
+
+// mfaChallengeCookie returns the HttpOnly cookie carrying the challenge token until it expires,
+// or the cookie deleting it when challenge is nil.
+func (app *Application) mfaChallengeCookie(challenge *domain.MFAChallenge) *http.Cookie {
+	if challenge == nil {
+		return app.cookiePolicy().ExpiredCookie(domain.MFAChallengeCookie, true)
+	}
+	return app.cookiePolicy().NewCookie(domain.MFAChallengeCookie, challenge.Token, challenge.ExpiresAt, true)
+}
+
+func (app *Application) getTwoFactorStatusHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	status, err := app.TwoFactorService.GetStatus(r.Context(), claims.ID)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.TwoFactorStatusSuccessResponse{
+		Data: apitypes.TwoFactorStatus{
+			Enabled:                status.Enabled,
+			RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		},
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) enrollTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	enrollment, err := app.TwoFactorService.Enroll(r.Context(), claims.ID)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.TOTPEnrollmentSuccessResponse{
+		Data: apitypes.TOTPEnrollment{
+			Secret:     enrollment.Secret,
+			OtpauthUri: enrollment.OTPAuthURI,
+		},
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) confirmTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	var requestBody struct {
+		Data *domain.ConfirmTOTPDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return
+	}
+
+	codes, err := app.TwoFactorService.ConfirmEnrollment(r.Context(), claims.ID, requestBody.Data)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.RecoveryCodesSuccessResponse{
+		Data: apitypes.RecoveryCodes{RecoveryCodes: codes},
+	})
+}
+
+func (app *Application) disableTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	confirmation, ok := readPasswordConfirmation(w, r)
+	if !ok {
+		return
+	}
+
+	if err := app.TwoFactorService.Disable(r.Context(), claims.ID, confirmation); err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusNoContent, nil)
+}
+
+func (app *Application) regenerateRecoveryCodesHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	confirmation, ok := readPasswordConfirmation(w, r)
+	if !ok {
+		return
+	}
+
+	codes, err := app.TwoFactorService.RegenerateRecoveryCodes(r.Context(), claims.ID, confirmation)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, apitypes.RecoveryCodesSuccessResponse{
+		Data: apitypes.RecoveryCodes{RecoveryCodes: codes},
+	})
+}
+
+// readPasswordConfirmation reads the current password sent to re-authenticate a sensitive
+// request. It writes the error response and returns false when the body is invalid.
+func readPasswordConfirmation(w http.ResponseWriter, r *http.Request) (*domain.PasswordConfirmationDTO, bool) {
+	var requestBody struct {
+		Data *domain.PasswordConfirmationDTO `json:"data"`
+	}
+
+	if err := readJSON(r.Body, &requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error(), errorcodes.CodeBadRequest, "")
+		return nil, false
+	}
+
+	if requestBody.Data == nil {
+		writeJSONError(w, http.StatusBadRequest, "missing request data", errorcodes.CodeBadRequest, "")
+		return nil, false
+	}
+
+	return requestBody.Data, true
+}
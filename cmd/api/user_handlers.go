@@ -21,21 +21,9 @@ func (app *Application) getUserProfileHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Map domain.User to apitypes.User
-	apiUser := apitypes.User{
-		Id:        &user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Username:  user.Username,
-		Email:     apitypes.Email(user.Email),
-		CreatedAt: &user.CreatedAt,
-		UpdatedAt: &user.UpdatedAt,
-		LastLogin: user.LastLogin, // Assuming types match (*time.Time)
-	}
-
 	// Wrap in the success response structure
 	response := apitypes.GetUserProfileSuccessResponse{
-		Data: apiUser,
+		Data: mapDomainToApiUser(user),
 	}
 
 	writeJSONResponse(w, http.StatusOK, response)
@@ -70,22 +58,95 @@ func (app *Application) updateUserHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// Map domain.User to apitypes.User
-	apiUser := apitypes.User{
-		Id:        &user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Username:  user.Username,
-		Email:     apitypes.Email(user.Email),
-		CreatedAt: &user.CreatedAt,
-		UpdatedAt: &user.UpdatedAt,
-		LastLogin: user.LastLogin, // Assuming types match (*time.Time)
-	}
-
 	// Wrap in the success response structure
 	response := apitypes.UpdateUserProfileSuccessResponse{
-		Data: apiUser,
+		Data: mapDomainToApiUser(user),
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+// mapDomainToApiUser maps the profile of the authenticated user to its API representation
+func mapDomainToApiUser(user *domain.User) apitypes.User {
+	return apitypes.User{
+		Id:                &user.ID,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Username:          user.Username,
+		Email:             apitypes.Email(user.Email),
+		CreatedAt:         &user.CreatedAt,
+		UpdatedAt:         &user.UpdatedAt,
+		LastLogin:         user.LastLogin,
+		EmailVerifiedAt:   user.EmailVerifiedAt,
+		Role:              mapDomainToApiUserRole(user.Role),
+		Bio:               &user.Bio,
+		ProfilePictureUrl: optionalString(user.ProfilePictureURL),
+	}
+}
+
+// getPublicUserProfileHandler returns what any user can see of the user named in the path.
+func (app *Application) getPublicUserProfileHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	profile, err := app.UserService.GetProfile(r.Context(), claims.ID, r.PathValue("username"))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.GetPublicUserProfileSuccessResponse{
+		Data: apitypes.PublicUserProfile{
+			Id:                profile.ID,
+			Username:          profile.Username,
+			FirstName:         profile.FirstName,
+			LastName:          profile.LastName,
+			Bio:               profile.Bio,
+			ProfilePictureUrl: optionalString(profile.ProfilePictureURL),
+			JoinedAt:          profile.JoinedAt,
+			PostCount:         profile.PostCount,
+			CommentCount:      profile.CommentCount,
+			FollowerCount:     profile.FollowerCount,
+			FollowingCount:    profile.FollowingCount,
+		},
+	}
+
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+func (app *Application) listUserPostsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := getUserClaimFromContext(r.Context())
+	if !ok {
+		handleErrors(w, domain.NewUnauthorizedError("unauthorized"))
+		return
+	}
+
+	cursor, limit, offset, err := readPage(r)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	profile, err := app.UserService.GetProfile(r.Context(), claims.ID, r.PathValue("username"))
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	page, err := app.PostService.ListByUserID(r.Context(), claims.ID, profile.ID, cursor, limit, offset)
+	if err != nil {
+		handleErrors(w, err)
+		return
+	}
+
+	response := apitypes.ListPostsSuccessResponse{
+		Data:       mapDomainToApiPosts(page.Posts),
+		NextCursor: optionalString(page.NextCursor),
 	}
 
+	setNextLink(w, r, page.NextCursor)
 	writeJSONResponse(w, http.StatusOK, response)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,18 +10,38 @@ import (
 
 	"github.com/floroz/go-social/cmd/api"
 	"github.com/floroz/go-social/cmd/database"
+	"github.com/floroz/go-social/internal/blobstore"
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/mailer"
+	"github.com/floroz/go-social/internal/oidc"
 	"github.com/floroz/go-social/internal/repositories"
 	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
 )
 
 func main() {
 	env.MustLoadEnv(".env.local")
 
-	// crash immediately if there's no JWT_SECRET
-	if env.GetJWTSecret() == "" {
-		panic("fatal: JWT_SECRET is required but not set in env.")
+	// crash immediately if there's no key to sign access tokens
+	keyring, err := jwtkeys.LoadFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("fatal: failed to load JWT signing keys (set JWT_KEYS_DIR or JWT_SIGNING_KEYS): %v", err))
+	}
+	log.Info().Msgf("Signing access tokens with key %s", keyring.ActiveKeyID())
+
+	// Without a configured key, pagination cursors stop working when the server restarts
+	cursorKey := env.GetEnvValue("CURSOR_SIGNING_KEY")
+	if cursorKey == "" {
+		if cursorKey, err = tokens.Generate(32); err != nil {
+			panic(fmt.Sprintf("fatal: failed to generate a cursor signing key: %v", err))
+		}
+		log.Warn().Msg("CURSOR_SIGNING_KEY is not set, signing pagination cursors with a random key")
 	}
+	cursors := cursor.NewCodec([]byte(cursorKey))
 
 	db, err := database.ConnectDb()
 	if err != nil {
@@ -30,26 +51,146 @@ func main() {
 	defer db.Close()
 
 	userRepo := repositories.NewUserRepository(db)
-	userService := services.NewUserService(userRepo)
+	blockRepo := repositories.NewBlockRepository(db)
+	userService := services.NewUserService(userRepo, blockRepo, cursors)
+	moderationLogRepo := repositories.NewModerationLogRepository(db)
+	authorizer := services.NewAuthorizer(userRepo, moderationLogRepo)
 
+	reactionRepo := repositories.NewReactionRepository(db)
 	commentRepo := repositories.NewCommentRepository(db)
-	commentService := services.NewCommentService(commentRepo)
-
 	postRepo := repositories.NewPostRepository(db)
-	postService := services.NewPostService(postRepo, commentRepo)
+	commentService := services.NewCommentService(commentRepo, postRepo, blockRepo, reactionRepo, authorizer, cursors)
+
+	postService := services.NewPostService(postRepo, commentRepo, blockRepo, reactionRepo, authorizer, cursors)
+
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	ipLoginFailureRepo := repositories.NewIPLoginFailureRepository(db)
+	defaultThrottlePolicy := domain.DefaultLoginThrottlePolicy()
+	loginThrottlePolicy := &domain.LoginThrottlePolicy{
+		MaxAccountFailures: env.GetIntValue("LOGIN_MAX_FAILED_ATTEMPTS", defaultThrottlePolicy.MaxAccountFailures),
+		MaxIPFailures:      env.GetIntValue("LOGIN_MAX_FAILED_ATTEMPTS_PER_IP", defaultThrottlePolicy.MaxIPFailures),
+		IPFailureWindow:    env.GetDurationValue("LOGIN_IP_FAILURE_WINDOW", defaultThrottlePolicy.IPFailureWindow),
+		BaseLockout:        env.GetDurationValue("LOGIN_LOCKOUT_BASE", defaultThrottlePolicy.BaseLockout),
+		MaxLockout:         env.GetDurationValue("LOGIN_LOCKOUT_MAX", defaultThrottlePolicy.MaxLockout),
+	}
+	impersonationRepo := repositories.NewImpersonationSessionRepository(db)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, sessionRepo, impersonationRepo, ipLoginFailureRepo, loginThrottlePolicy, keyring)
+
+	emailVerificationPolicy, err := domain.ParseEmailVerificationPolicy(env.GetEnvValue("EMAIL_VERIFICATION_REQUIRED_FOR"))
+	if err != nil {
+		panic(fmt.Sprintf("fatal: invalid EMAIL_VERIFICATION_REQUIRED_FOR: %s", err))
+	}
+
+	appMailer, err := mailer.NewFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("fatal: invalid mail configuration: %s", err))
+	}
+	userTokenRepo := repositories.NewUserTokenRepository(db)
+	passwordResetService := services.NewPasswordResetService(userRepo, userTokenRepo, refreshTokenRepo, sessionRepo, appMailer)
+	emailVerificationService := services.NewEmailVerificationService(userRepo, userTokenRepo, appMailer, emailVerificationPolicy)
+
+	totpIssuer := env.GetEnvValue("TOTP_ISSUER")
+	if totpIssuer == "" {
+		totpIssuer = "GoSocial"
+	}
+	totpRepo := repositories.NewTOTPRepository(db)
+	recoveryCodeRepo := repositories.NewRecoveryCodeRepository(db)
+	twoFactorService := services.NewTwoFactorService(userRepo, totpRepo, recoveryCodeRepo, userTokenRepo, loginThrottlePolicy, totpIssuer)
+	personalAccessTokenRepo := repositories.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenService := services.NewPersonalAccessTokenService(userRepo, personalAccessTokenRepo)
+	adminService := services.NewAdminService(userRepo, sessionRepo, refreshTokenRepo, moderationLogRepo)
+	defaultDeletionPolicy := domain.DefaultAccountDeletionPolicy()
+	accountDeletionPolicy := &domain.AccountDeletionPolicy{
+		GracePeriod:    env.GetDurationValue("ACCOUNT_DELETION_GRACE_PERIOD", defaultDeletionPolicy.GracePeriod),
+		PurgeInterval:  env.GetDurationValue("ACCOUNT_PURGE_INTERVAL", defaultDeletionPolicy.PurgeInterval),
+		PurgeBatchSize: env.GetIntValue("ACCOUNT_PURGE_BATCH_SIZE", defaultDeletionPolicy.PurgeBatchSize),
+	}
+	avatarPolicy := domain.DefaultAvatarPolicy()
+	avatarPolicy.MaxBytes = int64(env.GetIntValue("AVATAR_MAX_BYTES", int(avatarPolicy.MaxBytes)))
+	blobStore := blobstore.NewLocalBlobStore(env.GetEnvValue("BLOB_STORE_DIR"))
+	avatarService := services.NewAvatarService(userRepo, blobStore, avatarPolicy, env.GetEnvValue("API_URL")+"/api/v1/media/")
+	accountService := services.NewAccountService(userRepo, userTokenRepo, sessionRepo, personalAccessTokenRepo, appMailer, avatarService, loginThrottlePolicy, accountDeletionPolicy)
+
+	oidcConfigs, err := oidc.LoadFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("fatal: invalid OpenID Connect configuration: %s", err))
+	}
+	oidcProviders := make([]interfaces.OIDCProvider, 0, len(oidcConfigs))
+	for _, oidcConfig := range oidcConfigs {
+		oidcProviders = append(oidcProviders, oidc.NewProvider(oidcConfig, nil))
+	}
+	oidcService := services.NewOIDCService(oidcProviders, userRepo, repositories.NewUserIdentityRepository(db), repositories.NewOIDCLoginStateRepository(db))
+
+	defaultMagicLinkPolicy := domain.DefaultMagicLinkPolicy()
+	magicLinkPolicy := &domain.MagicLinkPolicy{
+		TokenTTL:    env.GetDurationValue("MAGIC_LINK_TTL", defaultMagicLinkPolicy.TokenTTL),
+		Window:      env.GetDurationValue("MAGIC_LINK_WINDOW", defaultMagicLinkPolicy.Window),
+		MaxPerEmail: env.GetIntValue("MAGIC_LINK_MAX_PER_EMAIL", defaultMagicLinkPolicy.MaxPerEmail),
+		MaxPerIP:    env.GetIntValue("MAGIC_LINK_MAX_PER_IP", defaultMagicLinkPolicy.MaxPerIP),
+	}
+	magicLinkService := services.NewMagicLinkService(userRepo, userTokenRepo, repositories.NewMagicLinkRequestRepository(db), appMailer, magicLinkPolicy)
+
+	impersonationPolicy := &domain.ImpersonationPolicy{
+		TTL: env.GetDurationValue("IMPERSONATION_TTL", domain.DefaultImpersonationPolicy().TTL),
+	}
+	impersonationService := services.NewImpersonationService(userRepo, impersonationRepo, repositories.NewImpersonationAuditLogRepository(db), impersonationPolicy)
+
+	followService := services.NewFollowService(userRepo, repositories.NewFollowRepository(db), blockRepo, cursors)
+	blockService := services.NewBlockService(userRepo, blockRepo, repositories.NewMuteRepository(db))
+	reactionSet, err := domain.ParseReactionSet(env.GetEnvValue("REACTION_TYPES"))
+	if err != nil {
+		panic(fmt.Sprintf("fatal: invalid REACTION_TYPES: %s", err))
+	}
+	reactionService := services.NewReactionService(reactionRepo, postRepo, commentRepo, blockRepo, reactionSet)
+	feedService := services.NewFeedService(repositories.NewTimelineRepository(db), postRepo, blockRepo, reactionRepo, cursors)
+	tagPolicy := &domain.TagPolicy{
+		TrendingWindow: env.GetDurationValue("TRENDING_TAGS_WINDOW", domain.DefaultTagPolicy().TrendingWindow),
+	}
+	tagService := services.NewTagService(repositories.NewTagRepository(db), postRepo, blockRepo, reactionRepo, cursors, tagPolicy)
 
-	authService := services.NewAuthService(userRepo)
+	cookiePolicy := domain.DefaultCookiePolicy()
+	cookiePolicy.Secure = env.GetBoolValue("COOKIE_SECURE", cookiePolicy.Secure)
+	cookiePolicy.Domain = env.GetEnvValue("COOKIE_DOMAIN")
+	if path := env.GetEnvValue("COOKIE_PATH"); path != "" {
+		cookiePolicy.Path = path
+	}
+	if sameSite := env.GetEnvValue("COOKIE_SAMESITE"); sameSite != "" {
+		if cookiePolicy.SameSite, err = domain.ParseSameSite(sameSite); err != nil {
+			panic(fmt.Sprintf("fatal: invalid COOKIE_SAMESITE: %s", err))
+		}
+	}
+	if err := cookiePolicy.Validate(); err != nil {
+		panic(fmt.Sprintf("fatal: invalid cookie policy: %s", err))
+	}
 
 	config := &api.Config{
 		Port: env.GetEnvValue("PORT"),
 	}
 
 	app := &api.Application{
-		Config:         config,
-		UserService:    userService,
-		PostService:    postService,
-		CommentService: commentService,
-		AuthService:    authService,
+		Config:                     config,
+		CookiePolicy:               cookiePolicy,
+		AvatarPolicy:               avatarPolicy,
+		UserService:                userService,
+		PostService:                postService,
+		CommentService:             commentService,
+		AuthService:                authService,
+		PasswordResetService:       passwordResetService,
+		EmailVerificationService:   emailVerificationService,
+		TwoFactorService:           twoFactorService,
+		PersonalAccessTokenService: personalAccessTokenService,
+		AdminService:               adminService,
+		AccountService:             accountService,
+		OIDCService:                oidcService,
+		MagicLinkService:           magicLinkService,
+		ImpersonationService:       impersonationService,
+		AvatarService:              avatarService,
+		FollowService:              followService,
+		BlockService:               blockService,
+		FeedService:                feedService,
+		TagService:                 tagService,
+		ReactionService:            reactionService,
 	}
 
 	server := &http.Server{
@@ -60,9 +201,28 @@ func main() {
 		IdleTimeout:  time.Minute,
 	}
 
+	go purgeDeletedAccounts(accountService, accountDeletionPolicy.PurgeInterval)
+
 	log.Info().Msgf("Starting server on %s", app.Config.Port)
 
 	if err := server.ListenAndServe(); err != nil {
 		log.Error().Err(err).Msg("server error")
 	}
 }
+
+// purgeDeletedAccounts purges the accounts whose deletion grace period is over, once per interval.
+// Each run drains the due accounts batch by batch.
+func purgeDeletedAccounts(accountService interfaces.AccountService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for {
+			purged, err := accountService.PurgeDueAccounts(context.Background())
+			if err != nil || purged == 0 {
+				break
+			}
+			log.Info().Int("purged", purged).Msg("purged deleted accounts")
+		}
+	}
+}
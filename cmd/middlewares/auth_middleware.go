@@ -3,10 +3,10 @@ package middlewares
 import (
 	"context"
 	"net/http"
+	"strings"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/floroz/go-social/internal/domain"
-	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
 )
 
 type contextKey string
@@ -15,33 +15,74 @@ const (
 	ContextKeyUser contextKey = "user"
 )
 
-func AuthMiddleware(next http.Handler) http.Handler {
-	jwtSecret := env.GetJWTSecret()
+// NewAuthMiddleware authenticates requests with an access token and rejects tokens whose
+// session has been revoked. The token is read from the "Authorization: Bearer" header or,
+// when the header is absent, from the access_token cookie. A request carrying the header is
+// authenticated by the header alone: the cookie is ignored, even if the header is invalid.
+func NewAuthMiddleware(authService interfaces.AuthService) func(http.Handler) http.Handler {
+	return newAuthMiddleware(authService, nil)
+}
+
+// NewTokenAuthMiddleware also accepts personal access tokens in the "Authorization: Bearer"
+// header, restricting the request to the scopes of the token.
+func NewTokenAuthMiddleware(authService interfaces.AuthService, personalAccessTokenService interfaces.PersonalAccessTokenService) func(http.Handler) http.Handler {
+	return newAuthMiddleware(authService, personalAccessTokenService)
+}
 
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		cookie, err := r.Cookie("access_token")
+func newAuthMiddleware(authService interfaces.AuthService, personalAccessTokenService interfaces.PersonalAccessTokenService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var claims *domain.UserClaims
+			var err error
 
-		if err != nil {
-			http.Error(w, "missing access token", http.StatusUnauthorized)
-			return
-		}
-		token, err := jwt.ParseWithClaims(cookie.Value, &domain.UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, http.ErrAbortHandler
+			if authorization := r.Header.Get("Authorization"); authorization != "" {
+				rawToken, ok := parseBearerToken(authorization)
+				if !ok {
+					http.Error(w, "invalid authorization header", http.StatusUnauthorized)
+					return
+				}
+
+				if strings.HasPrefix(rawToken, domain.PersonalAccessTokenPrefix) {
+					if personalAccessTokenService == nil {
+						http.Error(w, "personal access tokens are not accepted for this endpoint", http.StatusUnauthorized)
+						return
+					}
+					claims, err = personalAccessTokenService.Authenticate(r.Context(), rawToken)
+				} else {
+					claims, err = authService.AuthenticateAccessToken(r.Context(), rawToken)
+				}
+			} else {
+				cookie, cookieErr := r.Cookie(domain.AccessTokenCookie)
+				if cookieErr != nil {
+					http.Error(w, "missing access token", http.StatusUnauthorized)
+					return
+				}
+				claims, err = authService.AuthenticateAccessToken(r.Context(), cookie.Value)
 			}
-			return []byte(jwtSecret), nil
-		})
 
-		if err != nil || !token.Valid {
-			http.Error(w, "invalid token", http.StatusUnauthorized)
-			return
-		}
+			if err != nil {
+				if _, ok := err.(*domain.UnauthorizedError); ok {
+					http.Error(w, err.Error(), http.StatusUnauthorized)
+					return
+				}
+				http.Error(w, "failed to validate token", http.StatusInternalServerError)
+				return
+			}
 
-		if claims, ok := token.Claims.(*domain.UserClaims); ok && token.Valid {
 			ctx := context.WithValue(r.Context(), ContextKeyUser, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
-		} else {
-			http.Error(w, "invalid token claims", http.StatusUnauthorized)
-		}
-	})
+		})
+	}
+}
+
+// parseBearerToken extracts the token of an "Authorization: Bearer <token>" header.
+// The scheme is case-insensitive.
+func parseBearerToken(authorization string) (string, bool) {
+	scheme, rawToken, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	rawToken = strings.TrimSpace(rawToken)
+	return rawToken, rawToken != ""
 }
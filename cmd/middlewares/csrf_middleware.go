@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/rs/zerolog/log"
+)
+
+// CSRFHeader carries the value of the csrf_token cookie on state-changing requests.
+const CSRFHeader = "X-CSRF-Token"
+
+const csrfTokenSize = 32
+
+// NewCSRFMiddleware protects requests authenticated by cookie with the double-submit
+// pattern: state-changing requests must echo the csrf_token cookie in the X-CSRF-Token
+// header, which a cross-site form or script cannot do since it cannot read the cookie.
+// Requests with an Authorization header are not checked: browsers never attach one on
+// their own, so they cannot be forged. Safe requests of a cookie session missing the
+// token, e.g. one started before the protection existed, are issued a new one.
+func NewCSRFMiddleware(cookiePolicy *domain.CookiePolicy, expiration time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" || !hasAuthCookie(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			csrfCookie, err := r.Cookie(domain.CSRFTokenCookie)
+			hasToken := err == nil && csrfCookie.Value != ""
+
+			if isSafeMethod(r.Method) {
+				if !hasToken {
+					if err := IssueCSRFToken(w, cookiePolicy, expiration); err != nil {
+						log.Error().Err(err).Msg("failed to issue CSRF token")
+					}
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			headerToken := r.Header.Get(CSRFHeader)
+			if !hasToken || headerToken == "" || subtle.ConstantTimeCompare([]byte(headerToken), []byte(csrfCookie.Value)) != 1 {
+				http.Error(w, "missing or invalid CSRF token", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// IssueCSRFToken sets a new csrf_token cookie. It is readable by scripts on purpose.
+func IssueCSRFToken(w http.ResponseWriter, cookiePolicy *domain.CookiePolicy, expiration time.Duration) error {
+	token, err := tokens.Generate(csrfTokenSize)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, cookiePolicy.NewCookie(domain.CSRFTokenCookie, token, time.Now().Add(expiration), false))
+	return nil
+}
+
+func hasAuthCookie(r *http.Request) bool {
+	for _, name := range []string{domain.AccessTokenCookie, domain.RefreshTokenCookie} {
+		if cookie, err := r.Cookie(name); err == nil && cookie.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
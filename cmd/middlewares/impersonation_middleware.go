@@ -0,0 +1,59 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// NewImpersonationAuditMiddleware writes every request made with an impersonation session to
+// the audit log, together with its response status. It must run after an authentication
+// middleware; requests of users acting as themselves are not recorded.
+func NewImpersonationAuditMiddleware(impersonationService interfaces.ImpersonationService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := r.Context().Value(ContextKeyUser).(*domain.UserClaims)
+			if !ok || !claims.IsImpersonated() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			// The entry is written even when the client went away before the response was sent.
+			// Failures are logged by the service: the request has already been handled.
+			_ = impersonationService.Record(context.WithoutCancel(r.Context()), &domain.ImpersonationAuditEntry{
+				ImpersonationID: claims.SessionID,
+				ActorID:         claims.Actor.ID,
+				SubjectID:       claims.ID,
+				Method:          r.Method,
+				Path:            r.URL.Path,
+				Status:          status,
+			})
+		})
+	}
+}
+
+// BlockImpersonation rejects requests made with an impersonation session. It guards the
+// sensitive actions an admin must not take on behalf of a user, such as changing their
+// credentials, and must run after an authentication middleware.
+func BlockImpersonation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(ContextKeyUser).(*domain.UserClaims)
+		if ok && claims.IsImpersonated() {
+			http.Error(w, "not allowed while impersonating a user", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
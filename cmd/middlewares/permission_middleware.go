@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// RequirePermission only lets through users whose role grants the permission. It must run
+// after an authentication middleware. The role is the one carried by the access token;
+// changing a role revokes the sessions of the user, so a token never outlives its role.
+func RequirePermission(permission domain.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := r.Context().Value(ContextKeyUser).(*domain.UserClaims)
+			if !ok {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			if !claims.HasPermission(permission) {
+				http.Error(w, "missing the "+string(permission)+" permission", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE refresh_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Index for revoking a whole token family on reuse or logout
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+-- Index for revoking all tokens of a user
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- Single-use tokens sent to users by email (password reset, ...)
+CREATE TABLE user_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose VARCHAR(32) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Index for invalidating the outstanding tokens of a user
+CREATE INDEX idx_user_tokens_user_id_purpose ON user_tokens (user_id, purpose) WHERE used_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Users created before email verification existed are treated as verified
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE users SET email_verified_at = created_at;
\ No newline at end of file
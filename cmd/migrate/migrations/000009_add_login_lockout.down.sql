@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ip_login_failures;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS locked_until,
+    ALTER COLUMN failed_login_attempts DROP NOT NULL;
\ No newline at end of file
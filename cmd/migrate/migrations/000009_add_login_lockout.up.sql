@@ -0,0 +1,13 @@
+UPDATE users SET failed_login_attempts = 0 WHERE failed_login_attempts IS NULL;
+
+ALTER TABLE users
+    ALTER COLUMN failed_login_attempts SET NOT NULL,
+    ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE;
+
+-- Failed logins per client IP, to throttle guessing across many accounts
+CREATE TABLE ip_login_failures (
+    ip_address VARCHAR(45) PRIMARY KEY,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP WITH TIME ZONE
+);
\ No newline at end of file
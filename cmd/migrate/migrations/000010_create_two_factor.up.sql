@@ -0,0 +1,21 @@
+-- TOTP second factor of a user; confirmed_at stays NULL until enrollment is confirmed with a first code
+CREATE TABLE user_totp (
+    user_id INT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP WITH TIME ZONE,
+    -- Last accepted time step, so that a code cannot be replayed
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    -- Failed second factor attempts since the last success
+    failed_attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One-time recovery codes, used when the authenticator is not available
+CREATE TABLE recovery_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (user_id, code_hash)
+);
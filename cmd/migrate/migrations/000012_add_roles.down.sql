@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS moderation_actions;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
\ No newline at end of file
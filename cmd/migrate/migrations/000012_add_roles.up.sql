@@ -0,0 +1,19 @@
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'user'
+        CONSTRAINT users_role_check CHECK (role IN ('user', 'moderator', 'admin'));
+
+-- Actions taken by moderators on content they do not own. The entries deliberately have no
+-- foreign keys so that the audit trail outlives the users and content it mentions.
+CREATE TABLE moderation_actions (
+    id SERIAL PRIMARY KEY,
+    actor_id INT NOT NULL,
+    actor_role VARCHAR(20) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    target_type VARCHAR(20) NOT NULL,
+    target_id INT NOT NULL,
+    target_user_id INT NOT NULL,
+    previous_content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_moderation_actions_created_at ON moderation_actions (created_at DESC);
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Address a user asked to change their email to; it replaces email once confirmed
+ALTER TABLE users ADD COLUMN pending_email VARCHAR(255);
\ No newline at end of file
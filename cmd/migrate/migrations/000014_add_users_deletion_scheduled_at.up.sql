@@ -0,0 +1,5 @@
+-- When an account asked to be deleted is purged; logging in before then cancels the deletion
+ALTER TABLE users ADD COLUMN deletion_scheduled_at TIMESTAMP WITH TIME ZONE;
+
+-- Index for the purge to find the accounts that are due
+CREATE INDEX idx_users_deletion_scheduled_at ON users (deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL AND is_deleted = false;
\ No newline at end of file
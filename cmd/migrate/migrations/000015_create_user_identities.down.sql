@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS oidc_login_states;
+
+DROP TABLE IF EXISTS user_identities;
+
+-- Passwordless users get a random password that matches no input
+UPDATE users SET password = decode(md5(random()::text), 'hex') WHERE password IS NULL;
+ALTER TABLE users DROP CONSTRAINT users_password_check;
+ALTER TABLE users ADD CONSTRAINT users_password_check CHECK (LENGTH(password) >= 10);
+ALTER TABLE users ALTER COLUMN password SET NOT NULL;
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- Users created through a social login have no password until they set one
+ALTER TABLE users ALTER COLUMN password DROP NOT NULL;
+ALTER TABLE users DROP CONSTRAINT users_password_check;
+ALTER TABLE users ADD CONSTRAINT users_password_check CHECK (password IS NULL OR LENGTH(password) >= 10);
+
+-- Accounts of external OpenID Connect providers linked to users
+CREATE TABLE user_identities (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (provider, subject)
+);
+
+-- Index for the identities of a user
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
+
+-- Logins in progress with a provider, between the redirect to it and its callback
+CREATE TABLE oidc_login_states (
+    state_hash VARCHAR(64) PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Magic login links requested, to rate limit them per email address and per client IP.
+-- Requests for unknown addresses are recorded too, so that limits do not reveal accounts.
+CREATE TABLE magic_link_requests (
+    id BIGSERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_magic_link_requests_email_created_at ON magic_link_requests (email, created_at);
+CREATE INDEX idx_magic_link_requests_ip_address_created_at ON magic_link_requests (ip_address, created_at);
\ No newline at end of file
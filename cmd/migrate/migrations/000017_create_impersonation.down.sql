@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS impersonation_audit_log;
+
+DROP TABLE IF EXISTS impersonation_sessions;
\ No newline at end of file
@@ -0,0 +1,27 @@
+-- Sessions in which an admin acts as another user. They are kept apart from the sessions of
+-- the user, who neither sees nor can revoke them, and only ever issue an access token.
+CREATE TABLE impersonation_sessions (
+    id UUID PRIMARY KEY,
+    actor_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    subject_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Every request made while impersonating. Like the moderation log, the entries have no
+-- foreign keys so that the audit trail outlives the sessions and users it mentions.
+CREATE TABLE impersonation_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    impersonation_id UUID NOT NULL,
+    actor_id INT NOT NULL,
+    subject_id INT NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    status INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_impersonation_audit_log_impersonation_id ON impersonation_audit_log (impersonation_id, created_at);
+CREATE INDEX idx_impersonation_audit_log_created_at ON impersonation_audit_log (created_at DESC);
\ No newline at end of file
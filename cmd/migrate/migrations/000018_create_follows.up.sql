@@ -0,0 +1,10 @@
+CREATE TABLE follows (
+    follower_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    followee_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- The primary key serves the following lists; this index serves the follower lists
+CREATE INDEX idx_follows_followee_id_created_at ON follows (followee_id, created_at DESC);
\ No newline at end of file
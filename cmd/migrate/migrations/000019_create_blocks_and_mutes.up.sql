@@ -0,0 +1,18 @@
+CREATE TABLE user_blocks (
+    blocker_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    blocked_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+-- Blocks apply both ways, so they are also looked up by the blocked user
+CREATE INDEX idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+CREATE TABLE user_mutes (
+    muter_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    muted_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (muter_id, muted_id),
+    CHECK (muter_id <> muted_id)
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_posts_user_id_created_at ON posts (user_id, created_at DESC);
+
+DROP INDEX IF EXISTS idx_posts_user_id_created_at_id;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Serves the keyset pagination of timelines, which orders posts by creation time then id
+CREATE INDEX idx_posts_user_id_created_at_id ON posts (user_id, created_at DESC, id DESC) WHERE is_deleted = false;
+
+DROP INDEX IF EXISTS idx_posts_user_id_created_at;
\ No newline at end of file
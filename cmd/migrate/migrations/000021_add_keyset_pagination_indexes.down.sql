@@ -0,0 +1,7 @@
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at DESC) WHERE is_deleted = false;
+DROP INDEX IF EXISTS idx_users_created_at_id;
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_id ON comments (post_id, created_at DESC);
+DROP INDEX IF EXISTS idx_comments_post_id_created_at_id;
+
+DROP INDEX IF EXISTS idx_posts_created_at_id;
\ No newline at end of file
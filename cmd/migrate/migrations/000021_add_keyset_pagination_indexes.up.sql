@@ -0,0 +1,8 @@
+-- Serve the keyset pagination of the post, comment and user lists, which order rows by creation time then id
+CREATE INDEX idx_posts_created_at_id ON posts (created_at DESC, id DESC) WHERE is_deleted = false;
+
+CREATE INDEX idx_comments_post_id_created_at_id ON comments (post_id, created_at DESC, id DESC);
+DROP INDEX IF EXISTS idx_comments_post_id;
+
+CREATE INDEX idx_users_created_at_id ON users (created_at DESC, id DESC) WHERE is_deleted = false;
+DROP INDEX IF EXISTS idx_users_created_at;
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_reaction_counts ON reactions;
+
+DROP FUNCTION IF EXISTS update_reaction_counts;
+
+DROP TABLE IF EXISTS reaction_counts;
+
+DROP TABLE IF EXISTS reactions;
\ No newline at end of file
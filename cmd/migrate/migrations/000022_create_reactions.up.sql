@@ -0,0 +1,54 @@
+-- A reaction targets either a post or a comment; each user leaves at most one reaction of each type per target
+CREATE TABLE reactions (
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    post_id INT REFERENCES posts (id) ON DELETE CASCADE,
+    comment_id INT REFERENCES comments (id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((post_id IS NULL) <> (comment_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_reactions_post_id_user_id_type ON reactions (post_id, user_id, type) WHERE post_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_reactions_comment_id_user_id_type ON reactions (comment_id, user_id, type) WHERE comment_id IS NOT NULL;
+CREATE INDEX idx_reactions_user_id ON reactions (user_id);
+
+-- Counts of reactions by target and type, kept by the trigger below in the transaction changing the reactions
+CREATE TABLE reaction_counts (
+    post_id INT REFERENCES posts (id) ON DELETE CASCADE,
+    comment_id INT REFERENCES comments (id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    count INT NOT NULL CHECK (count >= 0),
+    CHECK ((post_id IS NULL) <> (comment_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_reaction_counts_post_id_type ON reaction_counts (post_id, type) WHERE post_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_reaction_counts_comment_id_type ON reaction_counts (comment_id, type) WHERE comment_id IS NOT NULL;
+
+-- The upsert locks the count row, so concurrent reactions on a target are counted one after the other
+CREATE FUNCTION update_reaction_counts()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NEW.post_id IS NOT NULL THEN
+            INSERT INTO reaction_counts (post_id, type, count) VALUES (NEW.post_id, NEW.type, 1)
+            ON CONFLICT (post_id, type) WHERE post_id IS NOT NULL DO UPDATE SET count = reaction_counts.count + 1;
+        ELSE
+            INSERT INTO reaction_counts (comment_id, type, count) VALUES (NEW.comment_id, NEW.type, 1)
+            ON CONFLICT (comment_id, type) WHERE comment_id IS NOT NULL DO UPDATE SET count = reaction_counts.count + 1;
+        END IF;
+        RETURN NEW;
+    END IF;
+
+    IF OLD.post_id IS NOT NULL THEN
+        UPDATE reaction_counts SET count = count - 1 WHERE post_id = OLD.post_id AND type = OLD.type;
+    ELSE
+        UPDATE reaction_counts SET count = count - 1 WHERE comment_id = OLD.comment_id AND type = OLD.type;
+    END IF;
+    RETURN OLD;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_reaction_counts
+    AFTER INSERT OR DELETE ON reactions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_reaction_counts();
\ No newline at end of file
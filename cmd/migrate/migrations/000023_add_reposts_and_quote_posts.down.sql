@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_posts_user_id_referenced_post_id_repost;
+
+DELETE FROM posts WHERE kind = 'repost';
+
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS posts_content_check;
+ALTER TABLE posts ADD CONSTRAINT posts_content_check CHECK (LENGTH(TRIM(content)) > 0);
+
+ALTER TABLE posts DROP COLUMN IF EXISTS referenced_post_id;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS kind;
\ No newline at end of file
@@ -0,0 +1,13 @@
+ALTER TABLE posts ADD COLUMN kind VARCHAR(16) NOT NULL DEFAULT 'post' CHECK (kind IN ('post', 'repost', 'quote'));
+
+-- The post shared by a repost or quote post. There is no foreign key: the reference outlives the
+-- shared post, which then shows as a tombstone
+ALTER TABLE posts ADD COLUMN referenced_post_id INT;
+ALTER TABLE posts ADD CONSTRAINT posts_referenced_post_id_check CHECK ((kind = 'post') = (referenced_post_id IS NULL));
+
+-- Reposts have no content of their own
+ALTER TABLE posts DROP CONSTRAINT posts_content_check;
+ALTER TABLE posts ADD CONSTRAINT posts_content_check CHECK (kind = 'repost' OR LENGTH(TRIM(content)) > 0);
+
+-- Users repost a post at most once
+CREATE UNIQUE INDEX idx_posts_user_id_referenced_post_id_repost ON posts (user_id, referenced_post_id) WHERE kind = 'repost';
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Hashtags of posts, extracted from their content and stored normalized
+CREATE TABLE post_tags (
+    post_id INT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    tag VARCHAR(100) NOT NULL,
+    PRIMARY KEY (post_id, tag)
+);
+
+-- Serves the posts of a tag, which are then ordered by creation time
+CREATE INDEX idx_post_tags_tag_post_id ON post_tags (tag, post_id);
\ No newline at end of file
@@ -7,8 +7,12 @@ import (
 	"github.com/bxcodec/faker/v3"
 	"github.com/floroz/go-social/cmd/api"
 	"github.com/floroz/go-social/cmd/database"
+	"github.com/floroz/go-social/internal/blobstore"
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/mailer"
 	"github.com/floroz/go-social/internal/repositories"
 	"github.com/floroz/go-social/internal/services"
 	"github.com/rs/zerolog/log"
@@ -17,6 +21,7 @@ import (
 func seed(app *api.Application) {
 	ctx := context.Background()
 	users := seedUsers(ctx, app)
+	seedRoles(ctx, app, users)
 	posts := seedPosts(ctx, app, users)
 	seedComments(ctx, app, posts)
 }
@@ -50,6 +55,23 @@ func seedUsers(ctx context.Context, app *api.Application) []domain.User {
 	return users
 }
 
+// seedRoles gives the first seeded user the admin role and the second the moderator role.
+func seedRoles(ctx context.Context, app *api.Application, users []domain.User) {
+	roles := []domain.Role{domain.RoleAdmin, domain.RoleModerator}
+	for i, role := range roles {
+		if i >= len(users) {
+			return
+		}
+
+		// The seeder acts as no user (ID 0), so the self-change guard never applies.
+		if _, err := app.AdminService.UpdateUserRole(ctx, 0, users[i].ID, &domain.UpdateUserRoleDTO{Role: role}); err != nil {
+			log.Error().Err(err).Msg("failed to assign role")
+			continue
+		}
+		log.Info().Msgf("Seeded %s %s (%s)", role, users[i].Username, users[i].Email)
+	}
+}
+
 func seedPosts(ctx context.Context, app *api.Application, users []domain.User) []domain.Post {
 	posts := make([]domain.Post, 0, len(users))
 	for _, user := range users {
@@ -108,20 +130,74 @@ func main() {
 		Port: env.GetEnvValue("PORT"),
 	}
 
+	// The seeder serves no requests, so its cursors never need to outlive it
+	cursors := cursor.NewCodec([]byte("seed-cursor-signing-key"))
+
 	userRepo := repositories.NewUserRepository(db)
-	userService := services.NewUserService(userRepo)
+	blockRepo := repositories.NewBlockRepository(db)
+	userService := services.NewUserService(userRepo, blockRepo, cursors)
+	moderationLogRepo := repositories.NewModerationLogRepository(db)
+	authorizer := services.NewAuthorizer(userRepo, moderationLogRepo)
+	reactionRepo := repositories.NewReactionRepository(db)
 	commentRepo := repositories.NewCommentRepository(db)
-	commentService := services.NewCommentService(commentRepo)
 	postRepo := repositories.NewPostRepository(db)
-	postService := services.NewPostService(postRepo, commentRepo)
-	authService := services.NewAuthService(userRepo)
+	commentService := services.NewCommentService(commentRepo, postRepo, blockRepo, reactionRepo, authorizer, cursors)
+	postService := services.NewPostService(postRepo, commentRepo, blockRepo, reactionRepo, authorizer, cursors)
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	ipLoginFailureRepo := repositories.NewIPLoginFailureRepository(db)
+	// The seeder signs no tokens that outlive it.
+	keyring, err := jwtkeys.NewEphemeralKeyring()
+	if err != nil {
+		panic(err)
+	}
+	impersonationRepo := repositories.NewImpersonationSessionRepository(db)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, sessionRepo, impersonationRepo, ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), keyring)
+	userTokenRepo := repositories.NewUserTokenRepository(db)
+	appMailer, err := mailer.NewFromEnv()
+	if err != nil {
+		panic(err)
+	}
+	passwordResetService := services.NewPasswordResetService(userRepo, userTokenRepo, refreshTokenRepo, sessionRepo, appMailer)
+	emailVerificationService := services.NewEmailVerificationService(userRepo, userTokenRepo, appMailer, domain.NewEmailVerificationPolicy())
+	totpRepo := repositories.NewTOTPRepository(db)
+	recoveryCodeRepo := repositories.NewRecoveryCodeRepository(db)
+	twoFactorService := services.NewTwoFactorService(userRepo, totpRepo, recoveryCodeRepo, userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	personalAccessTokenRepo := repositories.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenService := services.NewPersonalAccessTokenService(userRepo, personalAccessTokenRepo)
+	adminService := services.NewAdminService(userRepo, sessionRepo, refreshTokenRepo, moderationLogRepo)
+	avatarService := services.NewAvatarService(userRepo, blobstore.NewLocalBlobStore(env.GetEnvValue("BLOB_STORE_DIR")), domain.DefaultAvatarPolicy(), env.GetEnvValue("API_URL")+"/api/v1/media/")
+	accountService := services.NewAccountService(userRepo, userTokenRepo, sessionRepo, personalAccessTokenRepo, appMailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	magicLinkService := services.NewMagicLinkService(userRepo, userTokenRepo, repositories.NewMagicLinkRequestRepository(db), appMailer, domain.DefaultMagicLinkPolicy())
+	oidcService := services.NewOIDCService(nil, userRepo, repositories.NewUserIdentityRepository(db), repositories.NewOIDCLoginStateRepository(db))
+	impersonationService := services.NewImpersonationService(userRepo, impersonationRepo, repositories.NewImpersonationAuditLogRepository(db), domain.DefaultImpersonationPolicy())
+	followService := services.NewFollowService(userRepo, repositories.NewFollowRepository(db), blockRepo, cursors)
+	blockService := services.NewBlockService(userRepo, blockRepo, repositories.NewMuteRepository(db))
+	reactionService := services.NewReactionService(reactionRepo, postRepo, commentRepo, blockRepo, domain.DefaultReactionSet())
+	feedService := services.NewFeedService(repositories.NewTimelineRepository(db), postRepo, blockRepo, reactionRepo, cursors)
+	tagService := services.NewTagService(repositories.NewTagRepository(db), postRepo, blockRepo, reactionRepo, cursors, domain.DefaultTagPolicy())
 
 	app := &api.Application{
-		Config:         config,
-		UserService:    userService,
-		PostService:    postService,
-		CommentService: commentService,
-		AuthService:    authService,
+		Config:                     config,
+		UserService:                userService,
+		PostService:                postService,
+		CommentService:             commentService,
+		AuthService:                authService,
+		PasswordResetService:       passwordResetService,
+		EmailVerificationService:   emailVerificationService,
+		TwoFactorService:           twoFactorService,
+		PersonalAccessTokenService: personalAccessTokenService,
+		AdminService:               adminService,
+		AccountService:             accountService,
+		OIDCService:                oidcService,
+		MagicLinkService:           magicLinkService,
+		ImpersonationService:       impersonationService,
+		AvatarService:              avatarService,
+		FollowService:              followService,
+		BlockService:               blockService,
+		FeedService:                feedService,
+		TagService:                 tagService,
+		ReactionService:            reactionService,
 	}
 
 	seed(app)
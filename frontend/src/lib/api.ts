@@ -11,6 +11,11 @@ const apiClient = axios.create({
   // Important for cookies to be sent/received across domains during development
   // if frontend and backend are on different ports (e.g., 5173 vs 8080)
   withCredentials: true,
+  // Echo the CSRF cookie on state-changing requests authenticated by cookie. The API is on
+  // another origin in development, so axios must be told to send it anyway.
+  xsrfCookieName: "csrf_token",
+  xsrfHeaderName: "X-CSRF-Token",
+  withXSRFToken: true,
 });
 
 // Request interceptor to add JWT token to headers
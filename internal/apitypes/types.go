@@ -13,25 +13,89 @@ type LoginRequest = generated.LoginRequest
 type LoginResponse = generated.LoginResponse
 type SignupRequest = generated.SignupRequest
 type User = generated.User
+type PublicUserProfile = generated.PublicUserProfile
 
 type ApiError = generated.ApiError
 type ApiErrorResponse = generated.ApiErrorResponse
 type SignupSuccessResponse = generated.SignupSuccessResponse
 type LoginSuccessResponse = generated.LoginSuccessResponse
+type RefreshTokenRequest = generated.RefreshTokenRequest
+
+// Password reset endpoint types
+type ForgotPasswordRequest = generated.ForgotPasswordRequest
+type ResetPasswordRequest = generated.ResetPasswordRequest
+
+// Email verification endpoint types
+type VerifyEmailRequest = generated.VerifyEmailRequest
+type MagicLinkRequest = generated.MagicLinkRequest
+type MagicLinkLoginRequest = generated.MagicLinkLoginRequest
+
+// Two-factor authentication endpoint types
+type MFAChallenge = generated.MFAChallenge
+type MFAChallengeSuccessResponse = generated.MFAChallengeSuccessResponse
+type VerifyMFAChallengeRequest = generated.VerifyMFAChallengeRequest
+type TwoFactorStatus = generated.TwoFactorStatus
+type TwoFactorStatusSuccessResponse = generated.TwoFactorStatusSuccessResponse
+type TOTPEnrollment = generated.TOTPEnrollment
+type TOTPEnrollmentSuccessResponse = generated.TOTPEnrollmentSuccessResponse
+type ConfirmTOTPRequest = generated.ConfirmTOTPRequest
+type RecoveryCodes = generated.RecoveryCodes
+type RecoveryCodesSuccessResponse = generated.RecoveryCodesSuccessResponse
+type PasswordConfirmationRequest = generated.PasswordConfirmationRequest
+
+// Token verification key types
+type JSONWebKey = generated.JSONWebKey
+type JSONWebKeyAlg = generated.JSONWebKeyAlg
+type JSONWebKeySet = generated.JSONWebKeySet
+
+// External identity provider types
+type OIDCProvidersSuccessResponse = generated.OIDCProvidersSuccessResponse
+
+// Session endpoint types
+type Session = generated.Session
+type ListSessionsSuccessResponse = generated.ListSessionsSuccessResponse
 
 // User endpoint types
 type UpdateUserProfileRequest = generated.UpdateUserProfileRequest
 type GetUserProfileSuccessResponse = generated.GetUserProfileSuccessResponse
+type GetPublicUserProfileSuccessResponse = generated.GetPublicUserProfileSuccessResponse
 type UpdateUserProfileSuccessResponse = generated.UpdateUserProfileSuccessResponse
+type FollowUser = generated.FollowUser
+type ListFollowsSuccessResponse = generated.ListFollowsSuccessResponse
+type BlockedUser = generated.BlockedUser
+type ListBlockedUsersSuccessResponse = generated.ListBlockedUsersSuccessResponse
+type AvatarThumbnail = generated.AvatarThumbnail
+type Avatar = generated.Avatar
+type UploadAvatarSuccessResponse = generated.UploadAvatarSuccessResponse
+type ChangePasswordRequest = generated.ChangePasswordRequest
+type ChangeEmailRequest = generated.ChangeEmailRequest
+type ConfirmEmailChangeRequest = generated.ConfirmEmailChangeRequest
+type AccountDeletion = generated.AccountDeletion
+type AccountDeletionSuccessResponse = generated.AccountDeletionSuccessResponse
+
+// Personal access token endpoint types
+type PersonalAccessTokenScope = generated.PersonalAccessTokenScope
+type CreatePersonalAccessTokenRequest = generated.CreatePersonalAccessTokenRequest
+type PersonalAccessToken = generated.PersonalAccessToken
+type CreatedPersonalAccessToken = generated.CreatedPersonalAccessToken
+type CreatePersonalAccessTokenSuccessResponse = generated.CreatePersonalAccessTokenSuccessResponse
+type ListPersonalAccessTokensSuccessResponse = generated.ListPersonalAccessTokensSuccessResponse
 
 // Post endpoint types
 type Post = generated.Post // Shared Post schema
+type PostKind = generated.PostKind
+type ReferencedPost = generated.ReferencedPost
+type ReferencedPostKind = generated.ReferencedPostKind
 type CreatePostRequest = generated.CreatePostRequest
 type UpdatePostRequest = generated.UpdatePostRequest
 type CreatePostSuccessResponse = generated.CreatePostSuccessResponse
 type GetPostSuccessResponse = generated.GetPostSuccessResponse
 type UpdatePostSuccessResponse = generated.UpdatePostSuccessResponse
 type ListPostsSuccessResponse = generated.ListPostsSuccessResponse
+type HomeFeedSuccessResponse = generated.HomeFeedSuccessResponse
+type ListTagPostsSuccessResponse = generated.ListTagPostsSuccessResponse
+type TrendingTag = generated.TrendingTag
+type TrendingTagsSuccessResponse = generated.TrendingTagsSuccessResponse
 
 // Comment endpoint types
 type Comment = generated.Comment // Shared Comment schema
@@ -42,6 +106,20 @@ type GetCommentSuccessResponse = generated.GetCommentSuccessResponse
 type UpdateCommentSuccessResponse = generated.UpdateCommentSuccessResponse
 type ListCommentsSuccessResponse = generated.ListCommentsSuccessResponse
 
+// Admin endpoint types
+type UserRole = generated.UserRole
+type UpdateUserRoleRequest = generated.UpdateUserRoleRequest
+type UpdateUserRoleSuccessResponse = generated.UpdateUserRoleSuccessResponse
+type ModerationLogEntry = generated.ModerationLogEntry
+type ModerationLogEntryAction = generated.ModerationLogEntryAction
+type ModerationLogEntryTargetType = generated.ModerationLogEntryTargetType
+type ListModerationLogSuccessResponse = generated.ListModerationLogSuccessResponse
+type StartImpersonationRequest = generated.StartImpersonationRequest
+type Impersonation = generated.Impersonation
+type StartImpersonationSuccessResponse = generated.StartImpersonationSuccessResponse
+type ImpersonationAuditEntry = generated.ImpersonationAuditEntry
+type ListImpersonationLogSuccessResponse = generated.ListImpersonationLogSuccessResponse
+
 // Runtime Types (if needed directly, like Email)
 type Email = types.Email
 
@@ -0,0 +1,133 @@
+// Package blobstore implements interfaces.BlobStore.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// LocalBlobStore stores files in a directory of the local filesystem, one file per key.
+type LocalBlobStore struct {
+	dir string
+}
+
+func NewLocalBlobStore(dir string) *LocalBlobStore {
+	return &LocalBlobStore{dir: dir}
+}
+
+// Put writes the body to a temporary file first, so that readers never see a partial file.
+func (s *LocalBlobStore) Put(ctx context.Context, key string, body io.Reader) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("could not create blob directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("could not create blob file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		return fmt.Errorf("could not write blob file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("could not write blob file: %w", err)
+	}
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return fmt.Errorf("could not write blob file: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), filePath); err != nil {
+		return fmt.Errorf("could not store blob file: %w", err)
+	}
+
+	return nil
+}
+
+// Open derives the content type from the extension of the key.
+func (s *LocalBlobStore) Open(ctx context.Context, key string) (*domain.Blob, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, domain.ErrNotFound
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, domain.ErrNotFound
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return &domain.Blob{
+		Body:        file,
+		ContentType: contentType,
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("could not delete blob file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalBlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	dirPath, err := s.filePath(strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dirPath); err != nil {
+		return fmt.Errorf("could not delete blob directory: %w", err)
+	}
+
+	return nil
+}
+
+// filePath rejects keys that could escape the directory, such as ones containing "..".
+func (s *LocalBlobStore) filePath(key string) (string, error) {
+	if !fs.ValidPath(key) || key == "." {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,72 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/floroz/go-social/internal/blobstore"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStore_PutOpenDelete(t *testing.T) {
+	store := blobstore.NewLocalBlobStore(t.TempDir())
+	ctx := context.Background()
+
+	// Act: Store a file, then replace it
+	assert.NoError(t, store.Put(ctx, "avatars/1/abc/64.jpg", strings.NewReader("first")))
+	assert.NoError(t, store.Put(ctx, "avatars/1/abc/64.jpg", strings.NewReader("second")))
+
+	// Assert: The latest content is read back with a type derived from the key
+	blob, err := store.Open(ctx, "avatars/1/abc/64.jpg")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(blob.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, blob.Body.Close())
+	assert.Equal(t, "second", string(content))
+	assert.Equal(t, "image/jpeg", blob.ContentType)
+	assert.Equal(t, int64(6), blob.Size)
+
+	// Act & Assert: Deleted files are not found, and deleting them again is not an error
+	assert.NoError(t, store.Delete(ctx, "avatars/1/abc/64.jpg"))
+	assert.NoError(t, store.Delete(ctx, "avatars/1/abc/64.jpg"))
+	_, err = store.Open(ctx, "avatars/1/abc/64.jpg")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestLocalBlobStore_DeletePrefix(t *testing.T) {
+	store := blobstore.NewLocalBlobStore(t.TempDir())
+	ctx := context.Background()
+	for _, key := range []string{"avatars/1/abc/64.jpg", "avatars/1/def/64.jpg", "avatars/12/abc/64.jpg"} {
+		assert.NoError(t, store.Put(ctx, key, strings.NewReader("data")))
+	}
+
+	// Act
+	assert.NoError(t, store.DeletePrefix(ctx, "avatars/1/"))
+
+	// Assert: Only the files under the prefix are gone, and deleting them again is not an error
+	for _, key := range []string{"avatars/1/abc/64.jpg", "avatars/1/def/64.jpg"} {
+		_, err := store.Open(ctx, key)
+		assert.ErrorIs(t, err, domain.ErrNotFound, key)
+	}
+	blob, err := store.Open(ctx, "avatars/12/abc/64.jpg")
+	if assert.NoError(t, err) {
+		assert.NoError(t, blob.Body.Close())
+	}
+	assert.NoError(t, store.DeletePrefix(ctx, "avatars/1/"))
+}
+
+func TestLocalBlobStore_RejectsKeysOutsideTheDirectory(t *testing.T) {
+	store := blobstore.NewLocalBlobStore(t.TempDir())
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../secret", "/etc/passwd", "avatars/../../secret", "avatars/1"} {
+		_, err := store.Open(ctx, key)
+		assert.ErrorIs(t, err, domain.ErrNotFound, key)
+	}
+	assert.Error(t, store.Put(ctx, "../secret", strings.NewReader("data")))
+	assert.Error(t, store.Delete(ctx, "../secret"))
+	assert.Error(t, store.DeletePrefix(ctx, "../"))
+}
@@ -0,0 +1,77 @@
+// Package cursor turns positions in lists into opaque strings for keyset pagination, so that
+// clients do not depend on what a position is made of. Cursors are signed so that clients
+// cannot craft positions of their own.
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// signatureSize is the number of bytes of the HMAC-SHA256 kept in a cursor.
+const signatureSize = 16
+
+// Codec encodes and decodes cursors signed with a key.
+type Codec struct {
+	key []byte
+}
+
+func NewCodec(key []byte) *Codec {
+	return &Codec{key: key}
+}
+
+// Encode returns the opaque form of the cursor.
+func (c *Codec) Encode(position domain.Cursor) string {
+	payload := strconv.FormatInt(position.CreatedAt.UnixNano(), 10) + "," + strconv.FormatInt(position.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Decode parses a cursor returned by Encode with the same key.
+func (c *Codec) Decode(s string) (domain.Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(s, ".")
+	if !ok {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(string(payload))) {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(payload), ",")
+	if !ok {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+
+	return domain.Cursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: n}, nil
+}
+
+func (c *Codec) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)[:signatureSize]
+}
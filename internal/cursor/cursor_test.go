@@ -0,0 +1,45 @@
+package cursor_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	codec := cursor.NewCodec([]byte("secret"))
+	c := domain.Cursor{CreatedAt: time.Date(2025, 3, 1, 12, 30, 0, 123456000, time.UTC), ID: 42}
+
+	decoded, err := codec.Decode(codec.Encode(c))
+
+	assert.NoError(t, err)
+	assert.Equal(t, c, decoded)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	codec := cursor.NewCodec([]byte("secret"))
+
+	for _, s := range []string{"", "not base64!", "MTIz", "MTIzLDQ.", "MTIzLDQ.AAAA"} {
+		_, err := codec.Decode(s)
+
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor, s)
+	}
+}
+
+func TestDecode_RejectsTamperedAndForeignCursors(t *testing.T) {
+	codec := cursor.NewCodec([]byte("secret"))
+	encoded := codec.Encode(domain.Cursor{CreatedAt: time.Now(), ID: 42})
+	_, signature, _ := strings.Cut(encoded, ".")
+	tampered := base64.RawURLEncoding.EncodeToString([]byte("123,1")) + "." + signature
+
+	_, tamperedErr := codec.Decode(tampered)
+	_, foreignErr := cursor.NewCodec([]byte("other")).Decode(encoded)
+
+	assert.ErrorIs(t, tamperedErr, cursor.ErrInvalidCursor)
+	assert.ErrorIs(t, foreignErr, cursor.ErrInvalidCursor)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// AccountDeletionPolicy configures how long a deleted account can still be recovered and
+// how often the accounts past that period are purged.
+type AccountDeletionPolicy struct {
+	// GracePeriod is the time between a deletion request and the purge. Logging in during
+	// this period cancels the deletion.
+	GracePeriod time.Duration
+	// PurgeInterval is how often the background purge runs.
+	PurgeInterval time.Duration
+	// PurgeBatchSize caps the accounts purged per run.
+	PurgeBatchSize int
+}
+
+func DefaultAccountDeletionPolicy() *AccountDeletionPolicy {
+	return &AccountDeletionPolicy{
+		GracePeriod:    30 * 24 * time.Hour,
+		PurgeInterval:  time.Hour,
+		PurgeBatchSize: 100,
+	}
+}
+
+// AccountDeletion is a pending deletion of an account.
+type AccountDeletion struct {
+	PurgeAt time.Time `json:"purge_at"`
+}
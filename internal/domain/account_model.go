@@ -0,0 +1,18 @@
+package domain
+
+// ChangePasswordDTO replaces the password of the authenticated user.
+type ChangePasswordDTO struct {
+	CurrentPassword string `json:"current_password" validate:"required,min=8,max=50"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=50,nefield=CurrentPassword"`
+}
+
+// ChangeEmailDTO starts a change of email address, which takes effect once the new address
+// is confirmed.
+type ChangeEmailDTO struct {
+	Email    string `json:"email" validate:"required,min=3,max=50,email"`
+	Password string `json:"password" validate:"required,min=8,max=50"`
+}
+
+type ConfirmEmailChangeDTO struct {
+	Token string `json:"token" validate:"required"`
+}
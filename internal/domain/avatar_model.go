@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// AvatarPolicy configures the profile pictures users can upload.
+type AvatarPolicy struct {
+	// MaxBytes is the largest upload accepted.
+	MaxBytes int64
+	// MaxDimension is the largest width or height accepted, so that a small file cannot
+	// decode into a huge image.
+	MaxDimension int
+	// Sizes are the widths, in pixels, of the square thumbnails stored for each upload.
+	// The largest one is the profile picture.
+	Sizes []int
+}
+
+func DefaultAvatarPolicy() *AvatarPolicy {
+	return &AvatarPolicy{
+		MaxBytes:     5 << 20,
+		MaxDimension: 4096,
+		Sizes:        []int{64, 128, 256},
+	}
+}
+
+// AvatarContentTypes are the image formats accepted for profile pictures. Whatever the
+// format, thumbnails are stored as JPEG.
+var AvatarContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// AvatarThumbnail is a stored size of a profile picture.
+type AvatarThumbnail struct {
+	Size int    `json:"size"`
+	URL  string `json:"url"`
+}
+
+// Blob is a stored file opened for reading. Body must be closed by the caller.
+type Blob struct {
+	Body        io.ReadSeekCloser
+	ContentType string
+	Size        int64
+	ModTime     time.Time
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// BlockedUser is a user in the block or mute list of another user, with when they were
+// blocked or muted.
+type BlockedUser struct {
+	ID                int64     `json:"id"`
+	Username          string    `json:"username"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	ProfilePictureURL string    `json:"profile_picture_url"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -9,6 +9,7 @@ type Comment struct {
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	Reactions Reactions `json:"reactions"`
 }
 
 type EditableCommentFields struct {
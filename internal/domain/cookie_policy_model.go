@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Names of the cookies issued by the API.
+const (
+	AccessTokenCookie  = "access_token"
+	RefreshTokenCookie = "refresh_token"
+	// CSRFTokenCookie is readable by scripts, which echo it in the X-CSRF-Token header.
+	CSRFTokenCookie = "csrf_token"
+)
+
+// CookiePolicy sets the attributes shared by every cookie the API issues.
+type CookiePolicy struct {
+	SameSite http.SameSite
+	// Secure restricts the cookies to HTTPS. Browsers treat http://localhost as secure.
+	Secure bool
+	// Domain shares the cookies with subdomains; empty restricts them to the API host.
+	Domain string
+	Path   string
+}
+
+func DefaultCookiePolicy() *CookiePolicy {
+	return &CookiePolicy{
+		SameSite: http.SameSiteLaxMode,
+		Secure:   true,
+		Path:     "/",
+	}
+}
+
+// ParseSameSite parses "lax", "strict" or "none", case-insensitively.
+func ParseSameSite(value string) (http.SameSite, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("unknown SameSite mode %q", value)
+	}
+}
+
+// Validate rejects combinations that browsers refuse.
+func (p *CookiePolicy) Validate() error {
+	if p.SameSite == http.SameSiteNoneMode && !p.Secure {
+		return errors.New("SameSite=None cookies must be Secure")
+	}
+	if !strings.HasPrefix(p.Path, "/") {
+		return fmt.Errorf("cookie path %q must start with /", p.Path)
+	}
+	return nil
+}
+
+// NewCookie returns a cookie carrying the attributes of the policy.
+func (p *CookiePolicy) NewCookie(name, value string, expires time.Time, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     p.Path,
+		Domain:   p.Domain,
+		Expires:  expires,
+		Secure:   p.Secure,
+		HttpOnly: httpOnly,
+		SameSite: p.SameSite,
+	}
+}
+
+// ExpiredCookie returns a cookie that makes the browser delete the named cookie. The
+// attributes must match the ones the cookie was set with.
+func (p *CookiePolicy) ExpiredCookie(name string, httpOnly bool) *http.Cookie {
+	cookie := p.NewCookie(name, "", time.Unix(0, 0), httpOnly)
+	cookie.MaxAge = -1
+	return cookie
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VerifiedAction is an action that can be restricted to users with a verified email address.
+type VerifiedAction string
+
+const (
+	VerifiedActionPosting    VerifiedAction = "posting"
+	VerifiedActionCommenting VerifiedAction = "commenting"
+)
+
+// EmailVerificationPolicy decides which actions require a verified email address.
+type EmailVerificationPolicy struct {
+	requiredFor map[VerifiedAction]bool
+}
+
+func NewEmailVerificationPolicy(actions ...VerifiedAction) *EmailVerificationPolicy {
+	requiredFor := make(map[VerifiedAction]bool, len(actions))
+	for _, action := range actions {
+		requiredFor[action] = true
+	}
+	return &EmailVerificationPolicy{requiredFor: requiredFor}
+}
+
+// ParseEmailVerificationPolicy parses a comma separated list of actions, e.g. "posting,commenting".
+func ParseEmailVerificationPolicy(value string) (*EmailVerificationPolicy, error) {
+	actions := []VerifiedAction{}
+	for _, item := range strings.Split(value, ",") {
+		action := VerifiedAction(strings.TrimSpace(item))
+		switch action {
+		case "":
+			continue
+		case VerifiedActionPosting, VerifiedActionCommenting:
+			actions = append(actions, action)
+		default:
+			return nil, fmt.Errorf("unknown email verification action %q", action)
+		}
+	}
+	return NewEmailVerificationPolicy(actions...), nil
+}
+
+func (p *EmailVerificationPolicy) Requires(action VerifiedAction) bool {
+	return p != nil && p.requiredFor[action]
+}
+
+type VerifyEmailDTO struct {
+	Token string `json:"token" validate:"required"`
+}
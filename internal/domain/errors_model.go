@@ -4,11 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 var (
 	ErrNotFound                 = errors.New("not found")
 	ErrDuplicateEmailOrUsername = errors.New("email or username already exists")
+	ErrEmailNotVerified         = errors.New("email address is not verified")
+	ErrCannotFollowSelf         = errors.New("users cannot follow themselves")
+	ErrAlreadyFollowing         = errors.New("user is already followed")
+	ErrCannotBlockSelf          = errors.New("users cannot block or mute themselves")
+	ErrBlocked                  = errors.New("user is blocked")
+	ErrAlreadyReposted          = errors.New("post is already reposted")
 )
 
 type ErrorDetail struct {
@@ -124,3 +131,82 @@ func NewForbiddenError(message string) error {
 		},
 	}
 }
+
+type TooManyRequestsError struct {
+	ErrorDetail
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return e.Message
+}
+
+func NewTooManyRequestsError(message string, retryAfter time.Duration) error {
+	return &TooManyRequestsError{
+		StatusCode: http.StatusTooManyRequests,
+		RetryAfter: retryAfter,
+		ErrorDetail: ErrorDetail{
+			Message: message,
+		},
+	}
+}
+
+// PayloadTooLargeError is returned when an uploaded file exceeds the allowed size.
+type PayloadTooLargeError struct {
+	ErrorDetail
+	StatusCode int
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return e.Message
+}
+
+func NewPayloadTooLargeError(message string) error {
+	return &PayloadTooLargeError{
+		StatusCode: http.StatusRequestEntityTooLarge,
+		ErrorDetail: ErrorDetail{
+			Message: message,
+		},
+	}
+}
+
+// UnsupportedMediaTypeError is returned when an uploaded file is not of an accepted type.
+type UnsupportedMediaTypeError struct {
+	ErrorDetail
+	StatusCode int
+}
+
+func (e *UnsupportedMediaTypeError) Error() string {
+	return e.Message
+}
+
+func NewUnsupportedMediaTypeError(message string) error {
+	return &UnsupportedMediaTypeError{
+		StatusCode: http.StatusUnsupportedMediaType,
+		ErrorDetail: ErrorDetail{
+			Message: message,
+		},
+	}
+}
+
+// AccountLockedError is returned when logins are temporarily blocked after too many failures.
+type AccountLockedError struct {
+	ErrorDetail
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *AccountLockedError) Error() string {
+	return e.Message
+}
+
+func NewAccountLockedError(message string, retryAfter time.Duration) error {
+	return &AccountLockedError{
+		StatusCode: http.StatusTooManyRequests,
+		RetryAfter: retryAfter,
+		ErrorDetail: ErrorDetail{
+			Message: message,
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// FollowUser is a user in a list of followers or followed users, with when the follow
+// started.
+type FollowUser struct {
+	ID                int64     `json:"id"`
+	Username          string    `json:"username"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	ProfilePictureURL string    `json:"profile_picture_url"`
+	FollowedAt        time.Time `json:"followed_at"`
+}
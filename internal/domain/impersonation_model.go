@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// ImpersonationPolicy configures the sessions in which an admin acts as another user.
+type ImpersonationPolicy struct {
+	// TTL is how long an impersonation session, and its access token, lasts. It cannot be
+	// extended: there is no refresh token.
+	TTL time.Duration
+}
+
+func DefaultImpersonationPolicy() *ImpersonationPolicy {
+	return &ImpersonationPolicy{
+		TTL: 30 * time.Minute,
+	}
+}
+
+// Actor is the user acting on behalf of the subject of an access token. It is carried in the
+// "act" claim, as in RFC 8693.
+type Actor struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// ImpersonationSession is a time-limited session in which an admin (the actor) sees the app
+// as another user (the subject). Its ID is carried in access tokens as the "sid" claim.
+type ImpersonationSession struct {
+	ID        string     `json:"id"`
+	ActorID   int64      `json:"actor_id"`
+	SubjectID int64      `json:"subject_id"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// IsActive reports whether the session has neither been ended nor expired.
+func (s *ImpersonationSession) IsActive() bool {
+	return s.EndedAt == nil && time.Now().Before(s.ExpiresAt)
+}
+
+type StartImpersonationDTO struct {
+	// Reason is recorded with the session, e.g. the support ticket being worked on.
+	Reason string `json:"reason" validate:"required,min=3,max=500"`
+}
+
+// ImpersonationAuditEntry records a request made with an impersonation session.
+type ImpersonationAuditEntry struct {
+	ID              int64     `json:"id"`
+	ImpersonationID string    `json:"impersonation_id"`
+	ActorID         int64     `json:"actor_id"`
+	SubjectID       int64     `json:"subject_id"`
+	Method          string    `json:"method"`
+	Path            string    `json:"path"`
+	Status          int       `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
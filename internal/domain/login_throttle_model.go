@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// LoginThrottlePolicy configures how failed logins lock accounts and client IPs.
+type LoginThrottlePolicy struct {
+	// MaxAccountFailures is the number of consecutive failed logins after which an account is locked.
+	MaxAccountFailures int
+	// MaxIPFailures is the number of failed logins from one IP after which the IP is locked.
+	MaxIPFailures int
+	// IPFailureWindow is how long failures from an IP are remembered after the last one.
+	IPFailureWindow time.Duration
+	// BaseLockout is the first lock duration, doubled for every further failure.
+	BaseLockout time.Duration
+	// MaxLockout caps the lock duration.
+	MaxLockout time.Duration
+}
+
+func DefaultLoginThrottlePolicy() *LoginThrottlePolicy {
+	return &LoginThrottlePolicy{
+		MaxAccountFailures: 5,
+		MaxIPFailures:      20,
+		IPFailureWindow:    time.Hour,
+		BaseLockout:        time.Minute,
+		MaxLockout:         time.Hour,
+	}
+}
+
+// LockoutFor returns how long to lock after the given number of failures, or zero when the
+// threshold has not been reached. Every failure past the threshold doubles the lock.
+func (p *LoginThrottlePolicy) LockoutFor(failures, threshold int) time.Duration {
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for i := threshold; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, p.MaxLockout)
+}
+
+type IPLoginFailure struct {
+	IPAddress      string     `json:"ip_address"`
+	FailedAttempts int        `json:"failed_attempts"`
+	LastFailedAt   time.Time  `json:"last_failed_at"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// MagicLinkPolicy configures the login links sent by email.
+type MagicLinkPolicy struct {
+	// TokenTTL is how long a link can be used.
+	TokenTTL time.Duration
+	// Window is the period over which requests are counted.
+	Window time.Duration
+	// MaxPerEmail is the number of links sent to one address per window. Further requests are
+	// accepted but send nothing, so that the limit does not reveal whether an account exists.
+	MaxPerEmail int
+	// MaxPerIP is the number of requests accepted from one client IP per window.
+	MaxPerIP int
+}
+
+func DefaultMagicLinkPolicy() *MagicLinkPolicy {
+	return &MagicLinkPolicy{
+		TokenTTL:    15 * time.Minute,
+		Window:      time.Hour,
+		MaxPerEmail: 3,
+		MaxPerIP:    10,
+	}
+}
+
+type MagicLinkRequestDTO struct {
+	Email string `json:"email" validate:"required,min=3,max=50,email"`
+}
+
+type MagicLinkLoginDTO struct {
+	Token string `json:"token" validate:"required"`
+}
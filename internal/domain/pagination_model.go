@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// Cursor is a position in a list ordered by creation time then id, both descending. The
+// next page starts after the item at the position.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// PageRequest selects up to Limit items of a list ordered by creation time then id, both
+// descending: the items after the cursor when there is one, otherwise the items after
+// skipping Offset. Offsets are deprecated in favor of cursors.
+type PageRequest struct {
+	After  *Cursor
+	Offset int
+	Limit  int
+}
+
+// PostPage is a page of posts with the cursor of the next page, empty on the last page.
+type PostPage struct {
+	Posts      []Post
+	NextCursor string
+}
+
+// CommentPage is a page of comments with the cursor of the next page, empty on the last page.
+type CommentPage struct {
+	Comments   []Comment
+	NextCursor string
+}
+
+// FollowPage is a page of followers or followed users with the cursor of the next page,
+// empty on the last page.
+type FollowPage struct {
+	Users      []FollowUser
+	NextCursor string
+}
+
+// UserPage is a page of users with the cursor of the next page, empty on the last page.
+type UserPage struct {
+	Users      []User
+	NextCursor string
+}
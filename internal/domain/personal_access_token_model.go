@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// Scope is a permission granted to a personal access token.
+type Scope string
+
+const (
+	ScopeUsersRead     Scope = "users:read"
+	ScopeUsersWrite    Scope = "users:write"
+	ScopePostsRead     Scope = "posts:read"
+	ScopePostsWrite    Scope = "posts:write"
+	ScopeCommentsRead  Scope = "comments:read"
+	ScopeCommentsWrite Scope = "comments:write"
+)
+
+// PersonalAccessTokenPrefix marks personal access tokens, so that they can be told apart
+// from other bearer credentials and spotted by secret scanners.
+const PersonalAccessTokenPrefix = "gsp_"
+
+// PersonalAccessToken authenticates scripts and integrations on behalf of a user, limited
+// to its scopes. Token holds the raw value only right after creation; only its hash is stored.
+type PersonalAccessToken struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"-"`
+	TokenHash  string     `json:"-"`
+	Scopes     []Scope    `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type CreatePersonalAccessTokenDTO struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Scopes    []Scope    `json:"scopes" validate:"required,min=1,dive,oneof=users:read users:write posts:read posts:write comments:read comments:write"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
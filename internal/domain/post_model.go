@@ -4,6 +4,16 @@ import (
 	"time"
 )
 
+type PostKind string
+
+const (
+	PostKindPost PostKind = "post"
+	// PostKindRepost shares another post as is, without content of its own.
+	PostKindRepost PostKind = "repost"
+	// PostKindQuote shares another post next to content of its own.
+	PostKindQuote PostKind = "quote"
+)
+
 type Post struct {
 	ID        int64     `json:"id"`
 	UserID    int64     `json:"user_id"`
@@ -11,14 +21,32 @@ type Post struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 	Comments  []Comment `json:"comments"`
+	Reactions Reactions `json:"reactions"`
+	Kind      PostKind  `json:"kind"`
+	// ReferencedPostID is the post shared by a repost or quote post.
+	ReferencedPostID *int64 `json:"referenced_post_id"`
+	// ReferencedPost is the shared post, nil when it was deleted or is hidden from the viewer.
+	ReferencedPost *Post `json:"referenced_post"`
 }
 
 type EditablePostFields struct {
 	Content string `json:"content" validate:"required,min=1,max=1000"`
+	// Tags are the hashtags of the content, set by the post service rather than by clients.
+	Tags []string `json:"-"`
 }
 
 type CreatePostDTO struct {
 	EditablePostFields
+	// QuotedPostID makes the post a quote post of another post.
+	QuotedPostID *int64 `json:"quoted_post_id" validate:"omitempty,min=1"`
+}
+
+// Kind is the kind of the post created: a quote post when it quotes another post.
+func (dto *CreatePostDTO) Kind() PostKind {
+	if dto.QuotedPostID != nil {
+		return PostKindQuote
+	}
+	return PostKindPost
 }
 
 type UpdatePostDTO struct {
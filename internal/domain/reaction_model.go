@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type ReactionTargetType string
+
+const (
+	ReactionTargetPost    ReactionTargetType = "post"
+	ReactionTargetComment ReactionTargetType = "comment"
+)
+
+// ReactionTarget is the post or comment a reaction is left on.
+type ReactionTarget struct {
+	Type ReactionTargetType
+	ID   int64
+}
+
+// Reactions summarizes the reactions on a post or comment for a viewer.
+type Reactions struct {
+	// Counts by reaction type, leaving out the types nobody reacted with.
+	Counts map[string]int64 `json:"counts"`
+	// Mine lists the types the viewer reacted with.
+	Mine []string `json:"mine"`
+}
+
+var reactionTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// ReactionSet is the set of reaction types users can leave on posts and comments.
+type ReactionSet struct {
+	types []string
+}
+
+func DefaultReactionSet() *ReactionSet {
+	return &ReactionSet{types: []string{"like", "love", "laugh", "wow", "sad", "angry"}}
+}
+
+// ParseReactionSet parses a comma separated list of reaction types, e.g. "like,love,laugh".
+// Types are lowercase words of up to 32 characters. An empty list gives the default set.
+func ParseReactionSet(value string) (*ReactionSet, error) {
+	types := []string{}
+	for _, item := range strings.Split(value, ",") {
+		reactionType := strings.TrimSpace(item)
+		switch {
+		case reactionType == "":
+			continue
+		case !reactionTypePattern.MatchString(reactionType):
+			return nil, fmt.Errorf("invalid reaction type %q", reactionType)
+		case !slices.Contains(types, reactionType):
+			types = append(types, reactionType)
+		}
+	}
+	if len(types) == 0 {
+		return DefaultReactionSet(), nil
+	}
+	return &ReactionSet{types: types}, nil
+}
+
+func (s *ReactionSet) Allows(reactionType string) bool {
+	return s != nil && slices.Contains(s.types, reactionType)
+}
+
+func (s *ReactionSet) Types() []string {
+	return slices.Clone(s.types)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+type RefreshToken struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	FamilyID  string     `json:"family_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Token holds the raw token value. It is only set right after issuing and is never persisted.
+	Token string `json:"-"`
+}
+
+// RefreshTokenDTO carries the refresh token in the request body, for clients without cookies.
+type RefreshTokenDTO struct {
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Role is stored on every user and decides which permissions they hold.
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// Permission is an action that is not granted by ownership alone.
+type Permission string
+
+const (
+	// PermissionModeratePosts allows editing and deleting posts of other users.
+	PermissionModeratePosts Permission = "posts:moderate"
+	// PermissionModerateComments allows editing and deleting comments of other users.
+	PermissionModerateComments Permission = "comments:moderate"
+	// PermissionReadModerationLog allows reading the audit trail of moderation actions.
+	PermissionReadModerationLog Permission = "moderation_log:read"
+	// PermissionManageRoles allows changing the role of other users.
+	PermissionManageRoles Permission = "users:manage_roles"
+	// PermissionImpersonateUsers allows acting as another user, e.g. to debug their issues.
+	PermissionImpersonateUsers Permission = "users:impersonate"
+)
+
+// rolePermissions is the source of truth for what each role may do. Roles are assigned
+// in the database; their permissions are defined here so that they are reviewed with the code.
+var rolePermissions = map[Role][]Permission{
+	RoleUser: {},
+	RoleModerator: {
+		PermissionModeratePosts,
+		PermissionModerateComments,
+		PermissionReadModerationLog,
+	},
+	RoleAdmin: {
+		PermissionModeratePosts,
+		PermissionModerateComments,
+		PermissionReadModerationLog,
+		PermissionManageRoles,
+		PermissionImpersonateUsers,
+	},
+}
+
+// IsValid reports whether the role is one of the known roles.
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// HasPermission reports whether the role grants the permission. Unknown roles grant nothing.
+func (r Role) HasPermission(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+type UpdateUserRoleDTO struct {
+	Role Role `json:"role" validate:"required,oneof=user moderator admin"`
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionUpdate ModerationAction = "update"
+	ModerationActionDelete ModerationAction = "delete"
+)
+
+type ModerationTargetType string
+
+const (
+	ModerationTargetPost    ModerationTargetType = "post"
+	ModerationTargetComment ModerationTargetType = "comment"
+)
+
+// ModeratedResource is a piece of content a user wants to act on.
+type ModeratedResource struct {
+	Type    ModerationTargetType
+	ID      int64
+	OwnerID int64
+	Content string
+}
+
+// ModerationLogEntry records an action taken on content owned by another user, together
+// with the content as it was before the action.
+type ModerationLogEntry struct {
+	ID              int64                `json:"id"`
+	ActorID         int64                `json:"actor_id"`
+	ActorRole       Role                 `json:"actor_role"`
+	Action          ModerationAction     `json:"action"`
+	TargetType      ModerationTargetType `json:"target_type"`
+	TargetID        int64                `json:"target_id"`
+	TargetUserID    int64                `json:"target_user_id"`
+	PreviousContent string               `json:"previous_content"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
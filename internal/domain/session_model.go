@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Session is a logged-in device. Its ID doubles as the refresh token family ID
+// and is carried in access tokens as the "sid" claim.
+type Session struct {
+	ID         string     `json:"id"`
+	UserID     int64      `json:"user_id"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// SessionClient describes the client a session is created for.
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
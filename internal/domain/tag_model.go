@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// TrendingTag is a tag with the number of posts using it within the trending window.
+type TrendingTag struct {
+	Tag       string `json:"tag"`
+	PostCount int64  `json:"post_count"`
+}
+
+// TagPolicy configures the hashtags of posts.
+type TagPolicy struct {
+	// TrendingWindow is how far back posts count towards the trending tags. The window slides
+	// with time, so tags stop trending as their posts age.
+	TrendingWindow time.Duration
+}
+
+func DefaultTagPolicy() *TagPolicy {
+	return &TagPolicy{
+		TrendingWindow: 24 * time.Hour,
+	}
+}
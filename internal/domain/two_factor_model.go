@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// UserTOTP is the TOTP second factor of a user. It is pending until the enrollment is
+// confirmed with a first valid code.
+type UserTOTP struct {
+	UserID         int64      `json:"user_id"`
+	Secret         string     `json:"-"`
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep   int64      `json:"-"`
+	FailedAttempts int        `json:"-"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Enabled reports whether the second factor is required at login.
+func (t *UserTOTP) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// TOTPEnrollment is handed to the user to register the secret in an authenticator app.
+type TOTPEnrollment struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type TwoFactorStatus struct {
+	Enabled                bool `json:"enabled"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+// MFAChallengeCookie carries the challenge token of a login with an external provider, which
+// redirects the browser and so cannot return the token in a response body.
+const MFAChallengeCookie = "mfa_challenge"
+
+// MFAChallenge is returned by a login with valid credentials when a second factor is required.
+// Its token is exchanged, together with a code, for a session.
+type MFAChallenge struct {
+	Token     string    `json:"challenge_token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ConfirmTOTPDTO struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+// VerifyMFAChallengeDTO completes a login; Code is either a TOTP code or a recovery code. The
+// challenge token is read from the MFAChallengeCookie when the request does not carry it.
+type VerifyMFAChallengeDTO struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=32"`
+}
+
+// PasswordConfirmationDTO re-authenticates the user before a sensitive change.
+type PasswordConfirmationDTO struct {
+	Password string `json:"password" validate:"required,min=8,max=50"`
+}
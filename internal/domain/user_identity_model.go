@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// OIDCStateCookie binds a login with an external provider to the browser that started it.
+const OIDCStateCookie = "oidc_state"
+
+// UserIdentity links the account of a user at an external OpenID Connect provider, identified
+// by the provider name and the subject the provider assigned, to a user.
+type UserIdentity struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"user_id"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"subject"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+// OIDCLoginState is a login with an external provider in progress. It holds the secrets the
+// callback checks: the nonce of the ID token and the PKCE code verifier. Only the hash of the
+// state handed to the provider is stored.
+type OIDCLoginState struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// OIDCLogin is the redirection that starts a login with an external provider.
+type OIDCLogin struct {
+	AuthURL   string
+	State     string
+	ExpiresAt time.Time
+}
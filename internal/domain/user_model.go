@@ -1,28 +1,68 @@
 package domain
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
 type User struct {
-	ID        int64      `json:"id"`
-	FirstName string     `json:"first_name"`
-	LastName  string     `json:"last_name"`
-	Username  string     `json:"username"`
-	Password  string     `json:"-"`
-	Email     string     `json:"email"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	LastLogin *time.Time `json:"last_login,omitempty"`
+	ID                  int64      `json:"id"`
+	FirstName           string     `json:"first_name"`
+	LastName            string     `json:"last_name"`
+	Username            string     `json:"username"`
+	Password            string     `json:"-"`
+	Email               string     `json:"email"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	LastLogin           *time.Time `json:"last_login,omitempty"`
+	EmailVerifiedAt     *time.Time `json:"email_verified_at,omitempty"`
+	FailedLoginAttempts int        `json:"-"`
+	LockedUntil         *time.Time `json:"-"`
+	Role                Role       `json:"role"`
+	Bio                 string     `json:"bio"`
+	ProfilePictureURL   string     `json:"profile_picture_url"`
+}
+
+// PublicProfile is what any user can see of another user. It leaves out the email address
+// and the activity of the account, such as its last login.
+type PublicProfile struct {
+	ID                int64     `json:"id"`
+	Username          string    `json:"username"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	Bio               string    `json:"bio"`
+	ProfilePictureURL string    `json:"profile_picture_url"`
+	JoinedAt          time.Time `json:"joined_at"`
+	PostCount         int64     `json:"post_count"`
+	CommentCount      int64     `json:"comment_count"`
+	FollowerCount     int64     `json:"follower_count"`
+	FollowingCount    int64     `json:"following_count"`
+}
+
+// HasPassword reports whether the user can log in with a password. Users created through a
+// social login have none until they set one.
+func (u *User) HasPassword() bool {
+	return u.Password != ""
+}
+
+// reservedUsernames are the static segments of the /v1/users routes, which would otherwise
+// shadow the routes of users with the same username.
+var reservedUsernames = []string{"blocks", "mutes", "tokens"}
+
+// IsReservedUsername reports whether username is a static segment of the /v1/users routes,
+// and so cannot be taken by a user.
+func IsReservedUsername(username string) bool {
+	return slices.Contains(reservedUsernames, strings.ToLower(username))
 }
 
 type EditableUserField struct {
 	FirstName string `json:"first_name" validate:"required,min=3,max=50"`
 	LastName  string `json:"last_name" validate:"required,min=3,max=50"`
 	Email     string `json:"email" validate:"required,min=3,max=50,email"`
-	Username  string `json:"username" validate:"required,min=3,max=50,alphanum"`
+	Username  string `json:"username" validate:"required,min=3,max=50,alphanum,notreserved"`
 }
 
 type CreateUserDTO struct {
@@ -30,8 +70,19 @@ type CreateUserDTO struct {
 	Password string `json:"password" validate:"required,min=8,max=50"`
 }
 
+// UpdateUserDTO changes the profile of a user. The email address is changed through a
+// separate flow that confirms the new address, see ChangeEmailDTO.
 type UpdateUserDTO struct {
-	EditableUserField
+	FirstName string `json:"first_name" validate:"required,min=3,max=50"`
+	LastName  string `json:"last_name" validate:"required,min=3,max=50"`
+	Username  string `json:"username" validate:"required,min=3,max=50,alphanum,notreserved"`
+	// Bio is left unchanged when omitted.
+	Bio *string `json:"bio" validate:"omitempty,max=500"`
+	// Email is accepted and ignored, so that clients written when the profile update
+	// changed the address keep working.
+	//
+	// Deprecated: the address is changed through ChangeEmailDTO.
+	Email *string `json:"email"`
 }
 
 type LoginUserDTO struct {
@@ -47,5 +98,33 @@ type UserClaims struct {
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	SessionID string    `json:"sid,omitempty"`
+	Role      Role      `json:"role,omitempty"`
+	// Actor is set when an admin impersonates the user: the claims describe the subject,
+	// and the actor is the admin making the requests.
+	Actor *Actor `json:"act,omitempty"`
+	// AccessTokenID and Scopes are set when the request is authenticated with a personal
+	// access token instead of a session. They are never part of a JWT.
+	AccessTokenID int64   `json:"-"`
+	Scopes        []Scope `json:"-"`
 	jwt.StandardClaims
 }
+
+// HasScope reports whether the request may act within the scope. Sessions carry every
+// scope; personal access tokens only the ones they were created with.
+func (c *UserClaims) HasScope(scope Scope) bool {
+	if c.AccessTokenID == 0 {
+		return true
+	}
+	return slices.Contains(c.Scopes, scope)
+}
+
+// HasPermission reports whether the role of the user grants the permission.
+func (c *UserClaims) HasPermission(permission Permission) bool {
+	return c.Role.HasPermission(permission)
+}
+
+// IsImpersonated reports whether the request is made by an admin impersonating the user.
+func (c *UserClaims) IsImpersonated() bool {
+	return c.Actor != nil
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type TokenPurpose string
+
+const (
+	TokenPurposePasswordReset     TokenPurpose = "password_reset"
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+	TokenPurposeMFAChallenge      TokenPurpose = "mfa_challenge"
+	TokenPurposeEmailChange       TokenPurpose = "email_change"
+	TokenPurposeMagicLogin        TokenPurpose = "magic_login"
+)
+
+// UserToken is a single-use token delivered to a user out of band, e.g. by email, or handed
+// out to continue a multi-step flow such as a login requiring a second factor.
+type UserToken struct {
+	ID        int64        `json:"id"`
+	UserID    int64        `json:"user_id"`
+	Purpose   TokenPurpose `json:"purpose"`
+	TokenHash string       `json:"-"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	CreatedAt time.Time    `json:"created_at"`
+	UsedAt    *time.Time   `json:"used_at,omitempty"`
+}
+
+type ForgotPasswordDTO struct {
+	Email string `json:"email" validate:"required,min=3,max=50,email"`
+}
+
+type ResetPasswordDTO struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=50"`
+}
@@ -2,13 +2,16 @@ package env
 
 import (
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog/log"
 )
 
-func GetJWTSecret() string {
-	return GetEnvValue("JWT_SECRET")
+// GetAppURL returns the base URL of the frontend, used to build links sent to users.
+func GetAppURL() string {
+	return GetEnvValue("APP_URL")
 }
 
 func GetEnvValue(key string) string {
@@ -20,6 +23,48 @@ func GetEnvValue(key string) string {
 	return value
 }
 
+// GetIntValue returns the integer value of the key, or the fallback when unset or invalid.
+func GetIntValue(key string, fallback int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Warn().Msgf("Key %s is not a valid integer, using %d", key, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// GetBoolValue returns the boolean value of the key (e.g. "true", "0"), or the fallback when unset or invalid.
+func GetBoolValue(key string, fallback bool) bool {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Warn().Msgf("Key %s is not a valid boolean, using %t", key, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// GetDurationValue returns the duration value of the key (e.g. "15m"), or the fallback when unset or invalid.
+func GetDurationValue(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		log.Warn().Msgf("Key %s is not a valid duration, using %s", key, fallback)
+		return fallback
+	}
+	return parsed
+}
+
 func MustLoadEnv(filenames ...string) {
 	err := godotenv.Load(filenames...)
 	if err != nil {
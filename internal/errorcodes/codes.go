@@ -12,4 +12,14 @@ const (
 	CodeConflict            ApiErrorCode = "GOSOCIAL-005-CONFLICT"
 	CodeValidationError     ApiErrorCode = "GOSOCIAL-006-VALIDATION_ERROR"
 	CodeInternalServerError ApiErrorCode = "GOSOCIAL-007-INTERNAL_SERVER_ERROR"
+	CodeEmailNotVerified    ApiErrorCode = "GOSOCIAL-008-EMAIL_NOT_VERIFIED"
+	CodeTooManyRequests     ApiErrorCode = "GOSOCIAL-009-TOO_MANY_REQUESTS"
+	CodeAccountLocked       ApiErrorCode = "GOSOCIAL-010-ACCOUNT_LOCKED"
+	CodePayloadTooLarge     ApiErrorCode = "GOSOCIAL-011-PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia    ApiErrorCode = "GOSOCIAL-012-UNSUPPORTED_MEDIA_TYPE"
+	CodeCannotFollowSelf    ApiErrorCode = "GOSOCIAL-013-CANNOT_FOLLOW_SELF"
+	CodeAlreadyFollowing    ApiErrorCode = "GOSOCIAL-014-ALREADY_FOLLOWING"
+	CodeCannotBlockSelf     ApiErrorCode = "GOSOCIAL-015-CANNOT_BLOCK_SELF"
+	CodeBlocked             ApiErrorCode = "GOSOCIAL-016-BLOCKED"
+	CodeAlreadyReposted     ApiErrorCode = "GOSOCIAL-017-ALREADY_REPOSTED"
 )
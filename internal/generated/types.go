@@ -27,6 +27,66 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for JSONWebKeyAlg.
+const (
+	EdDSA JSONWebKeyAlg = "EdDSA"
+	RS256 JSONWebKeyAlg = "RS256"
+)
+
+// Defines values for ModerationLogEntryAction.
+const (
+	Delete ModerationLogEntryAction = "delete"
+	Update ModerationLogEntryAction = "update"
+)
+
+// Defines values for ModerationLogEntryTargetType.
+const (
+	ModerationLogEntryTargetTypeComment ModerationLogEntryTargetType = "comment"
+	ModerationLogEntryTargetTypePost    ModerationLogEntryTargetType = "post"
+)
+
+// Defines values for PersonalAccessTokenScope.
+const (
+	CommentsRead  PersonalAccessTokenScope = "comments:read"
+	CommentsWrite PersonalAccessTokenScope = "comments:write"
+	PostsRead     PersonalAccessTokenScope = "posts:read"
+	PostsWrite    PersonalAccessTokenScope = "posts:write"
+	UsersRead     PersonalAccessTokenScope = "users:read"
+	UsersWrite    PersonalAccessTokenScope = "users:write"
+)
+
+// Defines values for PostKind.
+const (
+	PostKindPost   PostKind = "post"
+	PostKindQuote  PostKind = "quote"
+	PostKindRepost PostKind = "repost"
+)
+
+// Defines values for ReferencedPostKind.
+const (
+	ReferencedPostKindPost  ReferencedPostKind = "post"
+	ReferencedPostKindQuote ReferencedPostKind = "quote"
+)
+
+// Defines values for UserRole.
+const (
+	UserRoleAdmin     UserRole = "admin"
+	UserRoleModerator UserRole = "moderator"
+	UserRoleUser      UserRole = "user"
+)
+
+// AccountDeletion Pending deletion of the account of the user.
+type AccountDeletion struct {
+	// PurgeAt When the account will be purged, unless the user logs in before then.
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// AccountDeletionSuccessResponse Standard wrapper for the successful account deletion response.
+type AccountDeletionSuccessResponse struct {
+	// Data Pending deletion of the account of the user.
+	Data AccountDeletion `json:"data"`
+}
+
 // ApiError defines model for ApiError.
 type ApiError struct {
 	// Code An application-specific error code.
@@ -45,6 +105,61 @@ type ApiErrorResponse struct {
 	Errors []ApiError `json:"errors"`
 }
 
+// Avatar The uploaded profile picture, stored as thumbnails.
+type Avatar struct {
+	// ProfilePictureUrl URL of the largest thumbnail, now the profile picture of the user.
+	ProfilePictureUrl string            `json:"profile_picture_url"`
+	Thumbnails        []AvatarThumbnail `json:"thumbnails"`
+}
+
+// AvatarThumbnail A square thumbnail of the profile picture.
+type AvatarThumbnail struct {
+	// Size Width and height of the thumbnail, in pixels.
+	Size int `json:"size"`
+
+	// Url URL serving the thumbnail as a JPEG image.
+	Url string `json:"url"`
+}
+
+// BlockedUser A user in the block or mute list of the authenticated user.
+type BlockedUser struct {
+	// CreatedAt When the user was blocked or muted.
+	CreatedAt time.Time `json:"created_at"`
+
+	// FirstName User's first name.
+	FirstName string `json:"first_name"`
+
+	// Id Unique identifier for the user.
+	Id int64 `json:"id"`
+
+	// LastName User's last name.
+	LastName string `json:"last_name"`
+
+	// ProfilePictureUrl URL of the profile picture, if the user has one.
+	ProfilePictureUrl *string `json:"profile_picture_url,omitempty"`
+
+	// Username Username of the user.
+	Username string `json:"username"`
+}
+
+// ChangeEmailRequest New email address and current password of the user.
+type ChangeEmailRequest struct {
+	// Email New email address. It replaces the current one once confirmed.
+	Email openapi_types.Email `json:"email"`
+
+	// Password Current password.
+	Password string `json:"password"`
+}
+
+// ChangePasswordRequest Current and new password of the user.
+type ChangePasswordRequest struct {
+	// CurrentPassword Current password.
+	CurrentPassword string `json:"current_password"`
+
+	// NewPassword New password, different from the current one.
+	NewPassword string `json:"new_password"`
+}
+
 // Comment Represents a comment on a post.
 type Comment struct {
 	// Content The text content of the comment.
@@ -56,9 +171,15 @@ type Comment struct {
 	// Id Unique identifier for the comment.
 	Id *int64 `json:"id,omitempty"`
 
+	// MyReactions Reaction types the authenticated user reacted to the comment with.
+	MyReactions *[]string `json:"my_reactions,omitempty"`
+
 	// PostId ID of the post this comment belongs to.
 	PostId *int64 `json:"post_id,omitempty"`
 
+	// ReactionCounts Number of reactions on the comment by type, leaving out the types nobody reacted with.
+	ReactionCounts *map[string]int64 `json:"reaction_counts,omitempty"`
+
 	// UpdatedAt Timestamp when the comment was last updated.
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
@@ -66,6 +187,18 @@ type Comment struct {
 	UserId *int64 `json:"user_id,omitempty"`
 }
 
+// ConfirmEmailChangeRequest Token sent to the new email address.
+type ConfirmEmailChangeRequest struct {
+	// Token Token from the confirmation email.
+	Token string `json:"token"`
+}
+
+// ConfirmTOTPRequest Data required to confirm a two-factor enrollment.
+type ConfirmTOTPRequest struct {
+	// Code Current 6-digit code from the authenticator app.
+	Code string `json:"code"`
+}
+
 // CreateCommentRequest Data required to create a new comment on a post.
 type CreateCommentRequest struct {
 	// Content The text content of the comment.
@@ -78,10 +211,30 @@ type CreateCommentSuccessResponse struct {
 	Data Comment `json:"data"`
 }
 
+// CreatePersonalAccessTokenRequest Fields required to create a personal access token.
+type CreatePersonalAccessTokenRequest struct {
+	// ExpiresAt Optional expiry of the token. Tokens without one stay valid until revoked.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name Name to recognise the token by.
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to the token.
+	Scopes []PersonalAccessTokenScope `json:"scopes"`
+}
+
+// CreatePersonalAccessTokenSuccessResponse Standard wrapper for the successful personal access token creation response.
+type CreatePersonalAccessTokenSuccessResponse struct {
+	Data CreatedPersonalAccessToken `json:"data"`
+}
+
 // CreatePostRequest Data required to create a new post.
 type CreatePostRequest struct {
 	// Content The text content of the post.
 	Content string `json:"content"`
+
+	// QuotedPostId ID of the post to quote. Quoting a repost quotes the post it shares.
+	QuotedPostId *int64 `json:"quoted_post_id,omitempty"`
 }
 
 // CreatePostSuccessResponse Standard wrapper for the successful post creation response.
@@ -90,6 +243,57 @@ type CreatePostSuccessResponse struct {
 	Data Post `json:"data"`
 }
 
+// CreatedPersonalAccessToken defines model for CreatedPersonalAccessToken.
+type CreatedPersonalAccessToken struct {
+	// CreatedAt Timestamp when the token was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt Expiry of the token, if any.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id Unique identifier for the token.
+	Id int64 `json:"id"`
+
+	// LastUsedAt Timestamp when the token was last used, if ever.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	// Name Name to recognise the token by.
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to the token.
+	Scopes []PersonalAccessTokenScope `json:"scopes"`
+
+	// Token The token value. Store it safely; it cannot be retrieved again.
+	Token string `json:"token"`
+}
+
+// FollowUser A user in a list of followers or followed users.
+type FollowUser struct {
+	// FirstName User's first name.
+	FirstName string `json:"first_name"`
+
+	// FollowedAt When the follow started.
+	FollowedAt time.Time `json:"followed_at"`
+
+	// Id Unique identifier for the user.
+	Id int64 `json:"id"`
+
+	// LastName User's last name.
+	LastName string `json:"last_name"`
+
+	// ProfilePictureUrl URL of the profile picture, if the user has one.
+	ProfilePictureUrl *string `json:"profile_picture_url,omitempty"`
+
+	// Username Username of the user.
+	Username string `json:"username"`
+}
+
+// ForgotPasswordRequest Data required to request a password reset.
+type ForgotPasswordRequest struct {
+	// Email Email address of the account.
+	Email openapi_types.Email `json:"email"`
+}
+
 // GetCommentSuccessResponse Standard wrapper for the successful comment retrieval response.
 type GetCommentSuccessResponse struct {
 	// Data Represents a comment on a post.
@@ -102,22 +306,165 @@ type GetPostSuccessResponse struct {
 	Data Post `json:"data"`
 }
 
+// GetPublicUserProfileSuccessResponse Standard wrapper for the successful public profile retrieval response.
+type GetPublicUserProfileSuccessResponse struct {
+	// Data What any user can see of another user. It leaves out the email address and the account activity.
+	Data PublicUserProfile `json:"data"`
+}
+
 // GetUserProfileSuccessResponse Standard wrapper for the successful user profile retrieval response.
 type GetUserProfileSuccessResponse struct {
 	// Data Represents a user in the system.
 	Data User `json:"data"`
 }
 
+// HomeFeedSuccessResponse Standard wrapper for a page of the home feed.
+type HomeFeedSuccessResponse struct {
+	// Data The posts of the page, newest first.
+	Data []Post `json:"data"`
+
+	// NextCursor Cursor of the next page, absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Impersonation An impersonation session and the access token that acts as its subject.
+type Impersonation struct {
+	// AccessToken Access token of the subject, to send in the "Authorization: Bearer" header. It carries the
+	// admin in its "act" claim and cannot be refreshed.
+	AccessToken string `json:"access_token"`
+
+	// ExpiresAt When the session and its access token expire.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// ImpersonationId ID of the impersonation session, to end it and to find its requests in the audit log.
+	ImpersonationId string `json:"impersonation_id"`
+
+	// User Represents a user in the system.
+	User User `json:"user"`
+}
+
+// ImpersonationAuditEntry A request made by an admin while impersonating a user.
+type ImpersonationAuditEntry struct {
+	// ActorId ID of the admin who made the request.
+	ActorId int64 `json:"actor_id"`
+
+	// CreatedAt Timestamp of the request.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the entry.
+	Id int64 `json:"id"`
+
+	// ImpersonationId ID of the impersonation session.
+	ImpersonationId string `json:"impersonation_id"`
+
+	// Method HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path Path of the request.
+	Path string `json:"path"`
+
+	// Status HTTP status of the response.
+	Status int `json:"status"`
+
+	// SubjectId ID of the impersonated user.
+	SubjectId int64 `json:"subject_id"`
+}
+
+// JSONWebKey Public key verifying access tokens, in the JSON Web Key format.
+type JSONWebKey struct {
+	// Alg Signing algorithm of the key.
+	Alg JSONWebKeyAlg `json:"alg"`
+
+	// Crv Curve of OKP keys.
+	Crv *string `json:"crv,omitempty"`
+
+	// E Exponent of RSA keys (base64url).
+	E *string `json:"e,omitempty"`
+
+	// Kid Key ID, matching the "kid" header of the tokens it verifies.
+	Kid string `json:"kid"`
+
+	// Kty Key type, "OKP" for Ed25519 keys and "RSA" for RSA keys.
+	Kty string `json:"kty"`
+
+	// N Modulus of RSA keys (base64url).
+	N *string `json:"n,omitempty"`
+
+	// Use Always "sig".
+	Use string `json:"use"`
+
+	// X Public key of OKP keys (base64url).
+	X *string `json:"x,omitempty"`
+}
+
+// JSONWebKeyAlg Signing algorithm of the key.
+type JSONWebKeyAlg string
+
+// JSONWebKeySet Set of public keys verifying access tokens.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// ListBlockedUsersSuccessResponse Standard wrapper for a list of blocked or muted users, most recent first.
+type ListBlockedUsersSuccessResponse struct {
+	Data []BlockedUser `json:"data"`
+}
+
 // ListCommentsSuccessResponse Standard wrapper for the successful comment list retrieval response.
 type ListCommentsSuccessResponse struct {
 	// Data An array of comment objects.
 	Data []Comment `json:"data"`
+
+	// NextCursor Cursor of the next page, absent on the last page. The next page is also linked in the Link header.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ListFollowsSuccessResponse Standard wrapper for a list of followers or followed users, most recent follow first.
+type ListFollowsSuccessResponse struct {
+	Data []FollowUser `json:"data"`
+
+	// NextCursor Cursor of the next page, absent on the last page. The next page is also linked in the Link header.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ListImpersonationLogSuccessResponse Standard wrapper for the successful impersonation audit log response.
+type ListImpersonationLogSuccessResponse struct {
+	Data []ImpersonationAuditEntry `json:"data"`
+}
+
+// ListModerationLogSuccessResponse Standard wrapper for the successful moderation log response.
+type ListModerationLogSuccessResponse struct {
+	Data []ModerationLogEntry `json:"data"`
+}
+
+// ListPersonalAccessTokensSuccessResponse Standard wrapper for the successful personal access token list response.
+type ListPersonalAccessTokensSuccessResponse struct {
+	// Data An array of personal access token objects.
+	Data []PersonalAccessToken `json:"data"`
 }
 
 // ListPostsSuccessResponse Standard wrapper for the successful post list retrieval response.
 type ListPostsSuccessResponse struct {
 	// Data An array of post objects.
 	Data []Post `json:"data"`
+
+	// NextCursor Cursor of the next page, absent on the last page. The next page is also linked in the Link header.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ListSessionsSuccessResponse Standard wrapper for the successful session list response.
+type ListSessionsSuccessResponse struct {
+	// Data An array of session objects.
+	Data []Session `json:"data"`
+}
+
+// ListTagPostsSuccessResponse Standard wrapper for a page of the posts of a hashtag.
+type ListTagPostsSuccessResponse struct {
+	// Data The posts of the page, newest first.
+	Data []Post `json:"data"`
+
+	// NextCursor Cursor of the next page, absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // LoginRequest Data required for user login.
@@ -129,21 +476,137 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
-// LoginResponse Response containing the JWT upon successful login.
+// LoginResponse Response containing the tokens upon successful login or refresh. The same tokens are
+// set as cookies; clients without cookies send the access token as
+// "Authorization: Bearer <token>" and the refresh token in the refresh request body.
+// The refresh token is only returned to clients that opt in, by sending the
+// "X-Token-Delivery: body" header or, on refresh, the refresh token in the body.
+// Otherwise it is only set in the HttpOnly cookie, out of reach of page scripts.
 type LoginResponse struct {
+	// RefreshToken Single-use refresh token, rotated on every refresh. Only returned to clients that opt in.
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
 	// Token JSON Web Token for authenticated sessions.
 	Token string `json:"token"`
 }
 
 // LoginSuccessResponse Standard wrapper for the successful login response.
 type LoginSuccessResponse struct {
-	// Data Response containing the JWT upon successful login.
+	// Data Response containing the tokens upon successful login or refresh. The same tokens are
+	// set as cookies; clients without cookies send the access token as
+	// "Authorization: Bearer <token>" and the refresh token in the refresh request body.
+	// The refresh token is only returned to clients that opt in, by sending the
+	// "X-Token-Delivery: body" header or, on refresh, the refresh token in the body.
+	// Otherwise it is only set in the HttpOnly cookie, out of reach of page scripts.
 	Data LoginResponse `json:"data"`
 }
 
+// MFAChallenge Returned by a login with valid credentials when two-factor authentication is enabled.
+// No session is started until the challenge token is exchanged together with a code.
+type MFAChallenge struct {
+	// ChallengeToken Short-lived, single-use token identifying the pending login.
+	ChallengeToken string `json:"challenge_token"`
+
+	// ExpiresAt Timestamp after which the challenge token is no longer accepted.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// MfaRequired Always true; a second factor is required to complete the login.
+	MfaRequired bool `json:"mfa_required"`
+}
+
+// MFAChallengeSuccessResponse Standard wrapper for the two-factor challenge response.
+type MFAChallengeSuccessResponse struct {
+	// Data Returned by a login with valid credentials when two-factor authentication is enabled.
+	// No session is started until the challenge token is exchanged together with a code.
+	Data MFAChallenge `json:"data"`
+}
+
+// MagicLinkLoginRequest Data required to log in with a login link.
+type MagicLinkLoginRequest struct {
+	// Token Token from the login link.
+	Token string `json:"token"`
+}
+
+// MagicLinkRequest Data required to request a login link.
+type MagicLinkRequest struct {
+	// Email Email address of the account.
+	Email openapi_types.Email `json:"email"`
+}
+
+// ModerationLogEntry An edit or deletion made by a moderator or admin on content owned by another user.
+type ModerationLogEntry struct {
+	// Action What was done to the content.
+	Action ModerationLogEntryAction `json:"action"`
+
+	// ActorId ID of the user who acted.
+	ActorId int64 `json:"actor_id"`
+
+	// ActorRole Role of the user. Moderators can edit and delete any post or comment; admins can also manage roles.
+	ActorRole UserRole `json:"actor_role"`
+
+	// CreatedAt Timestamp of the action.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the entry.
+	Id int64 `json:"id"`
+
+	// PreviousContent The content as it was before the action.
+	PreviousContent string `json:"previous_content"`
+
+	// TargetId ID of the post or comment.
+	TargetId int64 `json:"target_id"`
+
+	// TargetType Type of the content.
+	TargetType ModerationLogEntryTargetType `json:"target_type"`
+
+	// TargetUserId ID of the user who owns the content.
+	TargetUserId int64 `json:"target_user_id"`
+}
+
+// ModerationLogEntryAction What was done to the content.
+type ModerationLogEntryAction string
+
+// ModerationLogEntryTargetType Type of the content.
+type ModerationLogEntryTargetType string
+
+// OIDCProvidersSuccessResponse Standard wrapper for the list of external identity providers.
+type OIDCProvidersSuccessResponse struct {
+	Data []string `json:"data"`
+}
+
+// PasswordConfirmationRequest Current password of the user, required before sensitive changes.
+type PasswordConfirmationRequest struct {
+	// Password Current password.
+	Password string `json:"password"`
+}
+
+// PersonalAccessToken A personal access token of the user. The token value itself is only shown at creation.
+type PersonalAccessToken struct {
+	// CreatedAt Timestamp when the token was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt Expiry of the token, if any.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id Unique identifier for the token.
+	Id int64 `json:"id"`
+
+	// LastUsedAt Timestamp when the token was last used, if ever.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	// Name Name to recognise the token by.
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to the token.
+	Scopes []PersonalAccessTokenScope `json:"scopes"`
+}
+
+// PersonalAccessTokenScope Permission granted to a personal access token.
+type PersonalAccessTokenScope string
+
 // Post Represents a post in the system.
 type Post struct {
-	// Content The text content of the post.
+	// Content The text content of the post, empty for reposts.
 	Content string `json:"content"`
 
 	// CreatedAt Timestamp when the post was created.
@@ -152,6 +615,18 @@ type Post struct {
 	// Id Unique identifier for the post.
 	Id *int64 `json:"id,omitempty"`
 
+	// Kind Whether the post is an original post, a repost of another post, or a quote post commenting on another post.
+	Kind *PostKind `json:"kind,omitempty"`
+
+	// MyReactions Reaction types the authenticated user reacted to the post with.
+	MyReactions *[]string `json:"my_reactions,omitempty"`
+
+	// ReactionCounts Number of reactions on the post by type, leaving out the types nobody reacted with.
+	ReactionCounts *map[string]int64 `json:"reaction_counts,omitempty"`
+
+	// ReferencedPost A post shared by a repost or quote post. When the shared post was deleted, or its author is blocked, only a tombstone with its id is returned.
+	ReferencedPost *ReferencedPost `json:"referenced_post,omitempty"`
+
 	// UpdatedAt Timestamp when the post was last updated.
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
@@ -159,6 +634,119 @@ type Post struct {
 	UserId *int64 `json:"user_id,omitempty"`
 }
 
+// PostKind Whether the post is an original post, a repost of another post, or a quote post commenting on another post.
+type PostKind string
+
+// PublicUserProfile What any user can see of another user. It leaves out the email address and the account activity.
+type PublicUserProfile struct {
+	// Bio Short description the user gives of themselves, empty when unset.
+	Bio string `json:"bio"`
+
+	// CommentCount Number of comments the user wrote.
+	CommentCount int64 `json:"comment_count"`
+
+	// FirstName User's first name.
+	FirstName string `json:"first_name"`
+
+	// FollowerCount Number of users following the user.
+	FollowerCount int64 `json:"follower_count"`
+
+	// FollowingCount Number of users the user follows.
+	FollowingCount int64 `json:"following_count"`
+
+	// Id Unique identifier for the user.
+	Id int64 `json:"id"`
+
+	// JoinedAt Timestamp when the user signed up.
+	JoinedAt time.Time `json:"joined_at"`
+
+	// LastName User's last name.
+	LastName string `json:"last_name"`
+
+	// PostCount Number of posts the user wrote.
+	PostCount int64 `json:"post_count"`
+
+	// ProfilePictureUrl URL of the profile picture of the user, null when unset.
+	ProfilePictureUrl *string `json:"profile_picture_url"`
+
+	// Username User's unique username.
+	Username string `json:"username"`
+}
+
+// RecoveryCodes One-time recovery codes. They are shown only once and replace any previous set.
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RecoveryCodesSuccessResponse Standard wrapper for the recovery codes response.
+type RecoveryCodesSuccessResponse struct {
+	// Data One-time recovery codes. They are shown only once and replace any previous set.
+	Data RecoveryCodes `json:"data"`
+}
+
+// ReferencedPost A post shared by a repost or quote post. When the shared post was deleted, or its author is blocked, only a tombstone with its id is returned.
+type ReferencedPost struct {
+	// Content The text content of the shared post.
+	Content *string `json:"content,omitempty"`
+
+	// CreatedAt Timestamp when the shared post was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Deleted Whether the shared post is no longer available, in which case the other fields are absent.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Id Unique identifier for the shared post.
+	Id *int64 `json:"id,omitempty"`
+
+	// Kind Whether the shared post is an original post or a quote post.
+	Kind *ReferencedPostKind `json:"kind,omitempty"`
+
+	// UpdatedAt Timestamp when the shared post was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// UserId ID of the user who created the shared post.
+	UserId *int64 `json:"user_id,omitempty"`
+}
+
+// ReferencedPostKind Whether the shared post is an original post or a quote post.
+type ReferencedPostKind string
+
+// RefreshTokenRequest Refresh token sent in the body by clients without cookies. It takes precedence over the refresh_token cookie.
+type RefreshTokenRequest struct {
+	// RefreshToken The refresh token returned by the login or the previous refresh.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ResetPasswordRequest Data required to reset a password.
+type ResetPasswordRequest struct {
+	// Password New password.
+	Password string `json:"password"`
+
+	// Token Token from the password reset email.
+	Token string `json:"token"`
+}
+
+// Session An active session (logged-in device) of the user.
+type Session struct {
+	// CreatedAt Timestamp when the session was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current Whether this is the session making the request.
+	Current bool `json:"current"`
+
+	// Id Unique identifier for the session (UUID).
+	Id string `json:"id"`
+
+	// IpAddress IP address of the client that created the session.
+	IpAddress string `json:"ip_address"`
+
+	// LastUsedAt Timestamp when the session was last used.
+	LastUsedAt time.Time `json:"last_used_at"`
+
+	// UserAgent User agent of the client that created the session.
+	UserAgent string `json:"user_agent"`
+}
+
 // SignupRequest Data required for user signup.
 type SignupRequest struct {
 	// Email User's email address.
@@ -173,7 +761,7 @@ type SignupRequest struct {
 	// Password Desired password.
 	Password string `json:"password"`
 
-	// Username Desired username (alphanumeric).
+	// Username Desired username (alphanumeric). The names of the static /v1/users routes, such as "tokens" or "blocks", are reserved.
 	Username string `json:"username"`
 }
 
@@ -183,6 +771,65 @@ type SignupSuccessResponse struct {
 	Data User `json:"data"`
 }
 
+// StartImpersonationRequest Request body for starting an impersonation session.
+type StartImpersonationRequest struct {
+	Data struct {
+		// Reason Why the user is impersonated, e.g. the support ticket being worked on. Recorded with the session.
+		Reason string `json:"reason"`
+	} `json:"data"`
+}
+
+// StartImpersonationSuccessResponse Standard wrapper for the successful impersonation response.
+type StartImpersonationSuccessResponse struct {
+	// Data An impersonation session and the access token that acts as its subject.
+	Data Impersonation `json:"data"`
+}
+
+// TOTPEnrollment Secret to register in an authenticator app, e.g. by rendering the URI as a QR code.
+type TOTPEnrollment struct {
+	// OtpauthUri Key URI understood by authenticator apps.
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32-encoded TOTP secret, for manual entry.
+	Secret string `json:"secret"`
+}
+
+// TOTPEnrollmentSuccessResponse Standard wrapper for the enrollment response.
+type TOTPEnrollmentSuccessResponse struct {
+	// Data Secret to register in an authenticator app, e.g. by rendering the URI as a QR code.
+	Data TOTPEnrollment `json:"data"`
+}
+
+// TrendingTag A hashtag with the number of recent posts using it.
+type TrendingTag struct {
+	// PostCount Number of posts created within the trending window that use the hashtag.
+	PostCount int64 `json:"post_count"`
+
+	// Tag The normalized hashtag, without its hash sign.
+	Tag string `json:"tag"`
+}
+
+// TrendingTagsSuccessResponse Standard wrapper for the trending hashtags.
+type TrendingTagsSuccessResponse struct {
+	// Data The trending hashtags, most used first.
+	Data []TrendingTag `json:"data"`
+}
+
+// TwoFactorStatus Two-factor authentication settings of the user.
+type TwoFactorStatus struct {
+	// Enabled Whether a second factor is required at login.
+	Enabled bool `json:"enabled"`
+
+	// RecoveryCodesRemaining Number of unused recovery codes.
+	RecoveryCodesRemaining int `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorStatusSuccessResponse Standard wrapper for the two-factor status response.
+type TwoFactorStatusSuccessResponse struct {
+	// Data Two-factor authentication settings of the user.
+	Data TwoFactorStatus `json:"data"`
+}
+
 // UpdateCommentRequest Data required to update an existing comment.
 type UpdateCommentRequest struct {
 	// Content The updated text content of the comment.
@@ -209,8 +856,12 @@ type UpdatePostSuccessResponse struct {
 
 // UpdateUserProfileRequest Fields allowed for updating a user profile.
 type UpdateUserProfileRequest struct {
-	// Email User's email address.
-	Email *openapi_types.Email `json:"email,omitempty"`
+	// Bio Short description shown on the public profile. Left unchanged when omitted; an empty string removes it.
+	Bio *string `json:"bio,omitempty"`
+
+	// Email Ignored. The email address used to be changed here; it is now changed through PUT /v1/users/email, which confirms the new address. Will be rejected in a future version.
+	// Deprecated:
+	Email *string `json:"email,omitempty"`
 
 	// FirstName User's first name.
 	FirstName *string `json:"first_name,omitempty"`
@@ -218,7 +869,7 @@ type UpdateUserProfileRequest struct {
 	// LastName User's last name.
 	LastName *string `json:"last_name,omitempty"`
 
-	// Username Desired username (alphanumeric).
+	// Username Desired username (alphanumeric). The names of the static /v1/users routes, such as "tokens" or "blocks", are reserved.
 	Username *string `json:"username,omitempty"`
 }
 
@@ -228,14 +879,40 @@ type UpdateUserProfileSuccessResponse struct {
 	Data User `json:"data"`
 }
 
+// UpdateUserRoleRequest Request body for changing the role of a user.
+type UpdateUserRoleRequest struct {
+	Data struct {
+		// Role Role of the user. Moderators can edit and delete any post or comment; admins can also manage roles.
+		Role UserRole `json:"role"`
+	} `json:"data"`
+}
+
+// UpdateUserRoleSuccessResponse Standard wrapper for the successful role update response.
+type UpdateUserRoleSuccessResponse struct {
+	// Data Represents a user in the system.
+	Data User `json:"data"`
+}
+
+// UploadAvatarSuccessResponse Standard wrapper for the successful profile picture upload response.
+type UploadAvatarSuccessResponse struct {
+	// Data The uploaded profile picture, stored as thumbnails.
+	Data Avatar `json:"data"`
+}
+
 // User Represents a user in the system.
 type User struct {
+	// Bio Short description the user gives of themselves, empty when unset.
+	Bio *string `json:"bio,omitempty"`
+
 	// CreatedAt Timestamp when the user was created.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
 	// Email User's email address.
 	Email openapi_types.Email `json:"email"`
 
+	// EmailVerifiedAt Timestamp when the user verified their email address, null while unverified.
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+
 	// FirstName User's first name.
 	FirstName string `json:"first_name"`
 
@@ -248,6 +925,12 @@ type User struct {
 	// LastName User's last name.
 	LastName string `json:"last_name"`
 
+	// ProfilePictureUrl URL of the profile picture of the user, null when unset.
+	ProfilePictureUrl *string `json:"profile_picture_url"`
+
+	// Role Role of the user. Moderators can edit and delete any post or comment; admins can also manage roles.
+	Role *UserRole `json:"role,omitempty"`
+
 	// UpdatedAt Timestamp when the user was last updated.
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
@@ -255,18 +938,150 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserRole Role of the user. Moderators can edit and delete any post or comment; admins can also manage roles.
+type UserRole string
+
+// VerifyEmailRequest Data required to verify an email address.
+type VerifyEmailRequest struct {
+	// Token Token from the verification email.
+	Token string `json:"token"`
+}
+
+// VerifyMFAChallengeRequest Data required to complete a login with a second factor.
+type VerifyMFAChallengeRequest struct {
+	// ChallengeToken Token returned by the login endpoint. Omitted after a login with an external provider, which sets it in the `mfa_challenge` cookie.
+	ChallengeToken *string `json:"challenge_token,omitempty"`
+
+	// Code A 6-digit code from the authenticator app, or an unused recovery code.
+	Code string `json:"code"`
+}
+
+// ListImpersonationLogV1Params defines parameters for ListImpersonationLogV1.
+type ListImpersonationLogV1Params struct {
+	// Limit Maximum number of entries to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of entries to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListModerationLogV1Params defines parameters for ListModerationLogV1.
+type ListModerationLogV1Params struct {
+	// Limit Maximum number of entries to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of entries to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ConfirmTwoFactorV1JSONBody defines parameters for ConfirmTwoFactorV1.
+type ConfirmTwoFactorV1JSONBody struct {
+	// Data Data required to confirm a two-factor enrollment.
+	Data ConfirmTOTPRequest `json:"data"`
+}
+
+// DisableTwoFactorV1JSONBody defines parameters for DisableTwoFactorV1.
+type DisableTwoFactorV1JSONBody struct {
+	// Data Current password of the user, required before sensitive changes.
+	Data PasswordConfirmationRequest `json:"data"`
+}
+
+// RegenerateRecoveryCodesV1JSONBody defines parameters for RegenerateRecoveryCodesV1.
+type RegenerateRecoveryCodesV1JSONBody struct {
+	// Data Current password of the user, required before sensitive changes.
+	Data PasswordConfirmationRequest `json:"data"`
+}
+
 // LoginUserV1JSONBody defines parameters for LoginUserV1.
 type LoginUserV1JSONBody struct {
 	// Data Data required for user login.
 	Data LoginRequest `json:"data"`
 }
 
+// VerifyMfaChallengeV1JSONBody defines parameters for VerifyMfaChallengeV1.
+type VerifyMfaChallengeV1JSONBody struct {
+	// Data Data required to complete a login with a second factor.
+	Data VerifyMFAChallengeRequest `json:"data"`
+}
+
+// LogoutUserV1JSONBody defines parameters for LogoutUserV1.
+type LogoutUserV1JSONBody struct {
+	// Data Refresh token sent in the body by clients without cookies. It takes precedence over the refresh_token cookie.
+	Data RefreshTokenRequest `json:"data"`
+}
+
+// RequestMagicLinkV1JSONBody defines parameters for RequestMagicLinkV1.
+type RequestMagicLinkV1JSONBody struct {
+	// Data Data required to request a login link.
+	Data MagicLinkRequest `json:"data"`
+}
+
+// VerifyMagicLinkV1JSONBody defines parameters for VerifyMagicLinkV1.
+type VerifyMagicLinkV1JSONBody struct {
+	// Data Data required to log in with a login link.
+	Data MagicLinkLoginRequest `json:"data"`
+}
+
+// OidcCallbackV1Params defines parameters for OidcCallbackV1.
+type OidcCallbackV1Params struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Set by the provider when the user did not authenticate.
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// ForgotPasswordV1JSONBody defines parameters for ForgotPasswordV1.
+type ForgotPasswordV1JSONBody struct {
+	// Data Data required to request a password reset.
+	Data ForgotPasswordRequest `json:"data"`
+}
+
+// ResetPasswordV1JSONBody defines parameters for ResetPasswordV1.
+type ResetPasswordV1JSONBody struct {
+	// Data Data required to reset a password.
+	Data ResetPasswordRequest `json:"data"`
+}
+
+// RefreshAccessTokenV1JSONBody defines parameters for RefreshAccessTokenV1.
+type RefreshAccessTokenV1JSONBody struct {
+	// Data Refresh token sent in the body by clients without cookies. It takes precedence over the refresh_token cookie.
+	Data RefreshTokenRequest `json:"data"`
+}
+
 // SignupUserV1JSONBody defines parameters for SignupUserV1.
 type SignupUserV1JSONBody struct {
 	// Data Data required for user signup.
 	Data SignupRequest `json:"data"`
 }
 
+// VerifyEmailV1JSONBody defines parameters for VerifyEmailV1.
+type VerifyEmailV1JSONBody struct {
+	// Data Data required to verify an email address.
+	Data VerifyEmailRequest `json:"data"`
+}
+
+// GetHomeFeedV1Params defines parameters for GetHomeFeedV1.
+type GetHomeFeedV1Params struct {
+	// Limit Maximum number of posts to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPostsV1Params defines parameters for ListPostsV1.
+type ListPostsV1Params struct {
+	// Limit Maximum number of posts to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Offset Number of posts to skip. Deprecated in favor of cursor, and cannot be combined with it.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // CreatePostV1JSONBody defines parameters for CreatePostV1.
 type CreatePostV1JSONBody struct {
 	// Data Data required to create a new post.
@@ -279,6 +1094,18 @@ type UpdatePostV1JSONBody struct {
 	Data UpdatePostRequest `json:"data"`
 }
 
+// ListCommentsForPostV1Params defines parameters for ListCommentsForPostV1.
+type ListCommentsForPostV1Params struct {
+	// Limit Maximum number of comments to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Offset Number of comments to skip. Deprecated in favor of cursor, and cannot be combined with it.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // CreateCommentV1JSONBody defines parameters for CreateCommentV1.
 type CreateCommentV1JSONBody struct {
 	// Data Data required to create a new comment on a post.
@@ -291,18 +1118,162 @@ type UpdateCommentV1JSONBody struct {
 	Data UpdateCommentRequest `json:"data"`
 }
 
+// ListTrendingTagsV1Params defines parameters for ListTrendingTagsV1.
+type ListTrendingTagsV1Params struct {
+	// Limit Maximum number of hashtags to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTagPostsV1Params defines parameters for ListTagPostsV1.
+type ListTagPostsV1Params struct {
+	// Limit Maximum number of posts to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// DeleteAccountV1JSONBody defines parameters for DeleteAccountV1.
+type DeleteAccountV1JSONBody struct {
+	// Data Current password of the user, required before sensitive changes.
+	Data PasswordConfirmationRequest `json:"data"`
+}
+
 // UpdateUserProfileV1JSONBody defines parameters for UpdateUserProfileV1.
 type UpdateUserProfileV1JSONBody struct {
 	// Data Fields allowed for updating a user profile.
 	Data UpdateUserProfileRequest `json:"data"`
 }
 
+// UploadAvatarV1MultipartBody defines parameters for UploadAvatarV1.
+type UploadAvatarV1MultipartBody struct {
+	// Avatar The image file.
+	Avatar openapi_types.File `json:"avatar"`
+}
+
+// ListBlockedUsersV1Params defines parameters for ListBlockedUsersV1.
+type ListBlockedUsersV1Params struct {
+	// Limit Maximum number of users to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of users to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RequestEmailChangeV1JSONBody defines parameters for RequestEmailChangeV1.
+type RequestEmailChangeV1JSONBody struct {
+	// Data New email address and current password of the user.
+	Data ChangeEmailRequest `json:"data"`
+}
+
+// ConfirmEmailChangeV1JSONBody defines parameters for ConfirmEmailChangeV1.
+type ConfirmEmailChangeV1JSONBody struct {
+	// Data Token sent to the new email address.
+	Data ConfirmEmailChangeRequest `json:"data"`
+}
+
+// ListMutedUsersV1Params defines parameters for ListMutedUsersV1.
+type ListMutedUsersV1Params struct {
+	// Limit Maximum number of users to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of users to skip.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ChangePasswordV1JSONBody defines parameters for ChangePasswordV1.
+type ChangePasswordV1JSONBody struct {
+	// Data Current and new password of the user.
+	Data ChangePasswordRequest `json:"data"`
+}
+
+// CreatePersonalAccessTokenV1JSONBody defines parameters for CreatePersonalAccessTokenV1.
+type CreatePersonalAccessTokenV1JSONBody struct {
+	// Data Fields required to create a personal access token.
+	Data CreatePersonalAccessTokenRequest `json:"data"`
+}
+
+// ListFollowersV1Params defines parameters for ListFollowersV1.
+type ListFollowersV1Params struct {
+	// Limit Maximum number of users to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Offset Number of users to skip. Deprecated in favor of cursor, and cannot be combined with it.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListFollowingV1Params defines parameters for ListFollowingV1.
+type ListFollowingV1Params struct {
+	// Limit Maximum number of users to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Offset Number of users to skip. Deprecated in favor of cursor, and cannot be combined with it.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListUserPostsV1Params defines parameters for ListUserPostsV1.
+type ListUserPostsV1Params struct {
+	// Limit Maximum number of posts to return (at most 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as next_cursor by the previous page. The first page is returned without it.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Offset Number of posts to skip. Deprecated in favor of cursor, and cannot be combined with it.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// StartImpersonationV1JSONRequestBody defines body for StartImpersonationV1 for application/json ContentType.
+type StartImpersonationV1JSONRequestBody = StartImpersonationRequest
+
+// UpdateUserRoleV1JSONRequestBody defines body for UpdateUserRoleV1 for application/json ContentType.
+type UpdateUserRoleV1JSONRequestBody = UpdateUserRoleRequest
+
+// ConfirmTwoFactorV1JSONRequestBody defines body for ConfirmTwoFactorV1 for application/json ContentType.
+type ConfirmTwoFactorV1JSONRequestBody ConfirmTwoFactorV1JSONBody
+
+// DisableTwoFactorV1JSONRequestBody defines body for DisableTwoFactorV1 for application/json ContentType.
+type DisableTwoFactorV1JSONRequestBody DisableTwoFactorV1JSONBody
+
+// RegenerateRecoveryCodesV1JSONRequestBody defines body for RegenerateRecoveryCodesV1 for application/json ContentType.
+type RegenerateRecoveryCodesV1JSONRequestBody RegenerateRecoveryCodesV1JSONBody
+
 // LoginUserV1JSONRequestBody defines body for LoginUserV1 for application/json ContentType.
 type LoginUserV1JSONRequestBody LoginUserV1JSONBody
 
+// VerifyMfaChallengeV1JSONRequestBody defines body for VerifyMfaChallengeV1 for application/json ContentType.
+type VerifyMfaChallengeV1JSONRequestBody VerifyMfaChallengeV1JSONBody
+
+// LogoutUserV1JSONRequestBody defines body for LogoutUserV1 for application/json ContentType.
+type LogoutUserV1JSONRequestBody LogoutUserV1JSONBody
+
+// RequestMagicLinkV1JSONRequestBody defines body for RequestMagicLinkV1 for application/json ContentType.
+type RequestMagicLinkV1JSONRequestBody RequestMagicLinkV1JSONBody
+
+// VerifyMagicLinkV1JSONRequestBody defines body for VerifyMagicLinkV1 for application/json ContentType.
+type VerifyMagicLinkV1JSONRequestBody VerifyMagicLinkV1JSONBody
+
+// ForgotPasswordV1JSONRequestBody defines body for ForgotPasswordV1 for application/json ContentType.
+type ForgotPasswordV1JSONRequestBody ForgotPasswordV1JSONBody
+
+// ResetPasswordV1JSONRequestBody defines body for ResetPasswordV1 for application/json ContentType.
+type ResetPasswordV1JSONRequestBody ResetPasswordV1JSONBody
+
+// RefreshAccessTokenV1JSONRequestBody defines body for RefreshAccessTokenV1 for application/json ContentType.
+type RefreshAccessTokenV1JSONRequestBody RefreshAccessTokenV1JSONBody
+
 // SignupUserV1JSONRequestBody defines body for SignupUserV1 for application/json ContentType.
 type SignupUserV1JSONRequestBody SignupUserV1JSONBody
 
+// VerifyEmailV1JSONRequestBody defines body for VerifyEmailV1 for application/json ContentType.
+type VerifyEmailV1JSONRequestBody VerifyEmailV1JSONBody
+
 // CreatePostV1JSONRequestBody defines body for CreatePostV1 for application/json ContentType.
 type CreatePostV1JSONRequestBody CreatePostV1JSONBody
 
@@ -315,9 +1286,27 @@ type CreateCommentV1JSONRequestBody CreateCommentV1JSONBody
 // UpdateCommentV1JSONRequestBody defines body for UpdateCommentV1 for application/json ContentType.
 type UpdateCommentV1JSONRequestBody UpdateCommentV1JSONBody
 
+// DeleteAccountV1JSONRequestBody defines body for DeleteAccountV1 for application/json ContentType.
+type DeleteAccountV1JSONRequestBody DeleteAccountV1JSONBody
+
 // UpdateUserProfileV1JSONRequestBody defines body for UpdateUserProfileV1 for application/json ContentType.
 type UpdateUserProfileV1JSONRequestBody UpdateUserProfileV1JSONBody
 
+// UploadAvatarV1MultipartRequestBody defines body for UploadAvatarV1 for multipart/form-data ContentType.
+type UploadAvatarV1MultipartRequestBody UploadAvatarV1MultipartBody
+
+// RequestEmailChangeV1JSONRequestBody defines body for RequestEmailChangeV1 for application/json ContentType.
+type RequestEmailChangeV1JSONRequestBody RequestEmailChangeV1JSONBody
+
+// ConfirmEmailChangeV1JSONRequestBody defines body for ConfirmEmailChangeV1 for application/json ContentType.
+type ConfirmEmailChangeV1JSONRequestBody ConfirmEmailChangeV1JSONBody
+
+// ChangePasswordV1JSONRequestBody defines body for ChangePasswordV1 for application/json ContentType.
+type ChangePasswordV1JSONRequestBody ChangePasswordV1JSONBody
+
+// CreatePersonalAccessTokenV1JSONRequestBody defines body for CreatePersonalAccessTokenV1 for application/json ContentType.
+type CreatePersonalAccessTokenV1JSONRequestBody CreatePersonalAccessTokenV1JSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -391,24 +1380,128 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJWKS request
+	GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListImpersonationLogV1 request
+	ListImpersonationLogV1(ctx context.Context, params *ListImpersonationLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EndImpersonationV1 request
+	EndImpersonationV1(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListModerationLogV1 request
+	ListModerationLogV1(ctx context.Context, params *ListModerationLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartImpersonationV1WithBody request with any body
+	StartImpersonationV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartImpersonationV1(ctx context.Context, id int64, body StartImpersonationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserRoleV1WithBody request with any body
+	UpdateUserRoleV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserRoleV1(ctx context.Context, id int64, body UpdateUserRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTwoFactorStatusV1 request
+	GetTwoFactorStatusV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTwoFactorV1WithBody request with any body
+	ConfirmTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTwoFactorV1(ctx context.Context, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTwoFactorV1WithBody request with any body
+	DisableTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTwoFactorV1(ctx context.Context, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTwoFactorV1 request
+	EnrollTwoFactorV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesV1WithBody request with any body
+	RegenerateRecoveryCodesV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodesV1(ctx context.Context, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LoginUserV1WithBody request with any body
 	LoginUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	LoginUserV1(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// LogoutUserV1 request
-	LogoutUserV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// VerifyMfaChallengeV1WithBody request with any body
+	VerifyMfaChallengeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyMfaChallengeV1(ctx context.Context, body VerifyMfaChallengeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutUserV1WithBody request with any body
+	LogoutUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LogoutUserV1(ctx context.Context, body LogoutUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestMagicLinkV1WithBody request with any body
+	RequestMagicLinkV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestMagicLinkV1(ctx context.Context, body RequestMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyMagicLinkV1WithBody request with any body
+	VerifyMagicLinkV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyMagicLinkV1(ctx context.Context, body VerifyMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOidcProvidersV1 request
+	ListOidcProvidersV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartOidcLoginV1 request
+	StartOidcLoginV1(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OidcCallbackV1 request
+	OidcCallbackV1(ctx context.Context, provider string, params *OidcCallbackV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ForgotPasswordV1WithBody request with any body
+	ForgotPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// RefreshAccessTokenV1 request
-	RefreshAccessTokenV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ForgotPasswordV1(ctx context.Context, body ForgotPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPasswordV1WithBody request with any body
+	ResetPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetPasswordV1(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshAccessTokenV1WithBody request with any body
+	RefreshAccessTokenV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshAccessTokenV1(ctx context.Context, body RefreshAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeOtherSessionsV1 request
+	RevokeOtherSessionsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSessionsV1 request
+	ListSessionsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeSessionV1 request
+	RevokeSessionV1(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SignupUserV1WithBody request with any body
 	SignupUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	SignupUserV1(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// VerifyEmailV1WithBody request with any body
+	VerifyEmailV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyEmailV1(ctx context.Context, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResendVerificationEmailV1 request
+	ResendVerificationEmailV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHomeFeedV1 request
+	GetHomeFeedV1(ctx context.Context, params *GetHomeFeedV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAvatarV1 request
+	GetAvatarV1(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListPostsV1 request
-	ListPostsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListPostsV1(ctx context.Context, params *ListPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreatePostV1WithBody request with any body
 	CreatePostV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -426,8 +1519,20 @@ type ClientInterface interface {
 
 	UpdatePostV1(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemovePostReactionV1 request
+	RemovePostReactionV1(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddPostReactionV1 request
+	AddPostReactionV1(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnrepostPostV1 request
+	UnrepostPostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RepostPostV1 request
+	RepostPostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCommentsForPostV1 request
-	ListCommentsForPostV1(ctx context.Context, postId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListCommentsForPostV1(ctx context.Context, postId int64, params *ListCommentsForPostV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateCommentV1WithBody request with any body
 	CreateCommentV1WithBody(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -445,6 +1550,23 @@ type ClientInterface interface {
 
 	UpdateCommentV1(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemoveCommentReactionV1 request
+	RemoveCommentReactionV1(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddCommentReactionV1 request
+	AddCommentReactionV1(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTrendingTagsV1 request
+	ListTrendingTagsV1(ctx context.Context, params *ListTrendingTagsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTagPostsV1 request
+	ListTagPostsV1(ctx context.Context, tag string, params *ListTagPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountV1WithBody request with any body
+	DeleteAccountV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteAccountV1(ctx context.Context, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserProfileV1 request
 	GetUserProfileV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -452,10 +1574,78 @@ type ClientInterface interface {
 	UpdateUserProfileV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateUserProfileV1(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAvatarV1 request
+	DeleteAvatarV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadAvatarV1WithBody request with any body
+	UploadAvatarV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBlockedUsersV1 request
+	ListBlockedUsersV1(ctx context.Context, params *ListBlockedUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestEmailChangeV1WithBody request with any body
+	RequestEmailChangeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestEmailChangeV1(ctx context.Context, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEmailChangeV1WithBody request with any body
+	ConfirmEmailChangeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmEmailChangeV1(ctx context.Context, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMutedUsersV1 request
+	ListMutedUsersV1(ctx context.Context, params *ListMutedUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChangePasswordV1WithBody request with any body
+	ChangePasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePasswordV1(ctx context.Context, body ChangePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPersonalAccessTokensV1 request
+	ListPersonalAccessTokensV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePersonalAccessTokenV1WithBody request with any body
+	CreatePersonalAccessTokenV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePersonalAccessTokenV1(ctx context.Context, body CreatePersonalAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokePersonalAccessTokenV1 request
+	RevokePersonalAccessTokenV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPublicUserProfileV1 request
+	GetPublicUserProfileV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnblockUserV1 request
+	UnblockUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BlockUserV1 request
+	BlockUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnfollowUserV1 request
+	UnfollowUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FollowUserV1 request
+	FollowUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFollowersV1 request
+	ListFollowersV1(ctx context.Context, username string, params *ListFollowersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFollowingV1 request
+	ListFollowingV1(ctx context.Context, username string, params *ListFollowingV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnmuteUserV1 request
+	UnmuteUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MuteUserV1 request
+	MuteUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUserPostsV1 request
+	ListUserPostsV1(ctx context.Context, username string, params *ListUserPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) LoginUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginUserV1RequestWithBody(c.Server, contentType, body)
+func (c *Client) GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJWKSRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -466,8 +1656,8 @@ func (c *Client) LoginUserV1WithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) LoginUserV1(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginUserV1Request(c.Server, body)
+func (c *Client) ListImpersonationLogV1(ctx context.Context, params *ListImpersonationLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListImpersonationLogV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -478,8 +1668,8 @@ func (c *Client) LoginUserV1(ctx context.Context, body LoginUserV1JSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) LogoutUserV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLogoutUserV1Request(c.Server)
+func (c *Client) EndImpersonationV1(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEndImpersonationV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -490,8 +1680,8 @@ func (c *Client) LogoutUserV1(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) RefreshAccessTokenV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshAccessTokenV1Request(c.Server)
+func (c *Client) ListModerationLogV1(ctx context.Context, params *ListModerationLogV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListModerationLogV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -502,8 +1692,8 @@ func (c *Client) RefreshAccessTokenV1(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignupUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignupUserV1RequestWithBody(c.Server, contentType, body)
+func (c *Client) StartImpersonationV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartImpersonationV1RequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -514,8 +1704,8 @@ func (c *Client) SignupUserV1WithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignupUserV1(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignupUserV1Request(c.Server, body)
+func (c *Client) StartImpersonationV1(ctx context.Context, id int64, body StartImpersonationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartImpersonationV1Request(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -526,8 +1716,8 @@ func (c *Client) SignupUserV1(ctx context.Context, body SignupUserV1JSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPostsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPostsV1Request(c.Server)
+func (c *Client) UpdateUserRoleV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRoleV1RequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -538,8 +1728,8 @@ func (c *Client) ListPostsV1(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePostV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePostV1RequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateUserRoleV1(ctx context.Context, id int64, body UpdateUserRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRoleV1Request(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -550,8 +1740,8 @@ func (c *Client) CreatePostV1WithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePostV1(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePostV1Request(c.Server, body)
+func (c *Client) GetTwoFactorStatusV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTwoFactorStatusV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -562,8 +1752,8 @@ func (c *Client) CreatePostV1(ctx context.Context, body CreatePostV1JSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePostV1Request(c.Server, id)
+func (c *Client) ConfirmTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -574,8 +1764,8 @@ func (c *Client) DeletePostV1(ctx context.Context, id int64, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPostByIdV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPostByIdV1Request(c.Server, id)
+func (c *Client) ConfirmTwoFactorV1(ctx context.Context, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -586,8 +1776,8 @@ func (c *Client) GetPostByIdV1(ctx context.Context, id int64, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePostV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePostV1RequestWithBody(c.Server, id, contentType, body)
+func (c *Client) DisableTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -598,8 +1788,8 @@ func (c *Client) UpdatePostV1WithBody(ctx context.Context, id int64, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePostV1(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePostV1Request(c.Server, id, body)
+func (c *Client) DisableTwoFactorV1(ctx context.Context, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -610,8 +1800,8 @@ func (c *Client) UpdatePostV1(ctx context.Context, id int64, body UpdatePostV1JS
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListCommentsForPostV1(ctx context.Context, postId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCommentsForPostV1Request(c.Server, postId)
+func (c *Client) EnrollTwoFactorV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -622,8 +1812,8 @@ func (c *Client) ListCommentsForPostV1(ctx context.Context, postId int64, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCommentV1WithBody(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCommentV1RequestWithBody(c.Server, postId, contentType, body)
+func (c *Client) RegenerateRecoveryCodesV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -634,8 +1824,8 @@ func (c *Client) CreateCommentV1WithBody(ctx context.Context, postId int64, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCommentV1(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCommentV1Request(c.Server, postId, body)
+func (c *Client) RegenerateRecoveryCodesV1(ctx context.Context, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -646,8 +1836,8 @@ func (c *Client) CreateCommentV1(ctx context.Context, postId int64, body CreateC
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCommentV1(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCommentV1Request(c.Server, postId, id)
+func (c *Client) LoginUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -658,8 +1848,8 @@ func (c *Client) DeleteCommentV1(ctx context.Context, postId int64, id int64, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCommentByIdV1(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCommentByIdV1Request(c.Server, postId, id)
+func (c *Client) LoginUserV1(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -670,8 +1860,8 @@ func (c *Client) GetCommentByIdV1(ctx context.Context, postId int64, id int64, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCommentV1WithBody(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCommentV1RequestWithBody(c.Server, postId, id, contentType, body)
+func (c *Client) VerifyMfaChallengeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMfaChallengeV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -682,8 +1872,8 @@ func (c *Client) UpdateCommentV1WithBody(ctx context.Context, postId int64, id i
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCommentV1(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCommentV1Request(c.Server, postId, id, body)
+func (c *Client) VerifyMfaChallengeV1(ctx context.Context, body VerifyMfaChallengeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMfaChallengeV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -694,8 +1884,8 @@ func (c *Client) UpdateCommentV1(ctx context.Context, postId int64, id int64, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserProfileV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserProfileV1Request(c.Server)
+func (c *Client) LogoutUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -706,8 +1896,8 @@ func (c *Client) GetUserProfileV1(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserProfileV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserProfileV1RequestWithBody(c.Server, contentType, body)
+func (c *Client) LogoutUserV1(ctx context.Context, body LogoutUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -718,8 +1908,8 @@ func (c *Client) UpdateUserProfileV1WithBody(ctx context.Context, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserProfileV1(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserProfileV1Request(c.Server, body)
+func (c *Client) RequestMagicLinkV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestMagicLinkV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -730,2173 +1920,11530 @@ func (c *Client) UpdateUserProfileV1(ctx context.Context, body UpdateUserProfile
 	return c.Client.Do(req)
 }
 
-// NewLoginUserV1Request calls the generic LoginUserV1 builder with application/json body
-func NewLoginUserV1Request(server string, body LoginUserV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RequestMagicLinkV1(ctx context.Context, body RequestMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestMagicLinkV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginUserV1RequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewLoginUserV1RequestWithBody generates requests for LoginUserV1 with any type of body
-func NewLoginUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) VerifyMagicLinkV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMagicLinkV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLogoutUserV1Request generates requests for LogoutUserV1
-func NewLogoutUserV1Request(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) VerifyMagicLinkV1(ctx context.Context, body VerifyMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMagicLinkV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListOidcProvidersV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOidcProvidersV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRefreshAccessTokenV1Request generates requests for RefreshAccessTokenV1
-func NewRefreshAccessTokenV1Request(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) StartOidcLoginV1(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartOidcLoginV1Request(c.Server, provider)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) OidcCallbackV1(ctx context.Context, provider string, params *OidcCallbackV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOidcCallbackV1Request(c.Server, provider, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSignupUserV1Request calls the generic SignupUserV1 builder with application/json body
-func NewSignupUserV1Request(server string, body SignupUserV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ForgotPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForgotPasswordV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSignupUserV1RequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSignupUserV1RequestWithBody generates requests for SignupUserV1 with any type of body
-func NewSignupUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ForgotPasswordV1(ctx context.Context, body ForgotPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForgotPasswordV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/auth/signup")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ResetPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListPostsV1Request generates requests for ListPostsV1
-func NewListPostsV1Request(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ResetPasswordV1(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RefreshAccessTokenV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshAccessTokenV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreatePostV1Request calls the generic CreatePostV1 builder with application/json body
-func NewCreatePostV1Request(server string, body CreatePostV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RefreshAccessTokenV1(ctx context.Context, body RefreshAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshAccessTokenV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePostV1RequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreatePostV1RequestWithBody generates requests for CreatePostV1 with any type of body
-func NewCreatePostV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RevokeOtherSessionsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeOtherSessionsV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSessionsV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSessionsV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeletePostV1Request generates requests for DeletePostV1
-func NewDeletePostV1Request(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RevokeSessionV1(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeSessionV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SignupUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignupUserV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SignupUserV1(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignupUserV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetPostByIdV1Request generates requests for GetPostByIdV1
-func NewGetPostByIdV1Request(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) VerifyEmailV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) VerifyEmailV1(ctx context.Context, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ResendVerificationEmailV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResendVerificationEmailV1Request(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdatePostV1Request calls the generic UpdatePostV1 builder with application/json body
-func NewUpdatePostV1Request(server string, id int64, body UpdatePostV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetHomeFeedV1(ctx context.Context, params *GetHomeFeedV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHomeFeedV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePostV1RequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdatePostV1RequestWithBody generates requests for UpdatePostV1 with any type of body
-func NewUpdatePostV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetAvatarV1(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAvatarV1Request(c.Server, key)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListPostsV1(ctx context.Context, params *ListPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPostsV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePostV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListCommentsForPostV1Request generates requests for ListCommentsForPostV1
-func NewListCommentsForPostV1Request(server string, postId int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+func (c *Client) CreatePostV1(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePostV1Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/posts/%s/comments", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeletePostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePostV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateCommentV1Request calls the generic CreateCommentV1 builder with application/json body
-func NewCreateCommentV1Request(server string, postId int64, body CreateCommentV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetPostByIdV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostByIdV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCommentV1RequestWithBody(server, postId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCommentV1RequestWithBody generates requests for CreateCommentV1 with any type of body
-func NewCreateCommentV1RequestWithBody(server string, postId int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+func (c *Client) UpdatePostV1WithBody(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePostV1RequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdatePostV1(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePostV1Request(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s/comments", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemovePostReactionV1(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemovePostReactionV1Request(c.Server, id, pType)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteCommentV1Request generates requests for DeleteCommentV1
-func NewDeleteCommentV1Request(server string, postId int64, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+func (c *Client) AddPostReactionV1(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPostReactionV1Request(c.Server, id, pType)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UnrepostPostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnrepostPostV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RepostPostV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRepostPostV1Request(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCommentByIdV1Request generates requests for GetCommentByIdV1
-func NewGetCommentByIdV1Request(server string, postId int64, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+func (c *Client) ListCommentsForPostV1(ctx context.Context, postId int64, params *ListCommentsForPostV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCommentsForPostV1Request(c.Server, postId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateCommentV1WithBody(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCommentV1RequestWithBody(c.Server, postId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateCommentV1(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCommentV1Request(c.Server, postId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCommentV1Request calls the generic UpdateCommentV1 builder with application/json body
-func NewUpdateCommentV1Request(server string, postId int64, id int64, body UpdateCommentV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteCommentV1(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCommentV1Request(c.Server, postId, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCommentV1RequestWithBody(server, postId, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCommentV1RequestWithBody generates requests for UpdateCommentV1 with any type of body
-func NewUpdateCommentV1RequestWithBody(server string, postId int64, id int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+func (c *Client) GetCommentByIdV1(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCommentByIdV1Request(c.Server, postId, id)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateCommentV1WithBody(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCommentV1RequestWithBody(c.Server, postId, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateCommentV1(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCommentV1Request(c.Server, postId, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUserProfileV1Request generates requests for GetUserProfileV1
-func NewGetUserProfileV1Request(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveCommentReactionV1(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveCommentReactionV1Request(c.Server, postId, id, pType)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddCommentReactionV1(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddCommentReactionV1Request(c.Server, postId, id, pType)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateUserProfileV1Request calls the generic UpdateUserProfileV1 builder with application/json body
-func NewUpdateUserProfileV1Request(server string, body UpdateUserProfileV1JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListTrendingTagsV1(ctx context.Context, params *ListTrendingTagsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTrendingTagsV1Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserProfileV1RequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateUserProfileV1RequestWithBody generates requests for UpdateUserProfileV1 with any type of body
-func NewUpdateUserProfileV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTagPostsV1(ctx context.Context, tag string, params *ListTagPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagPostsV1Request(c.Server, tag, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteAccountV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) DeleteAccountV1(ctx context.Context, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetUserProfileV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserProfileV1Request(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) UpdateUserProfileV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserProfileV1RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) UpdateUserProfileV1(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserProfileV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginUserV1WithBodyWithResponse request with any body
-	LoginUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error)
-
-	LoginUserV1WithResponse(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error)
-
-	// LogoutUserV1WithResponse request
-	LogoutUserV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error)
-
-	// RefreshAccessTokenV1WithResponse request
-	RefreshAccessTokenV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error)
-
-	// SignupUserV1WithBodyWithResponse request with any body
-	SignupUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error)
-
-	SignupUserV1WithResponse(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error)
-
-	// ListPostsV1WithResponse request
-	ListPostsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPostsV1Response, error)
-
-	// CreatePostV1WithBodyWithResponse request with any body
-	CreatePostV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error)
-
-	CreatePostV1WithResponse(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error)
-
-	// DeletePostV1WithResponse request
-	DeletePostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePostV1Response, error)
-
-	// GetPostByIdV1WithResponse request
-	GetPostByIdV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetPostByIdV1Response, error)
-
-	// UpdatePostV1WithBodyWithResponse request with any body
-	UpdatePostV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error)
-
-	UpdatePostV1WithResponse(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error)
-
-	// ListCommentsForPostV1WithResponse request
-	ListCommentsForPostV1WithResponse(ctx context.Context, postId int64, reqEditors ...RequestEditorFn) (*ListCommentsForPostV1Response, error)
-
-	// CreateCommentV1WithBodyWithResponse request with any body
-	CreateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error)
-
-	CreateCommentV1WithResponse(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error)
-
-	// DeleteCommentV1WithResponse request
-	DeleteCommentV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*DeleteCommentV1Response, error)
-
-	// GetCommentByIdV1WithResponse request
-	GetCommentByIdV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*GetCommentByIdV1Response, error)
-
-	// UpdateCommentV1WithBodyWithResponse request with any body
-	UpdateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error)
-
-	UpdateCommentV1WithResponse(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error)
-
-	// GetUserProfileV1WithResponse request
-	GetUserProfileV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserProfileV1Response, error)
-
-	// UpdateUserProfileV1WithBodyWithResponse request with any body
-	UpdateUserProfileV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error)
-
-	UpdateUserProfileV1WithResponse(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error)
+func (c *Client) DeleteAvatarV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAvatarV1Request(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type LoginUserV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) UploadAvatarV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadAvatarV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r LoginUserV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ListBlockedUsersV1(ctx context.Context, params *ListBlockedUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBlockedUsersV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginUserV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) RequestEmailChangeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type LogoutUserV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *ApiErrorResponse
+func (c *Client) RequestEmailChangeV1(ctx context.Context, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r LogoutUserV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ConfirmEmailChangeV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LogoutUserV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) ConfirmEmailChangeV1(ctx context.Context, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type RefreshAccessTokenV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) ListMutedUsersV1(ctx context.Context, params *ListMutedUsersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMutedUsersV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r RefreshAccessTokenV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ChangePasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RefreshAccessTokenV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) ChangePasswordV1(ctx context.Context, body ChangePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type SignupUserV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SignupSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON409      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) ListPersonalAccessTokensV1(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPersonalAccessTokensV1Request(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r SignupUserV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) CreatePersonalAccessTokenV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignupUserV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) CreatePersonalAccessTokenV1(ctx context.Context, body CreatePersonalAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type ListPostsV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListPostsSuccessResponse
-	JSON401      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) RevokePersonalAccessTokenV1(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokePersonalAccessTokenV1Request(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListPostsV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetPublicUserProfileV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublicUserProfileV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPostsV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) UnblockUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnblockUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CreatePostV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreatePostSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) BlockUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBlockUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreatePostV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UnfollowUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnfollowUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePostV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) FollowUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFollowUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type DeletePostV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ApiErrorResponse
-	JSON403      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) ListFollowersV1(ctx context.Context, username string, params *ListFollowersV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFollowersV1Request(c.Server, username, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePostV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ListFollowingV1(ctx context.Context, username string, params *ListFollowingV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFollowingV1Request(c.Server, username, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePostV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) UnmuteUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnmuteUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetPostByIdV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetPostSuccessResponse
-	JSON401      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+func (c *Client) MuteUserV1(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMuteUserV1Request(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPostByIdV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ListUserPostsV1(ctx context.Context, username string, params *ListUserPostsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUserPostsV1Request(c.Server, username, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPostByIdV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetJWKSRequest generates requests for GetJWKS
+func NewGetJWKSRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdatePostV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdatePostSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON403      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
-}
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdatePostV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePostV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListCommentsForPostV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListCommentsSuccessResponse
-	JSON401      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCommentsForPostV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListImpersonationLogV1Request generates requests for ListImpersonationLogV1
+func NewListImpersonationLogV1Request(server string, params *ListImpersonationLogV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCommentsForPostV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/admin/impersonation-log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateCommentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CreateCommentSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateCommentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCommentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCommentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ApiErrorResponse
-	JSON403      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCommentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewEndImpersonationV1Request generates requests for EndImpersonationV1
+func NewEndImpersonationV1Request(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCommentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCommentByIdV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetCommentSuccessResponse
-	JSON401      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
-}
+	operationPath := fmt.Sprintf("/v1/admin/impersonations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCommentByIdV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCommentByIdV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCommentV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdateCommentSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON403      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCommentV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListModerationLogV1Request generates requests for ListModerationLogV1
+func NewListModerationLogV1Request(server string, params *ListModerationLogV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCommentV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/admin/moderation-log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetUserProfileV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetUserProfileSuccessResponse
-	JSON401      *ApiErrorResponse
-	JSON404      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUserProfileV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserProfileV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateUserProfileV1Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdateUserProfileSuccessResponse
-	JSON400      *ApiErrorResponse
-	JSON401      *ApiErrorResponse
-	JSON500      *ApiErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateUserProfileV1Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewStartImpersonationV1Request calls the generic StartImpersonationV1 builder with application/json body
+func NewStartImpersonationV1Request(server string, id int64, body StartImpersonationV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewStartImpersonationV1RequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateUserProfileV1Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewStartImpersonationV1RequestWithBody generates requests for StartImpersonationV1 with any type of body
+func NewStartImpersonationV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/users/%s/impersonate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// LoginUserV1WithBodyWithResponse request with arbitrary body returning *LoginUserV1Response
-func (c *ClientWithResponses) LoginUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error) {
-	rsp, err := c.LoginUserV1WithBody(ctx, contentType, body, reqEditors...)
+// NewUpdateUserRoleV1Request calls the generic UpdateUserRoleV1 builder with application/json body
+func NewUpdateUserRoleV1Request(server string, id int64, body UpdateUserRoleV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginUserV1Response(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRoleV1RequestWithBody(server, id, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) LoginUserV1WithResponse(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error) {
-	rsp, err := c.LoginUserV1(ctx, body, reqEditors...)
+// NewUpdateUserRoleV1RequestWithBody generates requests for UpdateUserRoleV1 with any type of body
+func NewUpdateUserRoleV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginUserV1Response(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/users/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// LogoutUserV1WithResponse request returning *LogoutUserV1Response
-func (c *ClientWithResponses) LogoutUserV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error) {
-	rsp, err := c.LogoutUserV1(ctx, reqEditors...)
+// NewGetTwoFactorStatusV1Request generates requests for GetTwoFactorStatusV1
+func NewGetTwoFactorStatusV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLogoutUserV1Response(rsp)
+
+	operationPath := fmt.Sprintf("/v1/auth/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmTwoFactorV1Request calls the generic ConfirmTwoFactorV1 builder with application/json body
+func NewConfirmTwoFactorV1Request(server string, body ConfirmTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTwoFactorV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmTwoFactorV1RequestWithBody generates requests for ConfirmTwoFactorV1 with any type of body
+func NewConfirmTwoFactorV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableTwoFactorV1Request calls the generic DisableTwoFactorV1 builder with application/json body
+func NewDisableTwoFactorV1Request(server string, body DisableTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTwoFactorV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisableTwoFactorV1RequestWithBody generates requests for DisableTwoFactorV1 with any type of body
+func NewDisableTwoFactorV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTwoFactorV1Request generates requests for EnrollTwoFactorV1
+func NewEnrollTwoFactorV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesV1Request calls the generic RegenerateRecoveryCodesV1 builder with application/json body
+func NewRegenerateRecoveryCodesV1Request(server string, body RegenerateRecoveryCodesV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesV1RequestWithBody generates requests for RegenerateRecoveryCodesV1 with any type of body
+func NewRegenerateRecoveryCodesV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/2fa/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginUserV1Request calls the generic LoginUserV1 builder with application/json body
+func NewLoginUserV1Request(server string, body LoginUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginUserV1RequestWithBody generates requests for LoginUserV1 with any type of body
+func NewLoginUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyMfaChallengeV1Request calls the generic VerifyMfaChallengeV1 builder with application/json body
+func NewVerifyMfaChallengeV1Request(server string, body VerifyMfaChallengeV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyMfaChallengeV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyMfaChallengeV1RequestWithBody generates requests for VerifyMfaChallengeV1 with any type of body
+func NewVerifyMfaChallengeV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/login/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutUserV1Request calls the generic LogoutUserV1 builder with application/json body
+func NewLogoutUserV1Request(server string, body LogoutUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLogoutUserV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLogoutUserV1RequestWithBody generates requests for LogoutUserV1 with any type of body
+func NewLogoutUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestMagicLinkV1Request calls the generic RequestMagicLinkV1 builder with application/json body
+func NewRequestMagicLinkV1Request(server string, body RequestMagicLinkV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestMagicLinkV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestMagicLinkV1RequestWithBody generates requests for RequestMagicLinkV1 with any type of body
+func NewRequestMagicLinkV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/magic-link")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyMagicLinkV1Request calls the generic VerifyMagicLinkV1 builder with application/json body
+func NewVerifyMagicLinkV1Request(server string, body VerifyMagicLinkV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyMagicLinkV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyMagicLinkV1RequestWithBody generates requests for VerifyMagicLinkV1 with any type of body
+func NewVerifyMagicLinkV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/magic-link/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOidcProvidersV1Request generates requests for ListOidcProvidersV1
+func NewListOidcProvidersV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/oidc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartOidcLoginV1Request generates requests for StartOidcLoginV1
+func NewStartOidcLoginV1Request(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/oidc/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOidcCallbackV1Request generates requests for OidcCallbackV1
+func NewOidcCallbackV1Request(server string, provider string, params *OidcCallbackV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/oidc/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewForgotPasswordV1Request calls the generic ForgotPasswordV1 builder with application/json body
+func NewForgotPasswordV1Request(server string, body ForgotPasswordV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewForgotPasswordV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewForgotPasswordV1RequestWithBody generates requests for ForgotPasswordV1 with any type of body
+func NewForgotPasswordV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/password/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetPasswordV1Request calls the generic ResetPasswordV1 builder with application/json body
+func NewResetPasswordV1Request(server string, body ResetPasswordV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetPasswordV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResetPasswordV1RequestWithBody generates requests for ResetPasswordV1 with any type of body
+func NewResetPasswordV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshAccessTokenV1Request calls the generic RefreshAccessTokenV1 builder with application/json body
+func NewRefreshAccessTokenV1Request(server string, body RefreshAccessTokenV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshAccessTokenV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshAccessTokenV1RequestWithBody generates requests for RefreshAccessTokenV1 with any type of body
+func NewRefreshAccessTokenV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeOtherSessionsV1Request generates requests for RevokeOtherSessionsV1
+func NewRevokeOtherSessionsV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSessionsV1Request generates requests for ListSessionsV1
+func NewListSessionsV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeSessionV1Request generates requests for RevokeSessionV1
+func NewRevokeSessionV1Request(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignupUserV1Request calls the generic SignupUserV1 builder with application/json body
+func NewSignupUserV1Request(server string, body SignupUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignupUserV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSignupUserV1RequestWithBody generates requests for SignupUserV1 with any type of body
+func NewSignupUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/signup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailV1Request calls the generic VerifyEmailV1 builder with application/json body
+func NewVerifyEmailV1Request(server string, body VerifyEmailV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyEmailV1RequestWithBody generates requests for VerifyEmailV1 with any type of body
+func NewVerifyEmailV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResendVerificationEmailV1Request generates requests for ResendVerificationEmailV1
+func NewResendVerificationEmailV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/verify-email/resend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHomeFeedV1Request generates requests for GetHomeFeedV1
+func NewGetHomeFeedV1Request(server string, params *GetHomeFeedV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/feed/home")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAvatarV1Request generates requests for GetAvatarV1
+func NewGetAvatarV1Request(server string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/media/avatars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPostsV1Request generates requests for ListPostsV1
+func NewListPostsV1Request(server string, params *ListPostsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePostV1Request calls the generic CreatePostV1 builder with application/json body
+func NewCreatePostV1Request(server string, body CreatePostV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePostV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePostV1RequestWithBody generates requests for CreatePostV1 with any type of body
+func NewCreatePostV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePostV1Request generates requests for DeletePostV1
+func NewDeletePostV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPostByIdV1Request generates requests for GetPostByIdV1
+func NewGetPostByIdV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePostV1Request calls the generic UpdatePostV1 builder with application/json body
+func NewUpdatePostV1Request(server string, id int64, body UpdatePostV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePostV1RequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdatePostV1RequestWithBody generates requests for UpdatePostV1 with any type of body
+func NewUpdatePostV1RequestWithBody(server string, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemovePostReactionV1Request generates requests for RemovePostReactionV1
+func NewRemovePostReactionV1Request(server string, id int64, pType string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "type", runtime.ParamLocationPath, pType)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/reactions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddPostReactionV1Request generates requests for AddPostReactionV1
+func NewAddPostReactionV1Request(server string, id int64, pType string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "type", runtime.ParamLocationPath, pType)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/reactions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnrepostPostV1Request generates requests for UnrepostPostV1
+func NewUnrepostPostV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/repost", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRepostPostV1Request generates requests for RepostPostV1
+func NewRepostPostV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/repost", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCommentsForPostV1Request generates requests for ListCommentsForPostV1
+func NewListCommentsForPostV1Request(server string, postId int64, params *ListCommentsForPostV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCommentV1Request calls the generic CreateCommentV1 builder with application/json body
+func NewCreateCommentV1Request(server string, postId int64, body CreateCommentV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCommentV1RequestWithBody(server, postId, "application/json", bodyReader)
+}
+
+// NewCreateCommentV1RequestWithBody generates requests for CreateCommentV1 with any type of body
+func NewCreateCommentV1RequestWithBody(server string, postId int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCommentV1Request generates requests for DeleteCommentV1
+func NewDeleteCommentV1Request(server string, postId int64, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCommentByIdV1Request generates requests for GetCommentByIdV1
+func NewGetCommentByIdV1Request(server string, postId int64, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCommentV1Request calls the generic UpdateCommentV1 builder with application/json body
+func NewUpdateCommentV1Request(server string, postId int64, id int64, body UpdateCommentV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCommentV1RequestWithBody(server, postId, id, "application/json", bodyReader)
+}
+
+// NewUpdateCommentV1RequestWithBody generates requests for UpdateCommentV1 with any type of body
+func NewUpdateCommentV1RequestWithBody(server string, postId int64, id int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveCommentReactionV1Request generates requests for RemoveCommentReactionV1
+func NewRemoveCommentReactionV1Request(server string, postId int64, id int64, pType string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "type", runtime.ParamLocationPath, pType)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s/reactions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddCommentReactionV1Request generates requests for AddCommentReactionV1
+func NewAddCommentReactionV1Request(server string, postId int64, id int64, pType string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "postId", runtime.ParamLocationPath, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "type", runtime.ParamLocationPath, pType)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/posts/%s/comments/%s/reactions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTrendingTagsV1Request generates requests for ListTrendingTagsV1
+func NewListTrendingTagsV1Request(server string, params *ListTrendingTagsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/trending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTagPostsV1Request generates requests for ListTagPostsV1
+func NewListTagPostsV1Request(server string, tag string, params *ListTagPostsV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tags/%s/posts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAccountV1Request calls the generic DeleteAccountV1 builder with application/json body
+func NewDeleteAccountV1Request(server string, body DeleteAccountV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteAccountV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteAccountV1RequestWithBody generates requests for DeleteAccountV1 with any type of body
+func NewDeleteAccountV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserProfileV1Request generates requests for GetUserProfileV1
+func NewGetUserProfileV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserProfileV1Request calls the generic UpdateUserProfileV1 builder with application/json body
+func NewUpdateUserProfileV1Request(server string, body UpdateUserProfileV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserProfileV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateUserProfileV1RequestWithBody generates requests for UpdateUserProfileV1 with any type of body
+func NewUpdateUserProfileV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAvatarV1Request generates requests for DeleteAvatarV1
+func NewDeleteAvatarV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/avatar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadAvatarV1RequestWithBody generates requests for UploadAvatarV1 with any type of body
+func NewUploadAvatarV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/avatar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBlockedUsersV1Request generates requests for ListBlockedUsersV1
+func NewListBlockedUsersV1Request(server string, params *ListBlockedUsersV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/blocks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRequestEmailChangeV1Request calls the generic RequestEmailChangeV1 builder with application/json body
+func NewRequestEmailChangeV1Request(server string, body RequestEmailChangeV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestEmailChangeV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestEmailChangeV1RequestWithBody generates requests for RequestEmailChangeV1 with any type of body
+func NewRequestEmailChangeV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmEmailChangeV1Request calls the generic ConfirmEmailChangeV1 builder with application/json body
+func NewConfirmEmailChangeV1Request(server string, body ConfirmEmailChangeV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmEmailChangeV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmEmailChangeV1RequestWithBody generates requests for ConfirmEmailChangeV1 with any type of body
+func NewConfirmEmailChangeV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/email/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListMutedUsersV1Request generates requests for ListMutedUsersV1
+func NewListMutedUsersV1Request(server string, params *ListMutedUsersV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/mutes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChangePasswordV1Request calls the generic ChangePasswordV1 builder with application/json body
+func NewChangePasswordV1Request(server string, body ChangePasswordV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordV1RequestWithBody generates requests for ChangePasswordV1 with any type of body
+func NewChangePasswordV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPersonalAccessTokensV1Request generates requests for ListPersonalAccessTokensV1
+func NewListPersonalAccessTokensV1Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePersonalAccessTokenV1Request calls the generic CreatePersonalAccessTokenV1 builder with application/json body
+func NewCreatePersonalAccessTokenV1Request(server string, body CreatePersonalAccessTokenV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePersonalAccessTokenV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePersonalAccessTokenV1RequestWithBody generates requests for CreatePersonalAccessTokenV1 with any type of body
+func NewCreatePersonalAccessTokenV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokePersonalAccessTokenV1Request generates requests for RevokePersonalAccessTokenV1
+func NewRevokePersonalAccessTokenV1Request(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPublicUserProfileV1Request generates requests for GetPublicUserProfileV1
+func NewGetPublicUserProfileV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnblockUserV1Request generates requests for UnblockUserV1
+func NewUnblockUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/block", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBlockUserV1Request generates requests for BlockUserV1
+func NewBlockUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/block", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnfollowUserV1Request generates requests for UnfollowUserV1
+func NewUnfollowUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/follow", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFollowUserV1Request generates requests for FollowUserV1
+func NewFollowUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/follow", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListFollowersV1Request generates requests for ListFollowersV1
+func NewListFollowersV1Request(server string, username string, params *ListFollowersV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/followers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListFollowingV1Request generates requests for ListFollowingV1
+func NewListFollowingV1Request(server string, username string, params *ListFollowingV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/following", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnmuteUserV1Request generates requests for UnmuteUserV1
+func NewUnmuteUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/mute", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMuteUserV1Request generates requests for MuteUserV1
+func NewMuteUserV1Request(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/mute", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListUserPostsV1Request generates requests for ListUserPostsV1
+func NewListUserPostsV1Request(server string, username string, params *ListUserPostsV1Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/posts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJWKSWithResponse request
+	GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error)
+
+	// ListImpersonationLogV1WithResponse request
+	ListImpersonationLogV1WithResponse(ctx context.Context, params *ListImpersonationLogV1Params, reqEditors ...RequestEditorFn) (*ListImpersonationLogV1Response, error)
+
+	// EndImpersonationV1WithResponse request
+	EndImpersonationV1WithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*EndImpersonationV1Response, error)
+
+	// ListModerationLogV1WithResponse request
+	ListModerationLogV1WithResponse(ctx context.Context, params *ListModerationLogV1Params, reqEditors ...RequestEditorFn) (*ListModerationLogV1Response, error)
+
+	// StartImpersonationV1WithBodyWithResponse request with any body
+	StartImpersonationV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartImpersonationV1Response, error)
+
+	StartImpersonationV1WithResponse(ctx context.Context, id int64, body StartImpersonationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*StartImpersonationV1Response, error)
+
+	// UpdateUserRoleV1WithBodyWithResponse request with any body
+	UpdateUserRoleV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserRoleV1Response, error)
+
+	UpdateUserRoleV1WithResponse(ctx context.Context, id int64, body UpdateUserRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserRoleV1Response, error)
+
+	// GetTwoFactorStatusV1WithResponse request
+	GetTwoFactorStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTwoFactorStatusV1Response, error)
+
+	// ConfirmTwoFactorV1WithBodyWithResponse request with any body
+	ConfirmTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error)
+
+	ConfirmTwoFactorV1WithResponse(ctx context.Context, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error)
+
+	// DisableTwoFactorV1WithBodyWithResponse request with any body
+	DisableTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error)
+
+	DisableTwoFactorV1WithResponse(ctx context.Context, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error)
+
+	// EnrollTwoFactorV1WithResponse request
+	EnrollTwoFactorV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error)
+
+	// RegenerateRecoveryCodesV1WithBodyWithResponse request with any body
+	RegenerateRecoveryCodesV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error)
+
+	RegenerateRecoveryCodesV1WithResponse(ctx context.Context, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error)
+
+	// LoginUserV1WithBodyWithResponse request with any body
+	LoginUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error)
+
+	LoginUserV1WithResponse(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error)
+
+	// VerifyMfaChallengeV1WithBodyWithResponse request with any body
+	VerifyMfaChallengeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMfaChallengeV1Response, error)
+
+	VerifyMfaChallengeV1WithResponse(ctx context.Context, body VerifyMfaChallengeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMfaChallengeV1Response, error)
+
+	// LogoutUserV1WithBodyWithResponse request with any body
+	LogoutUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error)
+
+	LogoutUserV1WithResponse(ctx context.Context, body LogoutUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error)
+
+	// RequestMagicLinkV1WithBodyWithResponse request with any body
+	RequestMagicLinkV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestMagicLinkV1Response, error)
+
+	RequestMagicLinkV1WithResponse(ctx context.Context, body RequestMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestMagicLinkV1Response, error)
+
+	// VerifyMagicLinkV1WithBodyWithResponse request with any body
+	VerifyMagicLinkV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMagicLinkV1Response, error)
+
+	VerifyMagicLinkV1WithResponse(ctx context.Context, body VerifyMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMagicLinkV1Response, error)
+
+	// ListOidcProvidersV1WithResponse request
+	ListOidcProvidersV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOidcProvidersV1Response, error)
+
+	// StartOidcLoginV1WithResponse request
+	StartOidcLoginV1WithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*StartOidcLoginV1Response, error)
+
+	// OidcCallbackV1WithResponse request
+	OidcCallbackV1WithResponse(ctx context.Context, provider string, params *OidcCallbackV1Params, reqEditors ...RequestEditorFn) (*OidcCallbackV1Response, error)
+
+	// ForgotPasswordV1WithBodyWithResponse request with any body
+	ForgotPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForgotPasswordV1Response, error)
+
+	ForgotPasswordV1WithResponse(ctx context.Context, body ForgotPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ForgotPasswordV1Response, error)
+
+	// ResetPasswordV1WithBodyWithResponse request with any body
+	ResetPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error)
+
+	ResetPasswordV1WithResponse(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error)
+
+	// RefreshAccessTokenV1WithBodyWithResponse request with any body
+	RefreshAccessTokenV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error)
+
+	RefreshAccessTokenV1WithResponse(ctx context.Context, body RefreshAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error)
+
+	// RevokeOtherSessionsV1WithResponse request
+	RevokeOtherSessionsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeOtherSessionsV1Response, error)
+
+	// ListSessionsV1WithResponse request
+	ListSessionsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsV1Response, error)
+
+	// RevokeSessionV1WithResponse request
+	RevokeSessionV1WithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionV1Response, error)
+
+	// SignupUserV1WithBodyWithResponse request with any body
+	SignupUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error)
+
+	SignupUserV1WithResponse(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error)
+
+	// VerifyEmailV1WithBodyWithResponse request with any body
+	VerifyEmailV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error)
+
+	VerifyEmailV1WithResponse(ctx context.Context, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error)
+
+	// ResendVerificationEmailV1WithResponse request
+	ResendVerificationEmailV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResendVerificationEmailV1Response, error)
+
+	// GetHomeFeedV1WithResponse request
+	GetHomeFeedV1WithResponse(ctx context.Context, params *GetHomeFeedV1Params, reqEditors ...RequestEditorFn) (*GetHomeFeedV1Response, error)
+
+	// GetAvatarV1WithResponse request
+	GetAvatarV1WithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetAvatarV1Response, error)
+
+	// ListPostsV1WithResponse request
+	ListPostsV1WithResponse(ctx context.Context, params *ListPostsV1Params, reqEditors ...RequestEditorFn) (*ListPostsV1Response, error)
+
+	// CreatePostV1WithBodyWithResponse request with any body
+	CreatePostV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error)
+
+	CreatePostV1WithResponse(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error)
+
+	// DeletePostV1WithResponse request
+	DeletePostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePostV1Response, error)
+
+	// GetPostByIdV1WithResponse request
+	GetPostByIdV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetPostByIdV1Response, error)
+
+	// UpdatePostV1WithBodyWithResponse request with any body
+	UpdatePostV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error)
+
+	UpdatePostV1WithResponse(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error)
+
+	// RemovePostReactionV1WithResponse request
+	RemovePostReactionV1WithResponse(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*RemovePostReactionV1Response, error)
+
+	// AddPostReactionV1WithResponse request
+	AddPostReactionV1WithResponse(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*AddPostReactionV1Response, error)
+
+	// UnrepostPostV1WithResponse request
+	UnrepostPostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*UnrepostPostV1Response, error)
+
+	// RepostPostV1WithResponse request
+	RepostPostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RepostPostV1Response, error)
+
+	// ListCommentsForPostV1WithResponse request
+	ListCommentsForPostV1WithResponse(ctx context.Context, postId int64, params *ListCommentsForPostV1Params, reqEditors ...RequestEditorFn) (*ListCommentsForPostV1Response, error)
+
+	// CreateCommentV1WithBodyWithResponse request with any body
+	CreateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error)
+
+	CreateCommentV1WithResponse(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error)
+
+	// DeleteCommentV1WithResponse request
+	DeleteCommentV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*DeleteCommentV1Response, error)
+
+	// GetCommentByIdV1WithResponse request
+	GetCommentByIdV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*GetCommentByIdV1Response, error)
+
+	// UpdateCommentV1WithBodyWithResponse request with any body
+	UpdateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error)
+
+	UpdateCommentV1WithResponse(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error)
+
+	// RemoveCommentReactionV1WithResponse request
+	RemoveCommentReactionV1WithResponse(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*RemoveCommentReactionV1Response, error)
+
+	// AddCommentReactionV1WithResponse request
+	AddCommentReactionV1WithResponse(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*AddCommentReactionV1Response, error)
+
+	// ListTrendingTagsV1WithResponse request
+	ListTrendingTagsV1WithResponse(ctx context.Context, params *ListTrendingTagsV1Params, reqEditors ...RequestEditorFn) (*ListTrendingTagsV1Response, error)
+
+	// ListTagPostsV1WithResponse request
+	ListTagPostsV1WithResponse(ctx context.Context, tag string, params *ListTagPostsV1Params, reqEditors ...RequestEditorFn) (*ListTagPostsV1Response, error)
+
+	// DeleteAccountV1WithBodyWithResponse request with any body
+	DeleteAccountV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error)
+
+	DeleteAccountV1WithResponse(ctx context.Context, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error)
+
+	// GetUserProfileV1WithResponse request
+	GetUserProfileV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserProfileV1Response, error)
+
+	// UpdateUserProfileV1WithBodyWithResponse request with any body
+	UpdateUserProfileV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error)
+
+	UpdateUserProfileV1WithResponse(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error)
+
+	// DeleteAvatarV1WithResponse request
+	DeleteAvatarV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAvatarV1Response, error)
+
+	// UploadAvatarV1WithBodyWithResponse request with any body
+	UploadAvatarV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAvatarV1Response, error)
+
+	// ListBlockedUsersV1WithResponse request
+	ListBlockedUsersV1WithResponse(ctx context.Context, params *ListBlockedUsersV1Params, reqEditors ...RequestEditorFn) (*ListBlockedUsersV1Response, error)
+
+	// RequestEmailChangeV1WithBodyWithResponse request with any body
+	RequestEmailChangeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error)
+
+	RequestEmailChangeV1WithResponse(ctx context.Context, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error)
+
+	// ConfirmEmailChangeV1WithBodyWithResponse request with any body
+	ConfirmEmailChangeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error)
+
+	ConfirmEmailChangeV1WithResponse(ctx context.Context, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error)
+
+	// ListMutedUsersV1WithResponse request
+	ListMutedUsersV1WithResponse(ctx context.Context, params *ListMutedUsersV1Params, reqEditors ...RequestEditorFn) (*ListMutedUsersV1Response, error)
+
+	// ChangePasswordV1WithBodyWithResponse request with any body
+	ChangePasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordV1Response, error)
+
+	ChangePasswordV1WithResponse(ctx context.Context, body ChangePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordV1Response, error)
+
+	// ListPersonalAccessTokensV1WithResponse request
+	ListPersonalAccessTokensV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensV1Response, error)
+
+	// CreatePersonalAccessTokenV1WithBodyWithResponse request with any body
+	CreatePersonalAccessTokenV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenV1Response, error)
+
+	CreatePersonalAccessTokenV1WithResponse(ctx context.Context, body CreatePersonalAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenV1Response, error)
+
+	// RevokePersonalAccessTokenV1WithResponse request
+	RevokePersonalAccessTokenV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokePersonalAccessTokenV1Response, error)
+
+	// GetPublicUserProfileV1WithResponse request
+	GetPublicUserProfileV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetPublicUserProfileV1Response, error)
+
+	// UnblockUserV1WithResponse request
+	UnblockUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnblockUserV1Response, error)
+
+	// BlockUserV1WithResponse request
+	BlockUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*BlockUserV1Response, error)
+
+	// UnfollowUserV1WithResponse request
+	UnfollowUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnfollowUserV1Response, error)
+
+	// FollowUserV1WithResponse request
+	FollowUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*FollowUserV1Response, error)
+
+	// ListFollowersV1WithResponse request
+	ListFollowersV1WithResponse(ctx context.Context, username string, params *ListFollowersV1Params, reqEditors ...RequestEditorFn) (*ListFollowersV1Response, error)
+
+	// ListFollowingV1WithResponse request
+	ListFollowingV1WithResponse(ctx context.Context, username string, params *ListFollowingV1Params, reqEditors ...RequestEditorFn) (*ListFollowingV1Response, error)
+
+	// UnmuteUserV1WithResponse request
+	UnmuteUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnmuteUserV1Response, error)
+
+	// MuteUserV1WithResponse request
+	MuteUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*MuteUserV1Response, error)
+
+	// ListUserPostsV1WithResponse request
+	ListUserPostsV1WithResponse(ctx context.Context, username string, params *ListUserPostsV1Params, reqEditors ...RequestEditorFn) (*ListUserPostsV1Response, error)
+}
+
+type GetJWKSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JSONWebKeySet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJWKSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJWKSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListImpersonationLogV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListImpersonationLogSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListImpersonationLogV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListImpersonationLogV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EndImpersonationV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EndImpersonationV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EndImpersonationV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListModerationLogV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListModerationLogSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListModerationLogV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListModerationLogV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartImpersonationV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StartImpersonationSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartImpersonationV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartImpersonationV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserRoleV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateUserRoleSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserRoleV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserRoleV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTwoFactorStatusV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorStatusSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTwoFactorStatusV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTwoFactorStatusV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TOTPEnrollmentSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodesSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginSuccessResponse
+	JSON202      *MFAChallengeSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyMfaChallengeV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyMfaChallengeV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyMfaChallengeV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestMagicLinkV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestMagicLinkV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestMagicLinkV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyMagicLinkV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginSuccessResponse
+	JSON202      *MFAChallengeSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyMagicLinkV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyMagicLinkV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOidcProvidersV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OIDCProvidersSuccessResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOidcProvidersV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOidcProvidersV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartOidcLoginV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartOidcLoginV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartOidcLoginV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OidcCallbackV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r OidcCallbackV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OidcCallbackV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForgotPasswordV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ForgotPasswordV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForgotPasswordV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPasswordV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPasswordV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPasswordV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshAccessTokenV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshAccessTokenV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshAccessTokenV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeOtherSessionsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeOtherSessionsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeOtherSessionsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSessionsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSessionsSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSessionsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSessionsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeSessionV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeSessionV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeSessionV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignupUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SignupSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON409      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SignupUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignupUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResendVerificationEmailV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResendVerificationEmailV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResendVerificationEmailV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHomeFeedV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HomeFeedSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHomeFeedV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHomeFeedV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAvatarV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAvatarV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAvatarV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPostsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListPostsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPostsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPostsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePostSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostByIdV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetPostSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostByIdV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostByIdV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdatePostSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemovePostReactionV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemovePostReactionV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemovePostReactionV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddPostReactionV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPostReactionV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPostReactionV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnrepostPostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnrepostPostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnrepostPostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RepostPostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePostSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON409      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RepostPostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RepostPostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCommentsForPostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListCommentsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCommentsForPostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCommentsForPostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCommentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateCommentSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCommentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCommentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCommentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCommentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCommentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCommentByIdV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetCommentSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCommentByIdV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCommentByIdV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCommentV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateCommentSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCommentV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCommentV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveCommentReactionV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveCommentReactionV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveCommentReactionV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddCommentReactionV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddCommentReactionV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddCommentReactionV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTrendingTagsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrendingTagsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTrendingTagsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTrendingTagsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagPostsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListTagPostsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagPostsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagPostsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *AccountDeletionSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserProfileV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserProfileSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserProfileV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserProfileV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserProfileV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateUserProfileSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserProfileV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserProfileV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAvatarV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAvatarV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAvatarV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadAvatarV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadAvatarSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON413      *ApiErrorResponse
+	JSON415      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadAvatarV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAvatarV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBlockedUsersV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListBlockedUsersSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBlockedUsersV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBlockedUsersV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestEmailChangeV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON409      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestEmailChangeV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestEmailChangeV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEmailChangeV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserProfileSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON409      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEmailChangeV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEmailChangeV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMutedUsersV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListBlockedUsersSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMutedUsersV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMutedUsersV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON429      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPersonalAccessTokensV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListPersonalAccessTokensSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPersonalAccessTokensV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPersonalAccessTokensV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePersonalAccessTokenV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePersonalAccessTokenSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePersonalAccessTokenV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePersonalAccessTokenV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokePersonalAccessTokenV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokePersonalAccessTokenV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokePersonalAccessTokenV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPublicUserProfileV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetPublicUserProfileSuccessResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublicUserProfileV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublicUserProfileV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnblockUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnblockUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnblockUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BlockUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BlockUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BlockUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnfollowUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnfollowUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnfollowUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FollowUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON403      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON409      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FollowUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FollowUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFollowersV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListFollowsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFollowersV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFollowersV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFollowingV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListFollowsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFollowingV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFollowingV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnmuteUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnmuteUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnmuteUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MuteUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MuteUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MuteUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUserPostsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListPostsSuccessResponse
+	JSON400      *ApiErrorResponse
+	JSON401      *ApiErrorResponse
+	JSON404      *ApiErrorResponse
+	JSON500      *ApiErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserPostsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserPostsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJWKSWithResponse request returning *GetJWKSResponse
+func (c *ClientWithResponses) GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error) {
+	rsp, err := c.GetJWKS(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJWKSResponse(rsp)
+}
+
+// ListImpersonationLogV1WithResponse request returning *ListImpersonationLogV1Response
+func (c *ClientWithResponses) ListImpersonationLogV1WithResponse(ctx context.Context, params *ListImpersonationLogV1Params, reqEditors ...RequestEditorFn) (*ListImpersonationLogV1Response, error) {
+	rsp, err := c.ListImpersonationLogV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListImpersonationLogV1Response(rsp)
+}
+
+// EndImpersonationV1WithResponse request returning *EndImpersonationV1Response
+func (c *ClientWithResponses) EndImpersonationV1WithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*EndImpersonationV1Response, error) {
+	rsp, err := c.EndImpersonationV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEndImpersonationV1Response(rsp)
+}
+
+// ListModerationLogV1WithResponse request returning *ListModerationLogV1Response
+func (c *ClientWithResponses) ListModerationLogV1WithResponse(ctx context.Context, params *ListModerationLogV1Params, reqEditors ...RequestEditorFn) (*ListModerationLogV1Response, error) {
+	rsp, err := c.ListModerationLogV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListModerationLogV1Response(rsp)
+}
+
+// StartImpersonationV1WithBodyWithResponse request with arbitrary body returning *StartImpersonationV1Response
+func (c *ClientWithResponses) StartImpersonationV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartImpersonationV1Response, error) {
+	rsp, err := c.StartImpersonationV1WithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartImpersonationV1Response(rsp)
+}
+
+func (c *ClientWithResponses) StartImpersonationV1WithResponse(ctx context.Context, id int64, body StartImpersonationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*StartImpersonationV1Response, error) {
+	rsp, err := c.StartImpersonationV1(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartImpersonationV1Response(rsp)
+}
+
+// UpdateUserRoleV1WithBodyWithResponse request with arbitrary body returning *UpdateUserRoleV1Response
+func (c *ClientWithResponses) UpdateUserRoleV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserRoleV1Response, error) {
+	rsp, err := c.UpdateUserRoleV1WithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserRoleV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserRoleV1WithResponse(ctx context.Context, id int64, body UpdateUserRoleV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserRoleV1Response, error) {
+	rsp, err := c.UpdateUserRoleV1(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserRoleV1Response(rsp)
+}
+
+// GetTwoFactorStatusV1WithResponse request returning *GetTwoFactorStatusV1Response
+func (c *ClientWithResponses) GetTwoFactorStatusV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTwoFactorStatusV1Response, error) {
+	rsp, err := c.GetTwoFactorStatusV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTwoFactorStatusV1Response(rsp)
+}
+
+// ConfirmTwoFactorV1WithBodyWithResponse request with arbitrary body returning *ConfirmTwoFactorV1Response
+func (c *ClientWithResponses) ConfirmTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error) {
+	rsp, err := c.ConfirmTwoFactorV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTwoFactorV1WithResponse(ctx context.Context, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error) {
+	rsp, err := c.ConfirmTwoFactorV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorV1Response(rsp)
+}
+
+// DisableTwoFactorV1WithBodyWithResponse request with arbitrary body returning *DisableTwoFactorV1Response
+func (c *ClientWithResponses) DisableTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error) {
+	rsp, err := c.DisableTwoFactorV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) DisableTwoFactorV1WithResponse(ctx context.Context, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error) {
+	rsp, err := c.DisableTwoFactorV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorV1Response(rsp)
+}
+
+// EnrollTwoFactorV1WithResponse request returning *EnrollTwoFactorV1Response
+func (c *ClientWithResponses) EnrollTwoFactorV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error) {
+	rsp, err := c.EnrollTwoFactorV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorV1Response(rsp)
+}
+
+// RegenerateRecoveryCodesV1WithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesV1Response
+func (c *ClientWithResponses) RegenerateRecoveryCodesV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error) {
+	rsp, err := c.RegenerateRecoveryCodesV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesV1WithResponse(ctx context.Context, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error) {
+	rsp, err := c.RegenerateRecoveryCodesV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesV1Response(rsp)
+}
+
+// LoginUserV1WithBodyWithResponse request with arbitrary body returning *LoginUserV1Response
+func (c *ClientWithResponses) LoginUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error) {
+	rsp, err := c.LoginUserV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) LoginUserV1WithResponse(ctx context.Context, body LoginUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserV1Response, error) {
+	rsp, err := c.LoginUserV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserV1Response(rsp)
+}
+
+// VerifyMfaChallengeV1WithBodyWithResponse request with arbitrary body returning *VerifyMfaChallengeV1Response
+func (c *ClientWithResponses) VerifyMfaChallengeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMfaChallengeV1Response, error) {
+	rsp, err := c.VerifyMfaChallengeV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMfaChallengeV1Response(rsp)
+}
+
+func (c *ClientWithResponses) VerifyMfaChallengeV1WithResponse(ctx context.Context, body VerifyMfaChallengeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMfaChallengeV1Response, error) {
+	rsp, err := c.VerifyMfaChallengeV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMfaChallengeV1Response(rsp)
+}
+
+// LogoutUserV1WithBodyWithResponse request with arbitrary body returning *LogoutUserV1Response
+func (c *ClientWithResponses) LogoutUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error) {
+	rsp, err := c.LogoutUserV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) LogoutUserV1WithResponse(ctx context.Context, body LogoutUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutUserV1Response, error) {
+	rsp, err := c.LogoutUserV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserV1Response(rsp)
+}
+
+// RequestMagicLinkV1WithBodyWithResponse request with arbitrary body returning *RequestMagicLinkV1Response
+func (c *ClientWithResponses) RequestMagicLinkV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestMagicLinkV1Response, error) {
+	rsp, err := c.RequestMagicLinkV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestMagicLinkV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RequestMagicLinkV1WithResponse(ctx context.Context, body RequestMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestMagicLinkV1Response, error) {
+	rsp, err := c.RequestMagicLinkV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestMagicLinkV1Response(rsp)
+}
+
+// VerifyMagicLinkV1WithBodyWithResponse request with arbitrary body returning *VerifyMagicLinkV1Response
+func (c *ClientWithResponses) VerifyMagicLinkV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMagicLinkV1Response, error) {
+	rsp, err := c.VerifyMagicLinkV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMagicLinkV1Response(rsp)
+}
+
+func (c *ClientWithResponses) VerifyMagicLinkV1WithResponse(ctx context.Context, body VerifyMagicLinkV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMagicLinkV1Response, error) {
+	rsp, err := c.VerifyMagicLinkV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMagicLinkV1Response(rsp)
+}
+
+// ListOidcProvidersV1WithResponse request returning *ListOidcProvidersV1Response
+func (c *ClientWithResponses) ListOidcProvidersV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOidcProvidersV1Response, error) {
+	rsp, err := c.ListOidcProvidersV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOidcProvidersV1Response(rsp)
+}
+
+// StartOidcLoginV1WithResponse request returning *StartOidcLoginV1Response
+func (c *ClientWithResponses) StartOidcLoginV1WithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*StartOidcLoginV1Response, error) {
+	rsp, err := c.StartOidcLoginV1(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartOidcLoginV1Response(rsp)
+}
+
+// OidcCallbackV1WithResponse request returning *OidcCallbackV1Response
+func (c *ClientWithResponses) OidcCallbackV1WithResponse(ctx context.Context, provider string, params *OidcCallbackV1Params, reqEditors ...RequestEditorFn) (*OidcCallbackV1Response, error) {
+	rsp, err := c.OidcCallbackV1(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOidcCallbackV1Response(rsp)
+}
+
+// ForgotPasswordV1WithBodyWithResponse request with arbitrary body returning *ForgotPasswordV1Response
+func (c *ClientWithResponses) ForgotPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForgotPasswordV1Response, error) {
+	rsp, err := c.ForgotPasswordV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForgotPasswordV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ForgotPasswordV1WithResponse(ctx context.Context, body ForgotPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ForgotPasswordV1Response, error) {
+	rsp, err := c.ForgotPasswordV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForgotPasswordV1Response(rsp)
+}
+
+// ResetPasswordV1WithBodyWithResponse request with arbitrary body returning *ResetPasswordV1Response
+func (c *ClientWithResponses) ResetPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error) {
+	rsp, err := c.ResetPasswordV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ResetPasswordV1WithResponse(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error) {
+	rsp, err := c.ResetPasswordV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordV1Response(rsp)
+}
+
+// RefreshAccessTokenV1WithBodyWithResponse request with arbitrary body returning *RefreshAccessTokenV1Response
+func (c *ClientWithResponses) RefreshAccessTokenV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error) {
+	rsp, err := c.RefreshAccessTokenV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshAccessTokenV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RefreshAccessTokenV1WithResponse(ctx context.Context, body RefreshAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error) {
+	rsp, err := c.RefreshAccessTokenV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshAccessTokenV1Response(rsp)
+}
+
+// RevokeOtherSessionsV1WithResponse request returning *RevokeOtherSessionsV1Response
+func (c *ClientWithResponses) RevokeOtherSessionsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeOtherSessionsV1Response, error) {
+	rsp, err := c.RevokeOtherSessionsV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeOtherSessionsV1Response(rsp)
+}
+
+// ListSessionsV1WithResponse request returning *ListSessionsV1Response
+func (c *ClientWithResponses) ListSessionsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsV1Response, error) {
+	rsp, err := c.ListSessionsV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSessionsV1Response(rsp)
+}
+
+// RevokeSessionV1WithResponse request returning *RevokeSessionV1Response
+func (c *ClientWithResponses) RevokeSessionV1WithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionV1Response, error) {
+	rsp, err := c.RevokeSessionV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeSessionV1Response(rsp)
+}
+
+// SignupUserV1WithBodyWithResponse request with arbitrary body returning *SignupUserV1Response
+func (c *ClientWithResponses) SignupUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error) {
+	rsp, err := c.SignupUserV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignupUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SignupUserV1WithResponse(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error) {
+	rsp, err := c.SignupUserV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignupUserV1Response(rsp)
+}
+
+// VerifyEmailV1WithBodyWithResponse request with arbitrary body returning *VerifyEmailV1Response
+func (c *ClientWithResponses) VerifyEmailV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error) {
+	rsp, err := c.VerifyEmailV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailV1Response(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailV1WithResponse(ctx context.Context, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error) {
+	rsp, err := c.VerifyEmailV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailV1Response(rsp)
+}
+
+// ResendVerificationEmailV1WithResponse request returning *ResendVerificationEmailV1Response
+func (c *ClientWithResponses) ResendVerificationEmailV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResendVerificationEmailV1Response, error) {
+	rsp, err := c.ResendVerificationEmailV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResendVerificationEmailV1Response(rsp)
+}
+
+// GetHomeFeedV1WithResponse request returning *GetHomeFeedV1Response
+func (c *ClientWithResponses) GetHomeFeedV1WithResponse(ctx context.Context, params *GetHomeFeedV1Params, reqEditors ...RequestEditorFn) (*GetHomeFeedV1Response, error) {
+	rsp, err := c.GetHomeFeedV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHomeFeedV1Response(rsp)
+}
+
+// GetAvatarV1WithResponse request returning *GetAvatarV1Response
+func (c *ClientWithResponses) GetAvatarV1WithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetAvatarV1Response, error) {
+	rsp, err := c.GetAvatarV1(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAvatarV1Response(rsp)
+}
+
+// ListPostsV1WithResponse request returning *ListPostsV1Response
+func (c *ClientWithResponses) ListPostsV1WithResponse(ctx context.Context, params *ListPostsV1Params, reqEditors ...RequestEditorFn) (*ListPostsV1Response, error) {
+	rsp, err := c.ListPostsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPostsV1Response(rsp)
+}
+
+// CreatePostV1WithBodyWithResponse request with arbitrary body returning *CreatePostV1Response
+func (c *ClientWithResponses) CreatePostV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error) {
+	rsp, err := c.CreatePostV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreatePostV1WithResponse(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error) {
+	rsp, err := c.CreatePostV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePostV1Response(rsp)
+}
+
+// DeletePostV1WithResponse request returning *DeletePostV1Response
+func (c *ClientWithResponses) DeletePostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePostV1Response, error) {
+	rsp, err := c.DeletePostV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePostV1Response(rsp)
+}
+
+// GetPostByIdV1WithResponse request returning *GetPostByIdV1Response
+func (c *ClientWithResponses) GetPostByIdV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetPostByIdV1Response, error) {
+	rsp, err := c.GetPostByIdV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPostByIdV1Response(rsp)
+}
+
+// UpdatePostV1WithBodyWithResponse request with arbitrary body returning *UpdatePostV1Response
+func (c *ClientWithResponses) UpdatePostV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error) {
+	rsp, err := c.UpdatePostV1WithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePostV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePostV1WithResponse(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error) {
+	rsp, err := c.UpdatePostV1(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePostV1Response(rsp)
+}
+
+// RemovePostReactionV1WithResponse request returning *RemovePostReactionV1Response
+func (c *ClientWithResponses) RemovePostReactionV1WithResponse(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*RemovePostReactionV1Response, error) {
+	rsp, err := c.RemovePostReactionV1(ctx, id, pType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemovePostReactionV1Response(rsp)
+}
+
+// AddPostReactionV1WithResponse request returning *AddPostReactionV1Response
+func (c *ClientWithResponses) AddPostReactionV1WithResponse(ctx context.Context, id int64, pType string, reqEditors ...RequestEditorFn) (*AddPostReactionV1Response, error) {
+	rsp, err := c.AddPostReactionV1(ctx, id, pType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPostReactionV1Response(rsp)
+}
+
+// UnrepostPostV1WithResponse request returning *UnrepostPostV1Response
+func (c *ClientWithResponses) UnrepostPostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*UnrepostPostV1Response, error) {
+	rsp, err := c.UnrepostPostV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnrepostPostV1Response(rsp)
+}
+
+// RepostPostV1WithResponse request returning *RepostPostV1Response
+func (c *ClientWithResponses) RepostPostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RepostPostV1Response, error) {
+	rsp, err := c.RepostPostV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRepostPostV1Response(rsp)
+}
+
+// ListCommentsForPostV1WithResponse request returning *ListCommentsForPostV1Response
+func (c *ClientWithResponses) ListCommentsForPostV1WithResponse(ctx context.Context, postId int64, params *ListCommentsForPostV1Params, reqEditors ...RequestEditorFn) (*ListCommentsForPostV1Response, error) {
+	rsp, err := c.ListCommentsForPostV1(ctx, postId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCommentsForPostV1Response(rsp)
+}
+
+// CreateCommentV1WithBodyWithResponse request with arbitrary body returning *CreateCommentV1Response
+func (c *ClientWithResponses) CreateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error) {
+	rsp, err := c.CreateCommentV1WithBody(ctx, postId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCommentV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateCommentV1WithResponse(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error) {
+	rsp, err := c.CreateCommentV1(ctx, postId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCommentV1Response(rsp)
+}
+
+// DeleteCommentV1WithResponse request returning *DeleteCommentV1Response
+func (c *ClientWithResponses) DeleteCommentV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*DeleteCommentV1Response, error) {
+	rsp, err := c.DeleteCommentV1(ctx, postId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCommentV1Response(rsp)
+}
+
+// GetCommentByIdV1WithResponse request returning *GetCommentByIdV1Response
+func (c *ClientWithResponses) GetCommentByIdV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*GetCommentByIdV1Response, error) {
+	rsp, err := c.GetCommentByIdV1(ctx, postId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCommentByIdV1Response(rsp)
+}
+
+// UpdateCommentV1WithBodyWithResponse request with arbitrary body returning *UpdateCommentV1Response
+func (c *ClientWithResponses) UpdateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error) {
+	rsp, err := c.UpdateCommentV1WithBody(ctx, postId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCommentV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCommentV1WithResponse(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error) {
+	rsp, err := c.UpdateCommentV1(ctx, postId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCommentV1Response(rsp)
+}
+
+// RemoveCommentReactionV1WithResponse request returning *RemoveCommentReactionV1Response
+func (c *ClientWithResponses) RemoveCommentReactionV1WithResponse(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*RemoveCommentReactionV1Response, error) {
+	rsp, err := c.RemoveCommentReactionV1(ctx, postId, id, pType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveCommentReactionV1Response(rsp)
+}
+
+// AddCommentReactionV1WithResponse request returning *AddCommentReactionV1Response
+func (c *ClientWithResponses) AddCommentReactionV1WithResponse(ctx context.Context, postId int64, id int64, pType string, reqEditors ...RequestEditorFn) (*AddCommentReactionV1Response, error) {
+	rsp, err := c.AddCommentReactionV1(ctx, postId, id, pType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCommentReactionV1Response(rsp)
+}
+
+// ListTrendingTagsV1WithResponse request returning *ListTrendingTagsV1Response
+func (c *ClientWithResponses) ListTrendingTagsV1WithResponse(ctx context.Context, params *ListTrendingTagsV1Params, reqEditors ...RequestEditorFn) (*ListTrendingTagsV1Response, error) {
+	rsp, err := c.ListTrendingTagsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTrendingTagsV1Response(rsp)
+}
+
+// ListTagPostsV1WithResponse request returning *ListTagPostsV1Response
+func (c *ClientWithResponses) ListTagPostsV1WithResponse(ctx context.Context, tag string, params *ListTagPostsV1Params, reqEditors ...RequestEditorFn) (*ListTagPostsV1Response, error) {
+	rsp, err := c.ListTagPostsV1(ctx, tag, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagPostsV1Response(rsp)
+}
+
+// DeleteAccountV1WithBodyWithResponse request with arbitrary body returning *DeleteAccountV1Response
+func (c *ClientWithResponses) DeleteAccountV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error) {
+	rsp, err := c.DeleteAccountV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountV1Response(rsp)
+}
+
+func (c *ClientWithResponses) DeleteAccountV1WithResponse(ctx context.Context, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error) {
+	rsp, err := c.DeleteAccountV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountV1Response(rsp)
+}
+
+// GetUserProfileV1WithResponse request returning *GetUserProfileV1Response
+func (c *ClientWithResponses) GetUserProfileV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserProfileV1Response, error) {
+	rsp, err := c.GetUserProfileV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserProfileV1Response(rsp)
+}
+
+// UpdateUserProfileV1WithBodyWithResponse request with arbitrary body returning *UpdateUserProfileV1Response
+func (c *ClientWithResponses) UpdateUserProfileV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error) {
+	rsp, err := c.UpdateUserProfileV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserProfileV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserProfileV1WithResponse(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error) {
+	rsp, err := c.UpdateUserProfileV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserProfileV1Response(rsp)
+}
+
+// DeleteAvatarV1WithResponse request returning *DeleteAvatarV1Response
+func (c *ClientWithResponses) DeleteAvatarV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAvatarV1Response, error) {
+	rsp, err := c.DeleteAvatarV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAvatarV1Response(rsp)
+}
+
+// UploadAvatarV1WithBodyWithResponse request with arbitrary body returning *UploadAvatarV1Response
+func (c *ClientWithResponses) UploadAvatarV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAvatarV1Response, error) {
+	rsp, err := c.UploadAvatarV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadAvatarV1Response(rsp)
+}
+
+// ListBlockedUsersV1WithResponse request returning *ListBlockedUsersV1Response
+func (c *ClientWithResponses) ListBlockedUsersV1WithResponse(ctx context.Context, params *ListBlockedUsersV1Params, reqEditors ...RequestEditorFn) (*ListBlockedUsersV1Response, error) {
+	rsp, err := c.ListBlockedUsersV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBlockedUsersV1Response(rsp)
+}
+
+// RequestEmailChangeV1WithBodyWithResponse request with arbitrary body returning *RequestEmailChangeV1Response
+func (c *ClientWithResponses) RequestEmailChangeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error) {
+	rsp, err := c.RequestEmailChangeV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RequestEmailChangeV1WithResponse(ctx context.Context, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error) {
+	rsp, err := c.RequestEmailChangeV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeV1Response(rsp)
+}
+
+// ConfirmEmailChangeV1WithBodyWithResponse request with arbitrary body returning *ConfirmEmailChangeV1Response
+func (c *ClientWithResponses) ConfirmEmailChangeV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error) {
+	rsp, err := c.ConfirmEmailChangeV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmEmailChangeV1WithResponse(ctx context.Context, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error) {
+	rsp, err := c.ConfirmEmailChangeV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeV1Response(rsp)
+}
+
+// ListMutedUsersV1WithResponse request returning *ListMutedUsersV1Response
+func (c *ClientWithResponses) ListMutedUsersV1WithResponse(ctx context.Context, params *ListMutedUsersV1Params, reqEditors ...RequestEditorFn) (*ListMutedUsersV1Response, error) {
+	rsp, err := c.ListMutedUsersV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMutedUsersV1Response(rsp)
+}
+
+// ChangePasswordV1WithBodyWithResponse request with arbitrary body returning *ChangePasswordV1Response
+func (c *ClientWithResponses) ChangePasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordV1Response, error) {
+	rsp, err := c.ChangePasswordV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordV1WithResponse(ctx context.Context, body ChangePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordV1Response, error) {
+	rsp, err := c.ChangePasswordV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordV1Response(rsp)
+}
+
+// ListPersonalAccessTokensV1WithResponse request returning *ListPersonalAccessTokensV1Response
+func (c *ClientWithResponses) ListPersonalAccessTokensV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensV1Response, error) {
+	rsp, err := c.ListPersonalAccessTokensV1(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPersonalAccessTokensV1Response(rsp)
+}
+
+// CreatePersonalAccessTokenV1WithBodyWithResponse request with arbitrary body returning *CreatePersonalAccessTokenV1Response
+func (c *ClientWithResponses) CreatePersonalAccessTokenV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenV1Response, error) {
+	rsp, err := c.CreatePersonalAccessTokenV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreatePersonalAccessTokenV1WithResponse(ctx context.Context, body CreatePersonalAccessTokenV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenV1Response, error) {
+	rsp, err := c.CreatePersonalAccessTokenV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenV1Response(rsp)
+}
+
+// RevokePersonalAccessTokenV1WithResponse request returning *RevokePersonalAccessTokenV1Response
+func (c *ClientWithResponses) RevokePersonalAccessTokenV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokePersonalAccessTokenV1Response, error) {
+	rsp, err := c.RevokePersonalAccessTokenV1(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokePersonalAccessTokenV1Response(rsp)
+}
+
+// GetPublicUserProfileV1WithResponse request returning *GetPublicUserProfileV1Response
+func (c *ClientWithResponses) GetPublicUserProfileV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetPublicUserProfileV1Response, error) {
+	rsp, err := c.GetPublicUserProfileV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublicUserProfileV1Response(rsp)
+}
+
+// UnblockUserV1WithResponse request returning *UnblockUserV1Response
+func (c *ClientWithResponses) UnblockUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnblockUserV1Response, error) {
+	rsp, err := c.UnblockUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnblockUserV1Response(rsp)
+}
+
+// BlockUserV1WithResponse request returning *BlockUserV1Response
+func (c *ClientWithResponses) BlockUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*BlockUserV1Response, error) {
+	rsp, err := c.BlockUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBlockUserV1Response(rsp)
+}
+
+// UnfollowUserV1WithResponse request returning *UnfollowUserV1Response
+func (c *ClientWithResponses) UnfollowUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnfollowUserV1Response, error) {
+	rsp, err := c.UnfollowUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnfollowUserV1Response(rsp)
+}
+
+// FollowUserV1WithResponse request returning *FollowUserV1Response
+func (c *ClientWithResponses) FollowUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*FollowUserV1Response, error) {
+	rsp, err := c.FollowUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFollowUserV1Response(rsp)
+}
+
+// ListFollowersV1WithResponse request returning *ListFollowersV1Response
+func (c *ClientWithResponses) ListFollowersV1WithResponse(ctx context.Context, username string, params *ListFollowersV1Params, reqEditors ...RequestEditorFn) (*ListFollowersV1Response, error) {
+	rsp, err := c.ListFollowersV1(ctx, username, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFollowersV1Response(rsp)
+}
+
+// ListFollowingV1WithResponse request returning *ListFollowingV1Response
+func (c *ClientWithResponses) ListFollowingV1WithResponse(ctx context.Context, username string, params *ListFollowingV1Params, reqEditors ...RequestEditorFn) (*ListFollowingV1Response, error) {
+	rsp, err := c.ListFollowingV1(ctx, username, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFollowingV1Response(rsp)
+}
+
+// UnmuteUserV1WithResponse request returning *UnmuteUserV1Response
+func (c *ClientWithResponses) UnmuteUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnmuteUserV1Response, error) {
+	rsp, err := c.UnmuteUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnmuteUserV1Response(rsp)
+}
+
+// MuteUserV1WithResponse request returning *MuteUserV1Response
+func (c *ClientWithResponses) MuteUserV1WithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*MuteUserV1Response, error) {
+	rsp, err := c.MuteUserV1(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMuteUserV1Response(rsp)
+}
+
+// ListUserPostsV1WithResponse request returning *ListUserPostsV1Response
+func (c *ClientWithResponses) ListUserPostsV1WithResponse(ctx context.Context, username string, params *ListUserPostsV1Params, reqEditors ...RequestEditorFn) (*ListUserPostsV1Response, error) {
+	rsp, err := c.ListUserPostsV1(ctx, username, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserPostsV1Response(rsp)
+}
+
+// ParseGetJWKSResponse parses an HTTP response from a GetJWKSWithResponse call
+func ParseGetJWKSResponse(rsp *http.Response) (*GetJWKSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJWKSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JSONWebKeySet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListImpersonationLogV1Response parses an HTTP response from a ListImpersonationLogV1WithResponse call
+func ParseListImpersonationLogV1Response(rsp *http.Response) (*ListImpersonationLogV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListImpersonationLogV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListImpersonationLogSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEndImpersonationV1Response parses an HTTP response from a EndImpersonationV1WithResponse call
+func ParseEndImpersonationV1Response(rsp *http.Response) (*EndImpersonationV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EndImpersonationV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListModerationLogV1Response parses an HTTP response from a ListModerationLogV1WithResponse call
+func ParseListModerationLogV1Response(rsp *http.Response) (*ListModerationLogV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListModerationLogV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListModerationLogSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartImpersonationV1Response parses an HTTP response from a StartImpersonationV1WithResponse call
+func ParseStartImpersonationV1Response(rsp *http.Response) (*StartImpersonationV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartImpersonationV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StartImpersonationSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserRoleV1Response parses an HTTP response from a UpdateUserRoleV1WithResponse call
+func ParseUpdateUserRoleV1Response(rsp *http.Response) (*UpdateUserRoleV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserRoleV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateUserRoleSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTwoFactorStatusV1Response parses an HTTP response from a GetTwoFactorStatusV1WithResponse call
+func ParseGetTwoFactorStatusV1Response(rsp *http.Response) (*GetTwoFactorStatusV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTwoFactorStatusV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorStatusSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmTwoFactorV1Response parses an HTTP response from a ConfirmTwoFactorV1WithResponse call
+func ParseConfirmTwoFactorV1Response(rsp *http.Response) (*ConfirmTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTwoFactorV1Response parses an HTTP response from a DisableTwoFactorV1WithResponse call
+func ParseDisableTwoFactorV1Response(rsp *http.Response) (*DisableTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTwoFactorV1Response parses an HTTP response from a EnrollTwoFactorV1WithResponse call
+func ParseEnrollTwoFactorV1Response(rsp *http.Response) (*EnrollTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TOTPEnrollmentSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateRecoveryCodesV1Response parses an HTTP response from a RegenerateRecoveryCodesV1WithResponse call
+func ParseRegenerateRecoveryCodesV1Response(rsp *http.Response) (*RegenerateRecoveryCodesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateRecoveryCodesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodesSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginUserV1Response parses an HTTP response from a LoginUserV1WithResponse call
+func ParseLoginUserV1Response(rsp *http.Response) (*LoginUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MFAChallengeSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyMfaChallengeV1Response parses an HTTP response from a VerifyMfaChallengeV1WithResponse call
+func ParseVerifyMfaChallengeV1Response(rsp *http.Response) (*VerifyMfaChallengeV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyMfaChallengeV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutUserV1Response parses an HTTP response from a LogoutUserV1WithResponse call
+func ParseLogoutUserV1Response(rsp *http.Response) (*LogoutUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestMagicLinkV1Response parses an HTTP response from a RequestMagicLinkV1WithResponse call
+func ParseRequestMagicLinkV1Response(rsp *http.Response) (*RequestMagicLinkV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestMagicLinkV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyMagicLinkV1Response parses an HTTP response from a VerifyMagicLinkV1WithResponse call
+func ParseVerifyMagicLinkV1Response(rsp *http.Response) (*VerifyMagicLinkV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyMagicLinkV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest MFAChallengeSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOidcProvidersV1Response parses an HTTP response from a ListOidcProvidersV1WithResponse call
+func ParseListOidcProvidersV1Response(rsp *http.Response) (*ListOidcProvidersV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOidcProvidersV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCProvidersSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartOidcLoginV1Response parses an HTTP response from a StartOidcLoginV1WithResponse call
+func ParseStartOidcLoginV1Response(rsp *http.Response) (*StartOidcLoginV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartOidcLoginV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOidcCallbackV1Response parses an HTTP response from a OidcCallbackV1WithResponse call
+func ParseOidcCallbackV1Response(rsp *http.Response) (*OidcCallbackV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OidcCallbackV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseForgotPasswordV1Response parses an HTTP response from a ForgotPasswordV1WithResponse call
+func ParseForgotPasswordV1Response(rsp *http.Response) (*ForgotPasswordV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ForgotPasswordV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetPasswordV1Response parses an HTTP response from a ResetPasswordV1WithResponse call
+func ParseResetPasswordV1Response(rsp *http.Response) (*ResetPasswordV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPasswordV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshAccessTokenV1Response parses an HTTP response from a RefreshAccessTokenV1WithResponse call
+func ParseRefreshAccessTokenV1Response(rsp *http.Response) (*RefreshAccessTokenV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshAccessTokenV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeOtherSessionsV1Response parses an HTTP response from a RevokeOtherSessionsV1WithResponse call
+func ParseRevokeOtherSessionsV1Response(rsp *http.Response) (*RevokeOtherSessionsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeOtherSessionsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSessionsV1Response parses an HTTP response from a ListSessionsV1WithResponse call
+func ParseListSessionsV1Response(rsp *http.Response) (*ListSessionsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSessionsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSessionsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeSessionV1Response parses an HTTP response from a RevokeSessionV1WithResponse call
+func ParseRevokeSessionV1Response(rsp *http.Response) (*RevokeSessionV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeSessionV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignupUserV1Response parses an HTTP response from a SignupUserV1WithResponse call
+func ParseSignupUserV1Response(rsp *http.Response) (*SignupUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignupUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SignupSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailV1Response parses an HTTP response from a VerifyEmailV1WithResponse call
+func ParseVerifyEmailV1Response(rsp *http.Response) (*VerifyEmailV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResendVerificationEmailV1Response parses an HTTP response from a ResendVerificationEmailV1WithResponse call
+func ParseResendVerificationEmailV1Response(rsp *http.Response) (*ResendVerificationEmailV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResendVerificationEmailV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHomeFeedV1Response parses an HTTP response from a GetHomeFeedV1WithResponse call
+func ParseGetHomeFeedV1Response(rsp *http.Response) (*GetHomeFeedV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHomeFeedV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HomeFeedSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAvatarV1Response parses an HTTP response from a GetAvatarV1WithResponse call
+func ParseGetAvatarV1Response(rsp *http.Response) (*GetAvatarV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAvatarV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPostsV1Response parses an HTTP response from a ListPostsV1WithResponse call
+func ParseListPostsV1Response(rsp *http.Response) (*ListPostsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPostsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPostsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePostV1Response parses an HTTP response from a CreatePostV1WithResponse call
+func ParseCreatePostV1Response(rsp *http.Response) (*CreatePostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePostSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePostV1Response parses an HTTP response from a DeletePostV1WithResponse call
+func ParseDeletePostV1Response(rsp *http.Response) (*DeletePostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPostByIdV1Response parses an HTTP response from a GetPostByIdV1WithResponse call
+func ParseGetPostByIdV1Response(rsp *http.Response) (*GetPostByIdV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPostByIdV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPostSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePostV1Response parses an HTTP response from a UpdatePostV1WithResponse call
+func ParseUpdatePostV1Response(rsp *http.Response) (*UpdatePostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdatePostSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemovePostReactionV1Response parses an HTTP response from a RemovePostReactionV1WithResponse call
+func ParseRemovePostReactionV1Response(rsp *http.Response) (*RemovePostReactionV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemovePostReactionV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddPostReactionV1Response parses an HTTP response from a AddPostReactionV1WithResponse call
+func ParseAddPostReactionV1Response(rsp *http.Response) (*AddPostReactionV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPostReactionV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnrepostPostV1Response parses an HTTP response from a UnrepostPostV1WithResponse call
+func ParseUnrepostPostV1Response(rsp *http.Response) (*UnrepostPostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnrepostPostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRepostPostV1Response parses an HTTP response from a RepostPostV1WithResponse call
+func ParseRepostPostV1Response(rsp *http.Response) (*RepostPostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RepostPostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePostSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCommentsForPostV1Response parses an HTTP response from a ListCommentsForPostV1WithResponse call
+func ParseListCommentsForPostV1Response(rsp *http.Response) (*ListCommentsForPostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCommentsForPostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCommentsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCommentV1Response parses an HTTP response from a CreateCommentV1WithResponse call
+func ParseCreateCommentV1Response(rsp *http.Response) (*CreateCommentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCommentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateCommentSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCommentV1Response parses an HTTP response from a DeleteCommentV1WithResponse call
+func ParseDeleteCommentV1Response(rsp *http.Response) (*DeleteCommentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCommentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCommentByIdV1Response parses an HTTP response from a GetCommentByIdV1WithResponse call
+func ParseGetCommentByIdV1Response(rsp *http.Response) (*GetCommentByIdV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCommentByIdV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetCommentSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCommentV1Response parses an HTTP response from a UpdateCommentV1WithResponse call
+func ParseUpdateCommentV1Response(rsp *http.Response) (*UpdateCommentV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCommentV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateCommentSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveCommentReactionV1Response parses an HTTP response from a RemoveCommentReactionV1WithResponse call
+func ParseRemoveCommentReactionV1Response(rsp *http.Response) (*RemoveCommentReactionV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveCommentReactionV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddCommentReactionV1Response parses an HTTP response from a AddCommentReactionV1WithResponse call
+func ParseAddCommentReactionV1Response(rsp *http.Response) (*AddCommentReactionV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddCommentReactionV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTrendingTagsV1Response parses an HTTP response from a ListTrendingTagsV1WithResponse call
+func ParseListTrendingTagsV1Response(rsp *http.Response) (*ListTrendingTagsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTrendingTagsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrendingTagsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagPostsV1Response parses an HTTP response from a ListTagPostsV1WithResponse call
+func ParseListTagPostsV1Response(rsp *http.Response) (*ListTagPostsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagPostsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTagPostsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountV1Response parses an HTTP response from a DeleteAccountV1WithResponse call
+func ParseDeleteAccountV1Response(rsp *http.Response) (*DeleteAccountV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AccountDeletionSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserProfileV1Response parses an HTTP response from a GetUserProfileV1WithResponse call
+func ParseGetUserProfileV1Response(rsp *http.Response) (*GetUserProfileV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserProfileV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserProfileSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserProfileV1Response parses an HTTP response from a UpdateUserProfileV1WithResponse call
+func ParseUpdateUserProfileV1Response(rsp *http.Response) (*UpdateUserProfileV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserProfileV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateUserProfileSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAvatarV1Response parses an HTTP response from a DeleteAvatarV1WithResponse call
+func ParseDeleteAvatarV1Response(rsp *http.Response) (*DeleteAvatarV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAvatarV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadAvatarV1Response parses an HTTP response from a UploadAvatarV1WithResponse call
+func ParseUploadAvatarV1Response(rsp *http.Response) (*UploadAvatarV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadAvatarV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadAvatarSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBlockedUsersV1Response parses an HTTP response from a ListBlockedUsersV1WithResponse call
+func ParseListBlockedUsersV1Response(rsp *http.Response) (*ListBlockedUsersV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBlockedUsersV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListBlockedUsersSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestEmailChangeV1Response parses an HTTP response from a RequestEmailChangeV1WithResponse call
+func ParseRequestEmailChangeV1Response(rsp *http.Response) (*RequestEmailChangeV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestEmailChangeV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEmailChangeV1Response parses an HTTP response from a ConfirmEmailChangeV1WithResponse call
+func ParseConfirmEmailChangeV1Response(rsp *http.Response) (*ConfirmEmailChangeV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEmailChangeV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserProfileSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMutedUsersV1Response parses an HTTP response from a ListMutedUsersV1WithResponse call
+func ParseListMutedUsersV1Response(rsp *http.Response) (*ListMutedUsersV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMutedUsersV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListBlockedUsersSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePasswordV1Response parses an HTTP response from a ChangePasswordV1WithResponse call
+func ParseChangePasswordV1Response(rsp *http.Response) (*ChangePasswordV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePasswordV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPersonalAccessTokensV1Response parses an HTTP response from a ListPersonalAccessTokensV1WithResponse call
+func ParseListPersonalAccessTokensV1Response(rsp *http.Response) (*ListPersonalAccessTokensV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPersonalAccessTokensV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPersonalAccessTokensSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePersonalAccessTokenV1Response parses an HTTP response from a CreatePersonalAccessTokenV1WithResponse call
+func ParseCreatePersonalAccessTokenV1Response(rsp *http.Response) (*CreatePersonalAccessTokenV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePersonalAccessTokenV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePersonalAccessTokenSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokePersonalAccessTokenV1Response parses an HTTP response from a RevokePersonalAccessTokenV1WithResponse call
+func ParseRevokePersonalAccessTokenV1Response(rsp *http.Response) (*RevokePersonalAccessTokenV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokePersonalAccessTokenV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPublicUserProfileV1Response parses an HTTP response from a GetPublicUserProfileV1WithResponse call
+func ParseGetPublicUserProfileV1Response(rsp *http.Response) (*GetPublicUserProfileV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublicUserProfileV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPublicUserProfileSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnblockUserV1Response parses an HTTP response from a UnblockUserV1WithResponse call
+func ParseUnblockUserV1Response(rsp *http.Response) (*UnblockUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnblockUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBlockUserV1Response parses an HTTP response from a BlockUserV1WithResponse call
+func ParseBlockUserV1Response(rsp *http.Response) (*BlockUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BlockUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnfollowUserV1Response parses an HTTP response from a UnfollowUserV1WithResponse call
+func ParseUnfollowUserV1Response(rsp *http.Response) (*UnfollowUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnfollowUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFollowUserV1Response parses an HTTP response from a FollowUserV1WithResponse call
+func ParseFollowUserV1Response(rsp *http.Response) (*FollowUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FollowUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFollowersV1Response parses an HTTP response from a ListFollowersV1WithResponse call
+func ParseListFollowersV1Response(rsp *http.Response) (*ListFollowersV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFollowersV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListFollowsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFollowingV1Response parses an HTTP response from a ListFollowingV1WithResponse call
+func ParseListFollowingV1Response(rsp *http.Response) (*ListFollowingV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFollowingV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListFollowsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnmuteUserV1Response parses an HTTP response from a UnmuteUserV1WithResponse call
+func ParseUnmuteUserV1Response(rsp *http.Response) (*UnmuteUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnmuteUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMuteUserV1Response parses an HTTP response from a MuteUserV1WithResponse call
+func ParseMuteUserV1Response(rsp *http.Response) (*MuteUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MuteUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUserPostsV1Response parses an HTTP response from a ListUserPostsV1WithResponse call
+func ParseListUserPostsV1Response(rsp *http.Response) (*ListUserPostsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUserPostsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListPostsSuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ApiErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the token verification keys
+	// (GET /.well-known/jwks.json)
+	GetJWKS(ctx echo.Context) error
+	// List impersonated requests
+	// (GET /v1/admin/impersonation-log)
+	ListImpersonationLogV1(ctx echo.Context, params ListImpersonationLogV1Params) error
+	// End an impersonation session
+	// (DELETE /v1/admin/impersonations/{id})
+	EndImpersonationV1(ctx echo.Context, id string) error
+	// List moderation actions
+	// (GET /v1/admin/moderation-log)
+	ListModerationLogV1(ctx echo.Context, params ListModerationLogV1Params) error
+	// Impersonate a user
+	// (POST /v1/admin/users/{id}/impersonate)
+	StartImpersonationV1(ctx echo.Context, id int64) error
+	// Change the role of a user
+	// (PUT /v1/admin/users/{id}/role)
+	UpdateUserRoleV1(ctx echo.Context, id int64) error
+	// Get two-factor status
+	// (GET /v1/auth/2fa)
+	GetTwoFactorStatusV1(ctx echo.Context) error
+	// Confirm two-factor enrollment
+	// (POST /v1/auth/2fa/confirm)
+	ConfirmTwoFactorV1(ctx echo.Context) error
+	// Disable two-factor authentication
+	// (POST /v1/auth/2fa/disable)
+	DisableTwoFactorV1(ctx echo.Context) error
+	// Start two-factor enrollment
+	// (POST /v1/auth/2fa/enroll)
+	EnrollTwoFactorV1(ctx echo.Context) error
+	// Regenerate recovery codes
+	// (POST /v1/auth/2fa/recovery-codes)
+	RegenerateRecoveryCodesV1(ctx echo.Context) error
+	// Log in a user
+	// (POST /v1/auth/login)
+	LoginUserV1(ctx echo.Context) error
+	// Complete a two-factor login
+	// (POST /v1/auth/login/mfa)
+	VerifyMfaChallengeV1(ctx echo.Context) error
+	// Log out a user
+	// (POST /v1/auth/logout)
+	LogoutUserV1(ctx echo.Context) error
+	// Request a login link
+	// (POST /v1/auth/magic-link)
+	RequestMagicLinkV1(ctx echo.Context) error
+	// Log in with a login link
+	// (POST /v1/auth/magic-link/verify)
+	VerifyMagicLinkV1(ctx echo.Context) error
+	// List the external identity providers
+	// (GET /v1/auth/oidc)
+	ListOidcProvidersV1(ctx echo.Context) error
+	// Start a login with an external identity provider
+	// (GET /v1/auth/oidc/{provider})
+	StartOidcLoginV1(ctx echo.Context, provider string) error
+	// Complete a login with an external identity provider
+	// (GET /v1/auth/oidc/{provider}/callback)
+	OidcCallbackV1(ctx echo.Context, provider string, params OidcCallbackV1Params) error
+	// Request a password reset
+	// (POST /v1/auth/password/forgot)
+	ForgotPasswordV1(ctx echo.Context) error
+	// Reset the password
+	// (POST /v1/auth/password/reset)
+	ResetPasswordV1(ctx echo.Context) error
+	// Refresh access token
+	// (POST /v1/auth/refresh)
+	RefreshAccessTokenV1(ctx echo.Context) error
+	// Log out everywhere else
+	// (DELETE /v1/auth/sessions)
+	RevokeOtherSessionsV1(ctx echo.Context) error
+	// List active sessions
+	// (GET /v1/auth/sessions)
+	ListSessionsV1(ctx echo.Context) error
+	// Revoke a session
+	// (DELETE /v1/auth/sessions/{id})
+	RevokeSessionV1(ctx echo.Context, id openapi_types.UUID) error
+	// Sign up a new user
+	// (POST /v1/auth/signup)
+	SignupUserV1(ctx echo.Context) error
+	// Verify the email address
+	// (POST /v1/auth/verify-email)
+	VerifyEmailV1(ctx echo.Context) error
+	// Resend the verification email
+	// (POST /v1/auth/verify-email/resend)
+	ResendVerificationEmailV1(ctx echo.Context) error
+	// Get the home feed
+	// (GET /v1/feed/home)
+	GetHomeFeedV1(ctx echo.Context, params GetHomeFeedV1Params) error
+	// Get a profile picture thumbnail
+	// (GET /v1/media/avatars/{key})
+	GetAvatarV1(ctx echo.Context, key string) error
+	// List posts
+	// (GET /v1/posts)
+	ListPostsV1(ctx echo.Context, params ListPostsV1Params) error
+	// Create a new post
+	// (POST /v1/posts)
+	CreatePostV1(ctx echo.Context) error
+	// Delete a specific post by ID
+	// (DELETE /v1/posts/{id})
+	DeletePostV1(ctx echo.Context, id int64) error
+	// Get a specific post by ID
+	// (GET /v1/posts/{id})
+	GetPostByIdV1(ctx echo.Context, id int64) error
+	// Update a specific post by ID
+	// (PUT /v1/posts/{id})
+	UpdatePostV1(ctx echo.Context, id int64) error
+	// Remove a reaction from a post
+	// (DELETE /v1/posts/{id}/reactions/{type})
+	RemovePostReactionV1(ctx echo.Context, id int64, pType string) error
+	// React to a post
+	// (PUT /v1/posts/{id}/reactions/{type})
+	AddPostReactionV1(ctx echo.Context, id int64, pType string) error
+	// Undo a repost
+	// (DELETE /v1/posts/{id}/repost)
+	UnrepostPostV1(ctx echo.Context, id int64) error
+	// Repost a post
+	// (POST /v1/posts/{id}/repost)
+	RepostPostV1(ctx echo.Context, id int64) error
+	// List comments for a post
+	// (GET /v1/posts/{postId}/comments)
+	ListCommentsForPostV1(ctx echo.Context, postId int64, params ListCommentsForPostV1Params) error
+	// Create a new comment on a post
+	// (POST /v1/posts/{postId}/comments)
+	CreateCommentV1(ctx echo.Context, postId int64) error
+	// Delete a specific comment by ID
+	// (DELETE /v1/posts/{postId}/comments/{id})
+	DeleteCommentV1(ctx echo.Context, postId int64, id int64) error
+	// Get a specific comment by ID
+	// (GET /v1/posts/{postId}/comments/{id})
+	GetCommentByIdV1(ctx echo.Context, postId int64, id int64) error
+	// Update a specific comment by ID
+	// (PUT /v1/posts/{postId}/comments/{id})
+	UpdateCommentV1(ctx echo.Context, postId int64, id int64) error
+	// Remove a reaction from a comment
+	// (DELETE /v1/posts/{postId}/comments/{id}/reactions/{type})
+	RemoveCommentReactionV1(ctx echo.Context, postId int64, id int64, pType string) error
+	// React to a comment
+	// (PUT /v1/posts/{postId}/comments/{id}/reactions/{type})
+	AddCommentReactionV1(ctx echo.Context, postId int64, id int64, pType string) error
+	// List the trending hashtags
+	// (GET /v1/tags/trending)
+	ListTrendingTagsV1(ctx echo.Context, params ListTrendingTagsV1Params) error
+	// List the posts of a hashtag
+	// (GET /v1/tags/{tag}/posts)
+	ListTagPostsV1(ctx echo.Context, tag string, params ListTagPostsV1Params) error
+	// Delete account
+	// (DELETE /v1/users)
+	DeleteAccountV1(ctx echo.Context) error
+	// Get current user profile
+	// (GET /v1/users)
+	GetUserProfileV1(ctx echo.Context) error
+	// Update current user profile
+	// (PUT /v1/users)
+	UpdateUserProfileV1(ctx echo.Context) error
+	// Remove the profile picture
+	// (DELETE /v1/users/avatar)
+	DeleteAvatarV1(ctx echo.Context) error
+	// Upload a profile picture
+	// (PUT /v1/users/avatar)
+	UploadAvatarV1(ctx echo.Context) error
+	// List blocked users
+	// (GET /v1/users/blocks)
+	ListBlockedUsersV1(ctx echo.Context, params ListBlockedUsersV1Params) error
+	// Request an email change
+	// (PUT /v1/users/email)
+	RequestEmailChangeV1(ctx echo.Context) error
+	// Confirm an email change
+	// (POST /v1/users/email/confirm)
+	ConfirmEmailChangeV1(ctx echo.Context) error
+	// List muted users
+	// (GET /v1/users/mutes)
+	ListMutedUsersV1(ctx echo.Context, params ListMutedUsersV1Params) error
+	// Change password
+	// (PUT /v1/users/password)
+	ChangePasswordV1(ctx echo.Context) error
+	// List personal access tokens
+	// (GET /v1/users/tokens)
+	ListPersonalAccessTokensV1(ctx echo.Context) error
+	// Create a personal access token
+	// (POST /v1/users/tokens)
+	CreatePersonalAccessTokenV1(ctx echo.Context) error
+	// Revoke a personal access token
+	// (DELETE /v1/users/tokens/{id})
+	RevokePersonalAccessTokenV1(ctx echo.Context, id int64) error
+	// Get the public profile of a user
+	// (GET /v1/users/{username})
+	GetPublicUserProfileV1(ctx echo.Context, username string) error
+	// Unblock a user
+	// (DELETE /v1/users/{username}/block)
+	UnblockUserV1(ctx echo.Context, username string) error
+	// Block a user
+	// (POST /v1/users/{username}/block)
+	BlockUserV1(ctx echo.Context, username string) error
+	// Unfollow a user
+	// (DELETE /v1/users/{username}/follow)
+	UnfollowUserV1(ctx echo.Context, username string) error
+	// Follow a user
+	// (POST /v1/users/{username}/follow)
+	FollowUserV1(ctx echo.Context, username string) error
+	// List the followers of a user
+	// (GET /v1/users/{username}/followers)
+	ListFollowersV1(ctx echo.Context, username string, params ListFollowersV1Params) error
+	// List the users a user follows
+	// (GET /v1/users/{username}/following)
+	ListFollowingV1(ctx echo.Context, username string, params ListFollowingV1Params) error
+	// Unmute a user
+	// (DELETE /v1/users/{username}/mute)
+	UnmuteUserV1(ctx echo.Context, username string) error
+	// Mute a user
+	// (POST /v1/users/{username}/mute)
+	MuteUserV1(ctx echo.Context, username string) error
+	// List the posts of a user
+	// (GET /v1/users/{username}/posts)
+	ListUserPostsV1(ctx echo.Context, username string, params ListUserPostsV1Params) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJWKS converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJWKS(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJWKS(ctx)
+	return err
+}
+
+// ListImpersonationLogV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListImpersonationLogV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListImpersonationLogV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListImpersonationLogV1(ctx, params)
+	return err
+}
+
+// EndImpersonationV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) EndImpersonationV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndImpersonationV1(ctx, id)
+	return err
+}
+
+// ListModerationLogV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListModerationLogV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListModerationLogV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListModerationLogV1(ctx, params)
+	return err
+}
+
+// StartImpersonationV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) StartImpersonationV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartImpersonationV1(ctx, id)
+	return err
+}
+
+// UpdateUserRoleV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateUserRoleV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateUserRoleV1(ctx, id)
+	return err
+}
+
+// GetTwoFactorStatusV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTwoFactorStatusV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTwoFactorStatusV1(ctx)
+	return err
+}
+
+// ConfirmTwoFactorV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmTwoFactorV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmTwoFactorV1(ctx)
+	return err
+}
+
+// DisableTwoFactorV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableTwoFactorV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DisableTwoFactorV1(ctx)
+	return err
+}
+
+// EnrollTwoFactorV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) EnrollTwoFactorV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EnrollTwoFactorV1(ctx)
+	return err
+}
+
+// RegenerateRecoveryCodesV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RegenerateRecoveryCodesV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegenerateRecoveryCodesV1(ctx)
+	return err
 }
 
-// RefreshAccessTokenV1WithResponse request returning *RefreshAccessTokenV1Response
-func (c *ClientWithResponses) RefreshAccessTokenV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshAccessTokenV1Response, error) {
-	rsp, err := c.RefreshAccessTokenV1(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRefreshAccessTokenV1Response(rsp)
-}
+// LoginUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) LoginUserV1(ctx echo.Context) error {
+	var err error
 
-// SignupUserV1WithBodyWithResponse request with arbitrary body returning *SignupUserV1Response
-func (c *ClientWithResponses) SignupUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error) {
-	rsp, err := c.SignupUserV1WithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSignupUserV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LoginUserV1(ctx)
+	return err
 }
 
-func (c *ClientWithResponses) SignupUserV1WithResponse(ctx context.Context, body SignupUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SignupUserV1Response, error) {
-	rsp, err := c.SignupUserV1(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSignupUserV1Response(rsp)
-}
+// VerifyMfaChallengeV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyMfaChallengeV1(ctx echo.Context) error {
+	var err error
 
-// ListPostsV1WithResponse request returning *ListPostsV1Response
-func (c *ClientWithResponses) ListPostsV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPostsV1Response, error) {
-	rsp, err := c.ListPostsV1(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListPostsV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyMfaChallengeV1(ctx)
+	return err
 }
 
-// CreatePostV1WithBodyWithResponse request with arbitrary body returning *CreatePostV1Response
-func (c *ClientWithResponses) CreatePostV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error) {
-	rsp, err := c.CreatePostV1WithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreatePostV1Response(rsp)
-}
+// LogoutUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) LogoutUserV1(ctx echo.Context) error {
+	var err error
 
-func (c *ClientWithResponses) CreatePostV1WithResponse(ctx context.Context, body CreatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePostV1Response, error) {
-	rsp, err := c.CreatePostV1(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreatePostV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogoutUserV1(ctx)
+	return err
 }
 
-// DeletePostV1WithResponse request returning *DeletePostV1Response
-func (c *ClientWithResponses) DeletePostV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePostV1Response, error) {
-	rsp, err := c.DeletePostV1(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeletePostV1Response(rsp)
-}
+// RequestMagicLinkV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestMagicLinkV1(ctx echo.Context) error {
+	var err error
 
-// GetPostByIdV1WithResponse request returning *GetPostByIdV1Response
-func (c *ClientWithResponses) GetPostByIdV1WithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetPostByIdV1Response, error) {
-	rsp, err := c.GetPostByIdV1(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetPostByIdV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestMagicLinkV1(ctx)
+	return err
 }
 
-// UpdatePostV1WithBodyWithResponse request with arbitrary body returning *UpdatePostV1Response
-func (c *ClientWithResponses) UpdatePostV1WithBodyWithResponse(ctx context.Context, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error) {
-	rsp, err := c.UpdatePostV1WithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdatePostV1Response(rsp)
-}
+// VerifyMagicLinkV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyMagicLinkV1(ctx echo.Context) error {
+	var err error
 
-func (c *ClientWithResponses) UpdatePostV1WithResponse(ctx context.Context, id int64, body UpdatePostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePostV1Response, error) {
-	rsp, err := c.UpdatePostV1(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdatePostV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyMagicLinkV1(ctx)
+	return err
 }
 
-// ListCommentsForPostV1WithResponse request returning *ListCommentsForPostV1Response
-func (c *ClientWithResponses) ListCommentsForPostV1WithResponse(ctx context.Context, postId int64, reqEditors ...RequestEditorFn) (*ListCommentsForPostV1Response, error) {
-	rsp, err := c.ListCommentsForPostV1(ctx, postId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListCommentsForPostV1Response(rsp)
-}
+// ListOidcProvidersV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOidcProvidersV1(ctx echo.Context) error {
+	var err error
 
-// CreateCommentV1WithBodyWithResponse request with arbitrary body returning *CreateCommentV1Response
-func (c *ClientWithResponses) CreateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error) {
-	rsp, err := c.CreateCommentV1WithBody(ctx, postId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateCommentV1Response(rsp)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOidcProvidersV1(ctx)
+	return err
 }
 
-func (c *ClientWithResponses) CreateCommentV1WithResponse(ctx context.Context, postId int64, body CreateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCommentV1Response, error) {
-	rsp, err := c.CreateCommentV1(ctx, postId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateCommentV1Response(rsp)
-}
+// StartOidcLoginV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) StartOidcLoginV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-// DeleteCommentV1WithResponse request returning *DeleteCommentV1Response
-func (c *ClientWithResponses) DeleteCommentV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*DeleteCommentV1Response, error) {
-	rsp, err := c.DeleteCommentV1(ctx, postId, id, reqEditors...)
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
 	}
-	return ParseDeleteCommentV1Response(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartOidcLoginV1(ctx, provider)
+	return err
 }
 
-// GetCommentByIdV1WithResponse request returning *GetCommentByIdV1Response
-func (c *ClientWithResponses) GetCommentByIdV1WithResponse(ctx context.Context, postId int64, id int64, reqEditors ...RequestEditorFn) (*GetCommentByIdV1Response, error) {
-	rsp, err := c.GetCommentByIdV1(ctx, postId, id, reqEditors...)
+// OidcCallbackV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) OidcCallbackV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
 	}
-	return ParseGetCommentByIdV1Response(rsp)
-}
 
-// UpdateCommentV1WithBodyWithResponse request with arbitrary body returning *UpdateCommentV1Response
-func (c *ClientWithResponses) UpdateCommentV1WithBodyWithResponse(ctx context.Context, postId int64, id int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error) {
-	rsp, err := c.UpdateCommentV1WithBody(ctx, postId, id, contentType, body, reqEditors...)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OidcCallbackV1Params
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
 	}
-	return ParseUpdateCommentV1Response(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCommentV1WithResponse(ctx context.Context, postId int64, id int64, body UpdateCommentV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCommentV1Response, error) {
-	rsp, err := c.UpdateCommentV1(ctx, postId, id, body, reqEditors...)
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
 	}
-	return ParseUpdateCommentV1Response(rsp)
-}
 
-// GetUserProfileV1WithResponse request returning *GetUserProfileV1Response
-func (c *ClientWithResponses) GetUserProfileV1WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserProfileV1Response, error) {
-	rsp, err := c.GetUserProfileV1(ctx, reqEditors...)
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
 	}
-	return ParseGetUserProfileV1Response(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OidcCallbackV1(ctx, provider, params)
+	return err
 }
 
-// UpdateUserProfileV1WithBodyWithResponse request with arbitrary body returning *UpdateUserProfileV1Response
-func (c *ClientWithResponses) UpdateUserProfileV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error) {
-	rsp, err := c.UpdateUserProfileV1WithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateUserProfileV1Response(rsp)
+// ForgotPasswordV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ForgotPasswordV1(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForgotPasswordV1(ctx)
+	return err
 }
 
-func (c *ClientWithResponses) UpdateUserProfileV1WithResponse(ctx context.Context, body UpdateUserProfileV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserProfileV1Response, error) {
-	rsp, err := c.UpdateUserProfileV1(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateUserProfileV1Response(rsp)
+// ResetPasswordV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetPasswordV1(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResetPasswordV1(ctx)
+	return err
 }
 
-// ParseLoginUserV1Response parses an HTTP response from a LoginUserV1WithResponse call
-func ParseLoginUserV1Response(rsp *http.Response) (*LoginUserV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RefreshAccessTokenV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshAccessTokenV1(ctx echo.Context) error {
+	var err error
 
-	response := &LoginUserV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshAccessTokenV1(ctx)
+	return err
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// RevokeOtherSessionsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeOtherSessionsV1(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeOtherSessionsV1(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// ListSessionsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSessionsV1(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSessionsV1(ctx)
+	return err
 }
 
-// ParseLogoutUserV1Response parses an HTTP response from a LogoutUserV1WithResponse call
-func ParseLogoutUserV1Response(rsp *http.Response) (*LogoutUserV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// RevokeSessionV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeSessionV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &LogoutUserV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeSessionV1(ctx, id)
+	return err
+}
+
+// SignupUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) SignupUserV1(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SignupUserV1(ctx)
+	return err
+}
 
-	}
+// VerifyEmailV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyEmailV1(ctx echo.Context) error {
+	var err error
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyEmailV1(ctx)
+	return err
 }
 
-// ParseRefreshAccessTokenV1Response parses an HTTP response from a RefreshAccessTokenV1WithResponse call
-func ParseRefreshAccessTokenV1Response(rsp *http.Response) (*RefreshAccessTokenV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ResendVerificationEmailV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ResendVerificationEmailV1(ctx echo.Context) error {
+	var err error
 
-	response := &RefreshAccessTokenV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResendVerificationEmailV1(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetHomeFeedV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHomeFeedV1(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHomeFeedV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// ParseSignupUserV1Response parses an HTTP response from a SignupUserV1WithResponse call
-func ParseSignupUserV1Response(rsp *http.Response) (*SignupUserV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	response := &SignupUserV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHomeFeedV1(ctx, params)
+	return err
+}
+
+// GetAvatarV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAvatarV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", ctx.Param("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SignupSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAvatarV1(ctx, key)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ListPostsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPostsV1(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPostsV1Params
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// ParseListPostsV1Response parses an HTTP response from a ListPostsV1WithResponse call
-func ParseListPostsV1Response(rsp *http.Response) (*ListPostsV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	response := &ListPostsV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "offset" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListPostsSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPostsV1(ctx, params)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// CreatePostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePostV1(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePostV1(ctx)
+	return err
 }
 
-// ParseCreatePostV1Response parses an HTTP response from a CreatePostV1WithResponse call
-func ParseCreatePostV1Response(rsp *http.Response) (*CreatePostV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// DeletePostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePostV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	response := &CreatePostV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreatePostSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePostV1(ctx, id)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetPostByIdV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPostByIdV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPostByIdV1(ctx, id)
+	return err
 }
 
-// ParseDeletePostV1Response parses an HTTP response from a DeletePostV1WithResponse call
-func ParseDeletePostV1Response(rsp *http.Response) (*DeletePostV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// UpdatePostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePostV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &DeletePostV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePostV1(ctx, id)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// RemovePostReactionV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemovePostReactionV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "type" -------------
+	var pType string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "type", ctx.Param("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemovePostReactionV1(ctx, id, pType)
+	return err
 }
 
-// ParseGetPostByIdV1Response parses an HTTP response from a GetPostByIdV1WithResponse call
-func ParseGetPostByIdV1Response(rsp *http.Response) (*GetPostByIdV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AddPostReactionV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPostReactionV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &GetPostByIdV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "type" -------------
+	var pType string
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetPostSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "type", ctx.Param("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddPostReactionV1(ctx, id, pType)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// UnrepostPostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UnrepostPostV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnrepostPostV1(ctx, id)
+	return err
 }
 
-// ParseUpdatePostV1Response parses an HTTP response from a UpdatePostV1WithResponse call
-func ParseUpdatePostV1Response(rsp *http.Response) (*UpdatePostV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// RepostPostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RepostPostV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &UpdatePostV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RepostPostV1(ctx, id)
+	return err
+}
+
+// ListCommentsForPostV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommentsForPostV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdatePostSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommentsForPostV1Params
+	// ------------- Optional query parameter "limit" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Optional query parameter "cursor" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "offset" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommentsForPostV1(ctx, postId, params)
+	return err
 }
 
-// ParseListCommentsForPostV1Response parses an HTTP response from a ListCommentsForPostV1WithResponse call
-func ParseListCommentsForPostV1Response(rsp *http.Response) (*ListCommentsForPostV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CreateCommentV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommentV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
 	}
 
-	response := &ListCommentsForPostV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListCommentsSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommentV1(ctx, postId)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// DeleteCommentV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommentV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "id" -------------
+	var id int64
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommentV1(ctx, postId, id)
+	return err
 }
 
-// ParseCreateCommentV1Response parses an HTTP response from a CreateCommentV1WithResponse call
-func ParseCreateCommentV1Response(rsp *http.Response) (*CreateCommentV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetCommentByIdV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCommentByIdV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
 	}
 
-	response := &CreateCommentV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateCommentSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCommentByIdV1(ctx, postId, id)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// UpdateCommentV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCommentV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id int64
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCommentV1(ctx, postId, id)
+	return err
 }
 
-// ParseDeleteCommentV1Response parses an HTTP response from a DeleteCommentV1WithResponse call
-func ParseDeleteCommentV1Response(rsp *http.Response) (*DeleteCommentV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// RemoveCommentReactionV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveCommentReactionV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
 	}
 
-	response := &DeleteCommentV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Path parameter "type" -------------
+	var pType string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "type", ctx.Param("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveCommentReactionV1(ctx, postId, id, pType)
+	return err
+}
+
+// AddCommentReactionV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) AddCommentReactionV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "postId" -------------
+	var postId int64
 
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-// ParseGetCommentByIdV1Response parses an HTTP response from a GetCommentByIdV1WithResponse call
-func ParseGetCommentByIdV1Response(rsp *http.Response) (*GetCommentByIdV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	response := &GetCommentByIdV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "type" -------------
+	var pType string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", ctx.Param("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetCommentSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddCommentReactionV1(ctx, postId, id, pType)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// ListTrendingTagsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTrendingTagsV1(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrendingTagsV1Params
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTrendingTagsV1(ctx, params)
+	return err
 }
 
-// ParseUpdateCommentV1Response parses an HTTP response from a UpdateCommentV1WithResponse call
-func ParseUpdateCommentV1Response(rsp *http.Response) (*UpdateCommentV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListTagPostsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTagPostsV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", ctx.Param("tag"), &tag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
 	}
 
-	response := &UpdateCommentV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdateCommentSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagPostsV1Params
+	// ------------- Optional query parameter "limit" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Optional query parameter "cursor" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTagPostsV1(ctx, tag, params)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// DeleteAccountV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountV1(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountV1(ctx)
+	return err
 }
 
-// ParseGetUserProfileV1Response parses an HTTP response from a GetUserProfileV1WithResponse call
-func ParseGetUserProfileV1Response(rsp *http.Response) (*GetUserProfileV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetUserProfileV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserProfileV1(ctx echo.Context) error {
+	var err error
 
-	response := &GetUserProfileV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetUserProfileSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserProfileV1(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// UpdateUserProfileV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateUserProfileV1(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateUserProfileV1(ctx)
+	return err
+}
 
-	}
+// DeleteAvatarV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAvatarV1(ctx echo.Context) error {
+	var err error
 
-	return response, nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAvatarV1(ctx)
+	return err
 }
 
-// ParseUpdateUserProfileV1Response parses an HTTP response from a UpdateUserProfileV1WithResponse call
-func ParseUpdateUserProfileV1Response(rsp *http.Response) (*UpdateUserProfileV1Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UploadAvatarV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadAvatarV1(ctx echo.Context) error {
+	var err error
 
-	response := &UpdateUserProfileV1Response{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdateUserProfileSuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadAvatarV1(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// ListBlockedUsersV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBlockedUsersV1(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	ctx.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ApiErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBlockedUsersV1Params
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Log in a user
-	// (POST /v1/auth/login)
-	LoginUserV1(ctx echo.Context) error
-	// Log out a user
-	// (POST /v1/auth/logout)
-	LogoutUserV1(ctx echo.Context) error
-	// Refresh access token
-	// (POST /v1/auth/refresh)
-	RefreshAccessTokenV1(ctx echo.Context) error
-	// Sign up a new user
-	// (POST /v1/auth/signup)
-	SignupUserV1(ctx echo.Context) error
-	// List posts
-	// (GET /v1/posts)
-	ListPostsV1(ctx echo.Context) error
-	// Create a new post
-	// (POST /v1/posts)
-	CreatePostV1(ctx echo.Context) error
-	// Delete a specific post by ID
-	// (DELETE /v1/posts/{id})
-	DeletePostV1(ctx echo.Context, id int64) error
-	// Get a specific post by ID
-	// (GET /v1/posts/{id})
-	GetPostByIdV1(ctx echo.Context, id int64) error
-	// Update a specific post by ID
-	// (PUT /v1/posts/{id})
-	UpdatePostV1(ctx echo.Context, id int64) error
-	// List comments for a post
-	// (GET /v1/posts/{postId}/comments)
-	ListCommentsForPostV1(ctx echo.Context, postId int64) error
-	// Create a new comment on a post
-	// (POST /v1/posts/{postId}/comments)
-	CreateCommentV1(ctx echo.Context, postId int64) error
-	// Delete a specific comment by ID
-	// (DELETE /v1/posts/{postId}/comments/{id})
-	DeleteCommentV1(ctx echo.Context, postId int64, id int64) error
-	// Get a specific comment by ID
-	// (GET /v1/posts/{postId}/comments/{id})
-	GetCommentByIdV1(ctx echo.Context, postId int64, id int64) error
-	// Update a specific comment by ID
-	// (PUT /v1/posts/{postId}/comments/{id})
-	UpdateCommentV1(ctx echo.Context, postId int64, id int64) error
-	// Get current user profile
-	// (GET /v1/users)
-	GetUserProfileV1(ctx echo.Context) error
-	// Update current user profile
-	// (PUT /v1/users)
-	UpdateUserProfileV1(ctx echo.Context) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBlockedUsersV1(ctx, params)
+	return err
 }
 
-// LoginUserV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) LoginUserV1(ctx echo.Context) error {
+// RequestEmailChangeV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestEmailChangeV1(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LoginUserV1(ctx)
+	err = w.Handler.RequestEmailChangeV1(ctx)
 	return err
 }
 
-// LogoutUserV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) LogoutUserV1(ctx echo.Context) error {
+// ConfirmEmailChangeV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmEmailChangeV1(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LogoutUserV1(ctx)
+	err = w.Handler.ConfirmEmailChangeV1(ctx)
 	return err
 }
 
-// RefreshAccessTokenV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshAccessTokenV1(ctx echo.Context) error {
+// ListMutedUsersV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMutedUsersV1(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMutedUsersV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RefreshAccessTokenV1(ctx)
+	err = w.Handler.ListMutedUsersV1(ctx, params)
 	return err
 }
 
-// SignupUserV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) SignupUserV1(ctx echo.Context) error {
+// ChangePasswordV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangePasswordV1(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SignupUserV1(ctx)
+	err = w.Handler.ChangePasswordV1(ctx)
 	return err
 }
 
-// ListPostsV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) ListPostsV1(ctx echo.Context) error {
+// ListPersonalAccessTokensV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPersonalAccessTokensV1(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListPostsV1(ctx)
+	err = w.Handler.ListPersonalAccessTokensV1(ctx)
 	return err
 }
 
-// CreatePostV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) CreatePostV1(ctx echo.Context) error {
+// CreatePersonalAccessTokenV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePersonalAccessTokenV1(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreatePostV1(ctx)
+	err = w.Handler.CreatePersonalAccessTokenV1(ctx)
 	return err
 }
 
-// DeletePostV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) DeletePostV1(ctx echo.Context) error {
+// RevokePersonalAccessTokenV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokePersonalAccessTokenV1(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int64
@@ -2909,179 +13456,256 @@ func (w *ServerInterfaceWrapper) DeletePostV1(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeletePostV1(ctx, id)
+	err = w.Handler.RevokePersonalAccessTokenV1(ctx, id)
 	return err
 }
 
-// GetPostByIdV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPostByIdV1(ctx echo.Context) error {
+// GetPublicUserProfileV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicUserProfileV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPostByIdV1(ctx, id)
+	err = w.Handler.GetPublicUserProfileV1(ctx, username)
 	return err
 }
 
-// UpdatePostV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdatePostV1(ctx echo.Context) error {
+// UnblockUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UnblockUserV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdatePostV1(ctx, id)
+	err = w.Handler.UnblockUserV1(ctx, username)
 	return err
 }
 
-// ListCommentsForPostV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommentsForPostV1(ctx echo.Context) error {
+// BlockUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) BlockUserV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "postId" -------------
-	var postId int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommentsForPostV1(ctx, postId)
+	err = w.Handler.BlockUserV1(ctx, username)
 	return err
 }
 
-// CreateCommentV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommentV1(ctx echo.Context) error {
+// UnfollowUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UnfollowUserV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "postId" -------------
-	var postId int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommentV1(ctx, postId)
+	err = w.Handler.UnfollowUserV1(ctx, username)
 	return err
 }
 
-// DeleteCommentV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCommentV1(ctx echo.Context) error {
+// FollowUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) FollowUserV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "postId" -------------
-	var postId int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
-	}
-
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCommentV1(ctx, postId, id)
+	err = w.Handler.FollowUserV1(ctx, username)
 	return err
 }
 
-// GetCommentByIdV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCommentByIdV1(ctx echo.Context) error {
+// ListFollowersV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFollowersV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "postId" -------------
-	var postId int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFollowersV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCommentByIdV1(ctx, postId, id)
+	err = w.Handler.ListFollowersV1(ctx, username, params)
 	return err
 }
 
-// UpdateCommentV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateCommentV1(ctx echo.Context) error {
+// ListFollowingV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFollowingV1(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "postId" -------------
-	var postId int64
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", ctx.Param("postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter postId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFollowingV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFollowingV1(ctx, username, params)
+	return err
+}
+
+// UnmuteUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) UnmuteUserV1(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateCommentV1(ctx, postId, id)
+	err = w.Handler.UnmuteUserV1(ctx, username)
 	return err
 }
 
-// GetUserProfileV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUserProfileV1(ctx echo.Context) error {
+// MuteUserV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) MuteUserV1(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserProfileV1(ctx)
+	err = w.Handler.MuteUserV1(ctx, username)
 	return err
 }
 
-// UpdateUserProfileV1 converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateUserProfileV1(ctx echo.Context) error {
+// ListUserPostsV1 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUserPostsV1(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", ctx.Param("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserPostsV1Params
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateUserProfileV1(ctx)
+	err = w.Handler.ListUserPostsV1(ctx, username, params)
 	return err
 }
 
@@ -3113,82 +13737,365 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJWKS)
+	router.GET(baseURL+"/v1/admin/impersonation-log", wrapper.ListImpersonationLogV1)
+	router.DELETE(baseURL+"/v1/admin/impersonations/:id", wrapper.EndImpersonationV1)
+	router.GET(baseURL+"/v1/admin/moderation-log", wrapper.ListModerationLogV1)
+	router.POST(baseURL+"/v1/admin/users/:id/impersonate", wrapper.StartImpersonationV1)
+	router.PUT(baseURL+"/v1/admin/users/:id/role", wrapper.UpdateUserRoleV1)
+	router.GET(baseURL+"/v1/auth/2fa", wrapper.GetTwoFactorStatusV1)
+	router.POST(baseURL+"/v1/auth/2fa/confirm", wrapper.ConfirmTwoFactorV1)
+	router.POST(baseURL+"/v1/auth/2fa/disable", wrapper.DisableTwoFactorV1)
+	router.POST(baseURL+"/v1/auth/2fa/enroll", wrapper.EnrollTwoFactorV1)
+	router.POST(baseURL+"/v1/auth/2fa/recovery-codes", wrapper.RegenerateRecoveryCodesV1)
 	router.POST(baseURL+"/v1/auth/login", wrapper.LoginUserV1)
+	router.POST(baseURL+"/v1/auth/login/mfa", wrapper.VerifyMfaChallengeV1)
 	router.POST(baseURL+"/v1/auth/logout", wrapper.LogoutUserV1)
+	router.POST(baseURL+"/v1/auth/magic-link", wrapper.RequestMagicLinkV1)
+	router.POST(baseURL+"/v1/auth/magic-link/verify", wrapper.VerifyMagicLinkV1)
+	router.GET(baseURL+"/v1/auth/oidc", wrapper.ListOidcProvidersV1)
+	router.GET(baseURL+"/v1/auth/oidc/:provider", wrapper.StartOidcLoginV1)
+	router.GET(baseURL+"/v1/auth/oidc/:provider/callback", wrapper.OidcCallbackV1)
+	router.POST(baseURL+"/v1/auth/password/forgot", wrapper.ForgotPasswordV1)
+	router.POST(baseURL+"/v1/auth/password/reset", wrapper.ResetPasswordV1)
 	router.POST(baseURL+"/v1/auth/refresh", wrapper.RefreshAccessTokenV1)
+	router.DELETE(baseURL+"/v1/auth/sessions", wrapper.RevokeOtherSessionsV1)
+	router.GET(baseURL+"/v1/auth/sessions", wrapper.ListSessionsV1)
+	router.DELETE(baseURL+"/v1/auth/sessions/:id", wrapper.RevokeSessionV1)
 	router.POST(baseURL+"/v1/auth/signup", wrapper.SignupUserV1)
+	router.POST(baseURL+"/v1/auth/verify-email", wrapper.VerifyEmailV1)
+	router.POST(baseURL+"/v1/auth/verify-email/resend", wrapper.ResendVerificationEmailV1)
+	router.GET(baseURL+"/v1/feed/home", wrapper.GetHomeFeedV1)
+	router.GET(baseURL+"/v1/media/avatars/:key", wrapper.GetAvatarV1)
 	router.GET(baseURL+"/v1/posts", wrapper.ListPostsV1)
 	router.POST(baseURL+"/v1/posts", wrapper.CreatePostV1)
 	router.DELETE(baseURL+"/v1/posts/:id", wrapper.DeletePostV1)
 	router.GET(baseURL+"/v1/posts/:id", wrapper.GetPostByIdV1)
 	router.PUT(baseURL+"/v1/posts/:id", wrapper.UpdatePostV1)
+	router.DELETE(baseURL+"/v1/posts/:id/reactions/:type", wrapper.RemovePostReactionV1)
+	router.PUT(baseURL+"/v1/posts/:id/reactions/:type", wrapper.AddPostReactionV1)
+	router.DELETE(baseURL+"/v1/posts/:id/repost", wrapper.UnrepostPostV1)
+	router.POST(baseURL+"/v1/posts/:id/repost", wrapper.RepostPostV1)
 	router.GET(baseURL+"/v1/posts/:postId/comments", wrapper.ListCommentsForPostV1)
 	router.POST(baseURL+"/v1/posts/:postId/comments", wrapper.CreateCommentV1)
 	router.DELETE(baseURL+"/v1/posts/:postId/comments/:id", wrapper.DeleteCommentV1)
 	router.GET(baseURL+"/v1/posts/:postId/comments/:id", wrapper.GetCommentByIdV1)
 	router.PUT(baseURL+"/v1/posts/:postId/comments/:id", wrapper.UpdateCommentV1)
+	router.DELETE(baseURL+"/v1/posts/:postId/comments/:id/reactions/:type", wrapper.RemoveCommentReactionV1)
+	router.PUT(baseURL+"/v1/posts/:postId/comments/:id/reactions/:type", wrapper.AddCommentReactionV1)
+	router.GET(baseURL+"/v1/tags/trending", wrapper.ListTrendingTagsV1)
+	router.GET(baseURL+"/v1/tags/:tag/posts", wrapper.ListTagPostsV1)
+	router.DELETE(baseURL+"/v1/users", wrapper.DeleteAccountV1)
 	router.GET(baseURL+"/v1/users", wrapper.GetUserProfileV1)
 	router.PUT(baseURL+"/v1/users", wrapper.UpdateUserProfileV1)
+	router.DELETE(baseURL+"/v1/users/avatar", wrapper.DeleteAvatarV1)
+	router.PUT(baseURL+"/v1/users/avatar", wrapper.UploadAvatarV1)
+	router.GET(baseURL+"/v1/users/blocks", wrapper.ListBlockedUsersV1)
+	router.PUT(baseURL+"/v1/users/email", wrapper.RequestEmailChangeV1)
+	router.POST(baseURL+"/v1/users/email/confirm", wrapper.ConfirmEmailChangeV1)
+	router.GET(baseURL+"/v1/users/mutes", wrapper.ListMutedUsersV1)
+	router.PUT(baseURL+"/v1/users/password", wrapper.ChangePasswordV1)
+	router.GET(baseURL+"/v1/users/tokens", wrapper.ListPersonalAccessTokensV1)
+	router.POST(baseURL+"/v1/users/tokens", wrapper.CreatePersonalAccessTokenV1)
+	router.DELETE(baseURL+"/v1/users/tokens/:id", wrapper.RevokePersonalAccessTokenV1)
+	router.GET(baseURL+"/v1/users/:username", wrapper.GetPublicUserProfileV1)
+	router.DELETE(baseURL+"/v1/users/:username/block", wrapper.UnblockUserV1)
+	router.POST(baseURL+"/v1/users/:username/block", wrapper.BlockUserV1)
+	router.DELETE(baseURL+"/v1/users/:username/follow", wrapper.UnfollowUserV1)
+	router.POST(baseURL+"/v1/users/:username/follow", wrapper.FollowUserV1)
+	router.GET(baseURL+"/v1/users/:username/followers", wrapper.ListFollowersV1)
+	router.GET(baseURL+"/v1/users/:username/following", wrapper.ListFollowingV1)
+	router.DELETE(baseURL+"/v1/users/:username/mute", wrapper.UnmuteUserV1)
+	router.POST(baseURL+"/v1/users/:username/mute", wrapper.MuteUserV1)
+	router.GET(baseURL+"/v1/users/:username/posts", wrapper.ListUserPostsV1)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdbVfbOPb/Kvr7P+dsORtCUqC7zathSssJM5QOhHZ3etgeYd8korbkkWRCOofvvkeS",
-	"7fgxsY1JaDev2saydHWf709X7l+WzTyfUaBSWIO/LGFPwcP6r0c+ecs54+rvPmc+cElAP7GZA+pPB4TN",
-	"iS8Jo9bAOqII+75LbKx+2BU+2GRMbARqEqTe6VodC+6x57tgDayPR78Nj49Gw/P3X95eXJxfWB1Lzn31",
-	"REhO6MR66FhjAq6TX2o0BRTPT6gfSKRHIg4uluAgyZCcQrj0C6bfw+5OmgDwMHGLVvVACDwp2iKaBh6m",
-	"uxywg29cQInHiI0Xa6YXeqsWQmPGPSwREYjQO+wSp5tf+6FjcfgzIBwca/DZMHpBz3U8nt3cgi0VrZGU",
-	"LkD4jArIS0sTJIrlxTmeI5tRiQkldIIYBcQ48hiPmGdWEopWIsHT8/zEYWwNrP/fW+jOXqg4e7HWPMTE",
-	"6lVyewvJKtrTG+Z5QGWe5AvwOQi1HsLINqMQowgjnwmpaMwqKpWFEykFknAvUTgiEl44Z1p8Jxyw1Cv8",
-	"X5G22OoxOF9w0TrEAyGx56PZFGhyCTTDAoWvquWMdlgDy8ESdiXxlOCVnp1Td24NJA+gYG1SYBxXlPwZ",
-	"ACIOUEnGBLjSvOzu4uUIla8OypciVMIEtDQVA74ULTg8jtinhiA5JSLe5Q24jE4EkqzhqoHvNOWui4VE",
-	"4fvNWRwI4Cu2rYag2ZRF8nw0szOmQhxrwf4FRZ1Yv1NKmOJZoXnpsaGRXcCfAYgC5h5jiVFEhXKoZgmE",
-	"EYXZ+oxviPCEAyjL8/D9b0AncmoNDnu9juURGv27v9qTGmJW8uMysG0QIulO09RfSkwdzB0049j3E9Yl",
-	"zJvjwI25o1mmYgMPp8tzycESr/KpkT/Mbkq/W76jD0w0FW9LEo2mWYjzNBASCZBSRZvAR94cnbDdS2YT",
-	"7CJs2yygMiPrfq99YSvWtCJp7fFaErMiqrqMT0A+hcpykJzAHXbXrbMnINuVSls7qS2WKwH8A2dj4kIr",
-	"u9HhxTcTtrYrRWT1Xf1GRKRtolV1c0lNSZXksGy8CEk1M9ZYU1ckrEuZo5REtKe7LbJFz1eXJ0bnGzOE",
-	"TQitGHkUB7SCu+ql/AZNkZZPcgXwvwmknyLsOByESEeaW0yh6zD4OfypazMvmYtF1V8qq0jFmf2CbNDH",
-	"QswYd0opigakiRH7Nt+X/s9CzHrcSZIRT7iMkn+uinjRZuLZloilTDmjJ8l6UOnm6acRCnxGk0paIizJ",
-	"vgLNz3x6ef4efYIbNFLPtchxIKeqPrF1vixACMJoRoIwP53enNjknJwOr74N++/JUAzpxaH9Zvhq+NX/",
-	"18c3p6+7MD/95nwaknMyvD+7Peu9H/17//z462xIZuTGeyf/uNSD7/DJweTi5LWrfsef3vWGt+z+/ejt",
-	"y7Pbs8Oz4+F8/Hv3cuz+ej+7OL08g19/fffy99HBeOafwel4/9WH86+v5qcfv2DndyFmh3ZSgrczubKQ",
-	"N4wpFUorjkPL5JFRIa0ilQ1eu4vl1bp2Q8QUaWIuJHhPkl+OVPFJhMorx4SL9sp2Tf8GavZoi09fOsc7",
-	"3Gzd3HjDRUXzY0vlSzKhgV83lAn91rOPZdpAvlDsQSlNxobUkEwZhynUXk/p1fLltOblVztm0GKgPgah",
-	"xbWmSG2UsHjbESnRCPQCu/4U08ADTuydvBI4qznhYymBq9n/8xnvfjva/aO3+/r67z+tjFEJdUjKKkF/",
-	"p1qeYYymlZhmLGmtpc6V9gi10THjSBCmCO6J0PBGAs+qEedCj1QHIROSMzpx508PlaWY02ohGPJvzaCD",
-	"2U89oKxA0g3gsmVizqc1V+HoXFrzZDjZgjPtVbWtyLgeHGO2kUBkSuX8joDrCIRdl82iYK5eVvLFKQxm",
-	"G9g3GdifcTRdrX3t44Gt2FTNCKlGL6/2NImrqr16BZgpFtIF2EKcL3svD3Z7/d3+4ajfG+z3Br3eH41L",
-	"l+bmzKa0ijm3arFsSq1Hl5mKu6l5+71+o6JT+wINRSyTaqIAjLxDDCkVCPUfo97rQW+pUGnguvhGvWYo",
-	"WynkRzqtvF+qV23H6pyttgu2/2rUfzk4OHyUTpe7zXC3gdGNaFxesZ2ifRcV3XUKierF+EPHEmAHnMj5",
-	"pXJbxoncAObAjwLlrqN/vYsYdPppZHVMS5OayTxd7GEqpW89qIkJHbMCFP3DMO4yMud7kbWcMBQdWS46",
-	"nnQ7D5GmZSQecPRhaHWsO+DCTNrv9ro9JRDmA8U+sQbWfrfX3ddFlZzqTe3d9fdwIKd7sR35hQDbUQJE",
-	"jb0upg7iIANO1U+nn0aKLuV3NZFDxxoYzFGJ/WPfMvIDIX9hzjyTtSY2t3crGF00iOW7jGqgiyYBqxhu",
-	"1LC8voZgp81BuzLsCis5m7IBPb0JiprAl71ere2t3Eg2lheQqsclQncXXSQlgzQs3FXacNAidbmWsALK",
-	"hqYFLWyeU7xHL6A76XaQ/t2ou2nR2gkJ7G+EQBNtGU/ANQ8d63DN7LoEfgc8bIlzAuX7ooClHVPgeZjP",
-	"jcRV3mNsUTkEPBFKuxOmqjj7sW9dqxeTls4CWW7qb1zAXCSPTdQ0NmNfCYhCC2eBTJh43hBymsoCmVLV",
-	"9yyuS40/gWfFexbIIuarXdTmPocxBzEtZ/+VAKE9fzjSWC56MebMC6WwgyRDRIgg6qHBmpXRSBJJaycv",
-	"rQsz6ZF+QZ+RVZTaUXKJkDRwElJ054VyVCp6wxz1MCb0i5nFEIkEyI2aPePII0IoWadY/mw0MMXzrCKG",
-	"Ak2pQA11NKjnEmegkyYR6pmJ+qZ1Kq9bBozdQLBPH508LtqHMLADEhNXu7tVsb49rS1Gs0spTZge4jAh",
-	"QgIHZxH4NYwbHndpyZmtfy9JwOu1EngVITmMR2W3qyqduYFexbPxBkbSPKwD0s5AKRAK/IS1VnMFyva1",
-	"Nk+g8HBdNwdpL6C7hcJWH9FBPpMmKXbnaEbkFPl4Qmhco2Qyhah5qTTktJMxl/VIFTD4KL2hqA8qG9g2",
-	"EZ4y4oqPBRiPLnU8lzAVMi06nRDdVAVtDT6na+fP1w/XqVSKhIcMIqGsWoBGRzuVQpNG/aOiOd3yEwFO",
-	"aW1cdOeuOVrlO6YbR6wPuiXYkLreUFXe21xKZhiJ0hljUaxKtBFuC9bv2zWYdvX42LKWX3iTvSVQ7B6S",
-	"IWzvL+I8GE/hgiw8t1G/K58R3+kz2jZTpcrNvLL3MBMlvEfKzg7yK2sbMGRVqJqev1od9PbXnp8hIhBl",
-	"UguIcfLNHJIbppq7YKGaKfIO1kqeFq/OgOTiwig4aHisKR6zgD4HSEOxqqE5Go3PWc7NHA2PywL3inQy",
-	"LLJU+pWZNm9w4Z2NX+ZD52nTx5LLIWUy/14zxq2BVEhla5rICch69uFjjj2QwIWeO9+6k73vypCxCkCm",
-	"wCJUdwvKqdWxzJGbORdLJ4KdBLty56y53tbrjuUHRcCkPjILU7VFF9GjQ+mi/WfNiXi+I6s5dBQ2Tfk1",
-	"E/L2VL28iarMGKO2sPKEPEjuapuQ/3CZU9jQs82cVgeGuD+vQVi4Crs4q0eGdE2j/hg6D3th/2o9oC56",
-	"yVyFWpVlJS9fvmO8rL5pF6wru+25FK+L97VNwH7QBCyScBM4MaP1GfQgUrhHpGGR1qVXUos5TuKDMeZb",
-	"JAVZmrHpFjK1CtBo6gMaaR9UHzANebcRzDRzU6JxtvYm+mbG5pDTknsNy4itjJ+mb6dvM7atq35abDe+",
-	"KtQc3s1946fUWy9PjZoAwPHazTDgtENcBQNH1rxFgp8YCV4o5Ybsl3EUCfv7wYWbmXIeGo6/yZapcbKZ",
-	"VyOAOHE1MYcRhwusBSauH8O3tcoPakD5suVx0HFV+6lduSS+W5j+XuJT1Sid5VQtCqVnjWw3zhFSt5g3",
-	"gm+3VTRFELddv3hqG+Wu73irY93b4ul/Au7epodNwO9msS2Pf1cLb2GppxxrFcxbB7nwqjKhJhokb9LZ",
-	"AedApTuv5LjTH1d88lxyycXtMh3PfKZxm1RWcQyxVaEXYsoC19G/yLlPbN3EPMW+DxSRcVpJdp5Xa0L0",
-	"cYjaKWZoA6l7/Qn7Uyxa5JarUqX2jC338YKNZEoFn+543F2SiEFj872POARtImd6hIOpnjx9h7dMtp27",
-	"qw68GzmbMOZX9zdmdkVAUSl7DHfgMl8nDWaU1bEC7loDaw/7xFKLh5NmXz2PPI1I/pcd5iZbWhovPpqr",
-	"86i/syg687dm8iVt+RKieNJ431XnMrdTCueK2waqzhWfWBZOl0zBHq4f/hsAAP//przAS7VlAAA=",
+	"H4sIAAAAAAAC/+y9eXMbN/Yo+lXw472vxqlHSdTmRa6pd2VJduRNiiTHkxnmacBukITVBNoAWjST8ne/",
+	"hQOgN6LJbooS5YT/zMQiGsvBwdmXP1sBH8WcEaZk6+DPlgyGZIThPw+DgCdMHZOIKMqZ/lNIZCBobP7Z",
+	"OicspGyAQjsC8T5SQ4Kw+dD9M5FEbLbarVjwmAhFCcweJ2JArrGanvbzkLDCPGMaRahHEHwStlHCIiJl",
+	"OjeK+EAiylCP9Lkg+u9Mr0e+4VEckdZBa6ezs7/R2d/obF9t7xx0Ogedzr9b7Vafi5HeQCvEimwoOiKt",
+	"dktNYv2JVIKyQev793ZLkK8JFSRsHfwn2/Xv6Uje+0IC1freLgPsMgkCIuUFkTFnkkwf9FJhFmIRorHA",
+	"cUwE6nMBp5Lmy34SpTBIYSzsdNMQDbHC+v//tyD91kHrf21lN7tlr3WrfKfl88Ec3rPF9EQILuDqCssG",
+	"PPSc7ZAhHMcRDbD+w4aMSUD7NEBET4L0N8Ur+vXw/enx4dXp2cfrk4uLs4vpm2i3+pRE4fRSVxpibn7K",
+	"4kQhGIkEibAiIVIcoGqWfsLhOxz9VNwAGWEa+VYdESnxwHdENExGmG0IgkPciwjK/exwH9YsLnSiF0IG",
+	"9xDViHuLIxpuzsU9AHS2n1m3lMe54m3BhqT/voTAExRwpjBl+l1zRhAXaKQflQGeWUnqvVJFRnIuujms",
+	"+Z5uFlaZOpvdlvdMt1hh4b/2JI44DkmIYsH7NCIopoFKBGkjqbggIcKaTCSjHsM0kh4iZD67tp9dJyKa",
+	"XujTxXt3nREWAyJVNmcbMT6Gn0o7KBO/lNYkgvqwLNul3kA94AJgrtyHc2HsO2xh4WroZ4t4XoH8mmAg",
+	"u3aMO3oJItPQl/QPz7P6TEM1RJiFaEjoYJiykRzMKUMx/UYiWXhZ2zvP0wNQpsiAAN5V3qkk4lajeWFy",
+	"jTEYvT0/eYPoCA9KVGqoVHywtRXxAEdDLtXB887zzhaO6dbt9taIhBRvYQCY3NreetrfDjrBU7LxPNzu",
+	"b+z1X5CNF3h/d6MT7PS3e8+CvXC7s7W983zzSzyYiyGluwTQmbP5bu1VxIMbEn6SRPhuDLgmNUy2p4fC",
+	"M08UQRGVKcBxojmp0iSchBVcPBBE/zqbj8NyYyzNWiR0q4WbNZmwJv1CqmuGRx6E0af8h0QwBOkhxTt7",
+	"i5l3SuphJZ8Y/ZoQREN97j7NsWR3/HTevZ3c3ilTT/daPuyL8Lx9R9i77WPu3XVTijVFGGlGl9AQS03k",
+	"69AnPb76GPqXMsXLjvIFMxLy+cIVDVu5hQqXngdkO491Puw/GmI2IMBnL8jXhEgPcn4kYwQsH+EwFERK",
+	"oDhBIgRhCsVYyjEX4WwRFr6vMfUmOlVIkDjCATFiq1sHOCwLiOa6fSpGJJyG3CYj4/9j/7QZ8FH+spzQ",
+	"MsLf3hM2UMPWwX6n3RpR5v6568Mhe7rprR+Vzl/cjdwNxK6K/4+U444I8/tIZ5y1lefz7t+dJp2t+nLP",
+	"7ZDK+3Un0bfKyLjmjdp7uX40EGq3GBnP2M7H3NHaKKT9PoHt9QUflTGtuFW2O77v+5yCZuk03uvloxFh",
+	"ngu9ILEgkjCl+XNgRiHOEEYxl8pzlZwp70RablTkm0J2hMMIO2cRSm8EwQpW+B8fVZzF/q7oiEiFRzEa",
+	"O0botq15of20kgUKgsMzFk1aB0ok5M78K3e6Ka5VsVSOi40m14LgQK8ifVdjfkL6Q1khOyCYINPGUlhQ",
+	"NSzA/D+tiN6AepNKwVNnr9ixlXvbLX1h1z4AnR6njJGDHE9lupMeiTgbSKT4glByILoGHRt2jsOQGnXz",
+	"vICdNSSH0kNPRj0i9ObTi9DYn4dkbwIX0EYRwSDY8kTBAHMtjPd4OEmvYQruf7YinAyGrYPttrmBg91q",
+	"SGcPNonDRZ8ASD72+8XfgUauOXdtBNAhd4/uzi/CJ7g4nMt21E6JUIFSFGDmp4EgDIAAY9hdJZu74jeE",
+	"Iakhat8Vm5I+pkij0h9VzZVxDrMLsN+YGYuk8V83O/H7F18vnt1+3Bu92g7+/Xx8tT/5effL66fhZQe/",
+	"IZ926Nme+OWZ+jxX8jM7mgGLq7Or80ogHGOFkZtOw8FuHWGkxnyjjwPFBSJM8ChyV17HhuV4/dONkA6o",
+	"AqtVBp8cieNCG7uK4Nne2d3bf6pXwkoRoef7///T2Xjx+59Pv//verYeLzwAjyyXbAAR+AxhQI8H456n",
+	"CA8EIf9TFiOKcsT2fGCYzcyFx1Isrg46ALK7W1zt1upbWs2JzomQmm0cwr7gaVbe9mtt7ZT++47tPNqQ",
+	"DEZzPdP0Sci3mAoivVT8zBpMEQyauBs3MyHYmgR2whOj0UiFJwiMmihhikZIkFt+Q0KfUX57Y3v/artz",
+	"sNvEKN9u+VXRj1oNVRwJEvABo5JkG0W9SXH5o1MU05hElJEiem7PRc92SwY8Jh5J6BL+jgYCs5yok8K8",
+	"llnPc/MwLchhlJ2aObbnGPuspmw32gjPlvKKvHi3tDdlmKln742fGZeLUtElEU43Tc5glUiFJFFKy3BJ",
+	"jEYT9IZvXPKA4tQf9D9TODsfab8mXAsdtWVjjuCLTfRLwmEvGhjwE/xdZkOpQnKIBZHNLWSNSb2+seVg",
+	"qN75khBSb6op6nnRV6sMUXTWbx38pzGVaH1v/1lT0kup4i2OErKJLhUXBK4R90k0ean/M8CMca0VIUGU",
+	"oOSWhAgPMC35Vgcyvn76NuiEJzujvagz2uW/xJ+3v/32/I/D/d7Rs/DkRf/N9vB098u7/ejDM7awKPj7",
+	"93brNY8iPp5n1capFbsP44mQiAv3D6ONeqTiezAxuyVnm8fNKM01RSOb+NqA/cMasPOI4aMQr7kYcDXX",
+	"yDnFoIQZqeU++y0SRBJV23Z9UjCJF+M5PKbpkJNlmqa99mAffN4QdR8ivyV0OHpomf8NUcvla8s6STPG",
+	"po+R9CIa6Cd1bh7ucs4Es6a0YGmnK2+20VGXfUigaMs+ot5k/VP9zEfkNSHhYufRVGeQktEhHxHUJySs",
+	"3vi0VKKRN6U7era2lrWJVIb31leiAG+nDcOMfFPXQSIkF157j+TCra6H2i3gnrT2EhMEIs0P80N2KgF9",
+	"OrIKkj+y7pAhmh+BJJFS/792Z1mSnGlVaogVwoGSCEtElUQygYWmAW8+u64QCw/zk1oo2KnamrtIwkIX",
+	"NtBtHSZqyAX9AzZ4gF4RLIjottCQ4JAI8HkGWAhqFIUuw+GIMv253mG3hQPVbaEgwnQEp8qLm31B5JCE",
+	"m13m4+SzbBWpUJUHmF6wADAzw5Q5Ys+ZI7abxQi2W4XLmqNceS8W4AvgNS5LxVGf2q1bpi4d6HESUqWD",
+	"HosH0OEmO/gF2dgOd3obe8F234Sb7Ifb5Hm/03sW7GxXCUYLUZGpQ7eL+FW4KbvO3LdwqA93wpSY+ER8",
+	"J9+McEi0vwMzZNBqPNREM7cjUFf9Tl4wB8+5JDcrN0vpP9m1iyFHtUToel5C3q9cpoCcnYPd/WbI2UhP",
+	"IBr2pbiqWqe88xtYDjqPiBpyz+I/X12dI/PjTFC/OblqeWMm1HB60nOshjNnc7FhwNd880qFVSIrtmt+",
+	"zBbIBIF0hZ1OJ501dxmWbNe+hlyAV3bvne3mBhwgA17KYB9dYW/pdVkAp/CYG+Pz9vLs42fSe0c8dMKI",
+	"deiGTNAtEbQ/AWqQYwCy7WipngZ9Jj30jkxsTK6HYEQDjwREBxAki6MBF1QNRw6oN8Q8H5aMNEBOwuPL",
+	"w1a7dXG5s/+09XsOvulPnrCCW690cgvC1dm7c72ILIUVhzv7+9svfNN55LeTb4bE6/kuLg9hPvSkhyV5",
+	"upeIcmz04S+Hr3wT3/jQS0Py9LiNRlgFQxdf2dVjU+Gg4EKQmufBPVEifWTv6UbH+9Jv1MS/uvGCd1tn",
+	"7867LSBsFjjmmJq/dlsXl4f2R3f+4tpn7859i3rEpg88TCLzTKtA6WO6Hv4WjfFEy0aSDrqt4nYkHfjm",
+	"+TYT+3PIUn2529tffzv87d23I9H/9fL62dXk8y8/nw2eDYPbcxzTD5EYn2J8Hvz86YLPlXf1lRi0MEds",
+	"w9uZ/X4viYctXhJAzTg9i6x6ytPPVY+uHUSd7WNu/DTM6zvLeypVLuJWLqo9ObNlOUzWWCzbaGQ0+4Cw",
+	"nDrk16xqnT2357mHr9Rk9OGtvUMu1RgD0GiiBlckNPB+OmXT9IXUjnPfiiS6yo9DVCIcSY4iyjQeWF71",
+	"nrIbp10trnnq+zJ29Dvj6QzzeglZ4bel4GzOBfBXu5WCHvSeD5bymoqSdqo61nhOta6jSne7Ezn5wEMi",
+	"lgqFUTrjMg9f2OcSzu3x6cl7dMZbArs4WfVP25TI+j2ZdwEjl0tiRWBKXyIfgvkaw+dBTJkPTOsujcq/",
+	"nGtytr47I7SbqOkV2dPcDW2v8OAOmFu0vadmdKxdpEOFB2sjvAfqfEBZTeeqhrFLNqestj/VusinAnLv",
+	"1486K8XH7ugRZ/jYa6lCf/dLPlM5Z0xIYs7yxAHuC3Hh3AqG0kk8Sr/AgnSZJAphiQLObyiRL1EQUY3L",
+	"aVyj/cH4QKY8MFh2WYVHBHWTTmc3gHHwn6TbSt04dk92FsoKf3TGbh22v9llV9PjJeIsmiBBVCKYDVGz",
+	"+wafEI8VoqytLeXS1moAV0y39a8NYLQbxySit0RMDmCVnIFGtBFnbr129Wbt5s7UkIgxlRBE5PalYWqH",
+	"/axUrAPqLRzbkJtgkxnAggrUy1yzNH6f4vuyi1e5ri4pG0RkI5GlbbaR4ArMm5whok+a4cFZDdgVn8fo",
+	"Xa/zy+jFaPdmJ/q3eD55fbv97fNecPU0Odnn58/wx93wsjP4eefL+z1vjrd/76kN0gbgc5GPLSehY0sl",
+	"wkEmb4e9NwE9o29PP/1xuv2RnspTdrEfHJ0+Pb2J//Xr0dsXm2Ty9o/w8yk9o6ffPnz50Pl49dvu2fHN",
+	"+JSOaW/0Wv37Egbf4jd7g4s3LyL9d/z5def0C//28epk58OXD/sfjk8n/V82L/vRu2/ji7eXH8i7d693",
+	"frna64/jD+Rtf/fp+dnN08nbX69x+IuU4/0gTzi+jNUdwv+BFixFSDB04G7O9CJlqs1nPrw+PBriKCJs",
+	"4KVnFgO1P8tuU1MdG0EdCAJOGhxJm0WTpTTk0ESLLVQiwnQlCu05/chTcYZKF1xm47E1aAK3o4yYkG8B",
+	"ZJvoxzAg+kWbjWBTr8PzKtNJKt/lkAu1oWlM2EYye6R2TeN+mjgSHlsilfLYDNt//rr7b9W5/fx89Gon",
+	"ePds8n7/28ft+GJPHr/ov3n65bBDPu3Ssx1x9Xzc1GecueBwX+kzD2kwrIIR40hniREBDCBWJFyip27U",
+	"x9cZRlWYh5VIyEuEkSQBZyGyqEBL8f9c70cZz+U0OAtZTT3OI4KnQ6cLu2lP3XUBqPPQfvEnnEP37Dru",
+	"9owL77H+K8YDGmgNqInYqDS6DJB70u59a7Vq0bys4gyLEtb0NIsEF846xI8dWOgx6fg0RhJShbjIqjKl",
+	"AQnO1KRVGGHDCDjLgv7HjtIzDgS2MkTBGxf0eYhN6mTIGckyaWHuvNPRJBi22i3YICm6He3fPPSnTmRE",
+	"mksJaaRFX3StOAGziOARmfdOtapyocc1jaEw4JtJmDsPGkKxWwsysSC3lCfyemYSif3RxHqZsipp3TPv",
+	"yV8lExQMCY7RWLtkifSmsissBqRWYgjU75rO9qsZT27XMT9MnW8SkyyjcAqt9fKQVQurF7Ha/lZ1riZZ",
+	"wnzM5NQW7h4VkQuByL2BtnvsRdDkL2TqEB5UmRswcXZ6fHQu+C0NF/ZQAvexvh/yTRGhbb8G/9UExW7y",
+	"aqacy/EfcD6I5mT5L2ZLc1HzR7n85bllQnzlQdoZ97MvTBImqaK3IBmyAfEc9bHWVplpcanIRCpH31XY",
+	"+3NZE6iUWYSokiTqZxaBIR8zhLO8q2YVpTz1BMxipYIaS6L6s5SGk+lcWEhNwWyyxDTXZnwnzTNtGroH",
+	"qSmJXATqpoaDJCGcXltYKi7gqS69ube/0izfR5PHO5OyAYEv5u/Ope6Vi3mKtooRNXaB3PFmJIqnMqUk",
+	"Qh4Igl2SkzwYCwqCJDgM3E/mH+4ny6vTX9N/mwFTLDwdOHVX4E2YXRbIJKMaFJUTqchoSQm6bURGsYII",
+	"QZsDWzLGXQ2p1FRuNLFJgssqGQRHWkG9IJeWvEAZnBvKQm9kPig86aGoRJghLuiAarQzYE5TjHk/VZHM",
+	"L+DjgsRj87lFJFOntDDWIzGaSVs2B7r1e+UxcraY5Zc8Mnd5H/WOVlR5CA60mrJDgkCls8AmtM8jxhfp",
+	"cOeUbFi3KH2Hqy1atPCzrMpULdcpgsfbrFzRdBad326B2cQcKsAMSULyb9xIj6cKkIjIFIemSzTmC4Nr",
+	"dLylajJN5XuUVxijC1WaUzgPKKwKkB9JEt0S6Wg+oEDCbBZtLlGAx3rnek8B7/cJQRG/9QZGpFzPPE+P",
+	"RJM+MTswV9x8LLgqhvzv7tUS6u6Sz86HbEY+u5h/Dr11aeP+nHV/OsFgp1PvJG6auuumwDNfFnn182e1",
+	"Fr1jdn09M0G79YVTVp8KwaEkHTDNYeIlqjpLz/LXRUbm3lYMgSYzUL2m/nLHmgJFpZ8lUVT56IdKxfJg",
+	"aytnm84qPne2N2M2aLVbegrth5tJ/mdCOzGY5saVLOR8yJZajkBTyzwqFu6vTL2myMD0A/VxiQsSaPI4",
+	"OeKhT+M6YwY5kbDjwOcowaIwQVgQazsAMwKUzNWE1xbVBdbiTGLIW+/ATXsduPVzAhjuBSHZ6A+GO7ua",
+	"Be6NWLzxVcj9p7OlsplaXGnBuSBZ3CRXhNgdfWPFa6ptdCvJVz7jEZe2SpD1dDtBX+Sk+k2UJfCaoanc",
+	"ZbwWISgCkNALwTaIplXF2wY3MFJ81JOKM2J8bnowDRE4SI2nfQlKYW53m3XkvYZaX/nwd1b+LPRmq2X5",
+	"VYuu7ltMgaBB3pzxjwfYGl6MANc3Nej0QzWBejPAkjqcm3LZEtTvRTctAaGsopb1UI+yWV/HbKiDlLFi",
+	"tarIXS/Dx6wcmlaQGEHkcHYpxItCjJrGw3ygmiY8FYF9oHwofEMkigUJSEg0j9GkMB/7ZkIf7DebTYPU",
+	"piP4RC72J/PtOxOMY2j2myXGok3xqvy+/cCXZKF6RBBXWfB51PWX5EubT5UuX2qJ9XpxF8WKSr6KuF93",
+	"337rjPY+7PSexb+8CD5uJ7/t3/78/Obq6fii88d7fLIjj/f6b54N397UjNyYEynr4s69Qe0BOKpcDNiT",
+	"iA8GJNygDIXklgbkpzmV8BsyLLvM/ThibCH5WYTbmF/zWxnhG6d5+lLzl8SRHHg/fTo9LiXWdnov+k/7",
+	"z8jGXm8bb+wF+/umgsFOb7u/T3aD56G/ggGNr63Bw0OTz8sxNIaemZjVAnn2FVXY6exudja3t3c3n1Vq",
+	"gU38QPlrTz1BS3QAAW/CA+/da00JwW8LgeID/4NGEd7a3+ygJx9wQJnicvgSnTJFIvQBB+jsEv0Lbe9d",
+	"d36qr2nZzRYusWRNKwA5w23v+6YDlsRNMxQkfPXoUxTuXm+x2Xp3NHEsK//imEi4rgdrIFJta3BbcSPQ",
+	"ExzFQ8ySERE0+MnmhOFRahmFgiQ0QLqiibG0CZ4oIttIJsEQYV27wCRVdFuIC9RtgW4mu602aAaCSCJu",
+	"fU1uwvkwztdRxxt/HG78W1dT/3/n11KvNHvkTCO1ElPMc1xOyhpM9aC13S4VFsUc4xlSdJZ5AruH8HEo",
+	"vcCqa/f4D1D8qyBY+gMbJ5mmQWVuERK2EdkcbFoIxjEXCika3BCFekTvacwFVEtgm0hbL0Ro3UzVtP/K",
+	"fP6/9vZ3tg+gRh0KOfiqFNINBWfXq9+dL07DIacvYfGruodc8LvhXmF39U+m+zicpI0YPMcggSDKqA4D",
+	"KpWt6Mumuy1YrOhNkCAsJMLJep8uTk0Pv18u0jajxeNxFevZrhNB/fVj9BSJnlMqzo21qrx6iUPaKQ+2",
+	"thRX8dYbbqp1H/g45/+XVgz65+XPh9s6J2znKTSYkP98av5FpUyI+KebxvwxJoLy8J+7HfNPCZD659tX",
+	"l59/2z0+P/n5/N3u+b/Oy//2Rr3Ap9Nnf4Ul2d3ZIEzDLUT6rpAZ2wZ0GmGW4MgT3tpqvosSwtgttQuX",
+	"Mx+BFn8WWTeQOz6EEkbXfwnCJLtc4YG3taxJ280IGcu54aGohnGeJDqpBlGPxbuJC8ZJy3o1ay9Rdn9o",
+	"TFkIPU4xiPfwYy6puHn878BvGWH624j+QUI3fTs10lAl4Y/AN0v1x3mE2WC+Po0HRbfGnDu5gz0+hZw9",
+	"hmySez31sa2kohWGhunXeQxbOJr2asxfQ7TyZUWhuqvKdDTbwkDOaaJoMtaqdftZmU5Y1U5uapc8MteC",
+	"jEz28Ex/MgPAl5xSBXfyXMumO+GMHdSA/FIyqGxBwTtSvBJK1MalT2Cqbty7yFi4tRBAvlEJYmgu/6CB",
+	"I8dMFDbpXySV4GwQTe6/kVEBOEstpGXh98Alzc15mvVX8dz0Al1WZl3zdLeVT3b0VORms/YqjW96ufXe",
+	"l3LHzYq9m2Pkwr7m9afCtkAYGKr0x1mRXheRsXAglwsOMJdcqBa/id6TvkIJc8nNYL3kI6oUCV8CskGc",
+	"l7lJJMiI61gwOjfcKxS6OI7YLOLKfq4gayZy52xvsSAQIuoYVcnAO2BckNDYXorRb8CJFEc9l34SoiER",
+	"5KUtuaAb0bu/q6HgyWCIzj9dZcaaLZiu7fy3Jj1Gpk0D02bFn2kUIajDra/aVP/BqJ9AqMwtEU6f/ntZ",
+	"9P6WZrT5j375nQ+WQsqamcayU13wGXRsyiwGzy11MvHIhLRWCLoVBrFGebClA8HHi5uZisdeyk0Kvqob",
+	"1Ha7Q4jEWw5XLYUIJrDAHc9k9tfgVN5OV4WUF9f0albKy2MLhm7mWjbBJ8vwK88NhVncQ8aHrI6HzL/i",
+	"ta1+3Qwk7iP9FyqKu0ujWIGgMjd08VIl5YjWuaC8h+jze4nInh8yBlIGWBlqVB5IcnLHtGUiA/mzq86L",
+	"OU1GGoP8kXVqe/io6qY1JRqG36WEqBx3t1iwQ63AvAcKEm/iIm2SGpRCe5qJWWkpfarogyucIiFBCEqs",
+	"aH5iYgJNiHex/MRLU1/FjIdypSPM8MCIYrKcu9pqt9LaLK12Cz4t5p/aUVMX8StUnocKNvVtGaZcvVEv",
+	"l9CN3ZDwYMXd2A0k8vWTmjRltyWpCkXOStbdzeYlxq5mRFISFsacMrWJzoy+b0t8FbfAsmoWroiF05Il",
+	"UVBmxUpY/9U1sdId/TcXCLq0SmX+VvSHdZvQm0RZ5jVcVzSoz6mLuzsFdfHpgm3qjY8xEVRNLjXdtWIo",
+	"wYIIXSQz+9drRxnffr5qtWf0IzOh5Tb1Ca4NGqogaO5xfHn4cvqVmGYfwtqD5FDfvuqyrc0xiaKNG8bH",
+	"bOvL+EZufpE6duCV4GNQxnMwJZn/Ld/ayt07grKXaUixtzZol/lRE7T82hVDXxo21ONqaABBmDJVOU3B",
+	"zi4ba0LoEiTN/iCQwtmTLgGwhlZSJhXBodlwRer/zJqmuunv5uam3pcxPkV0RHOZzqZsgatPlPcu6i1q",
+	"kFjTkgVJyXMEx4BNGIIOZmz3lnV5UO15IBup+p12SCvmYPdcuVE0SqRCJBia3QVS9AsXaZ93l/1r4+jy",
+	"4rWpjWoh27bh2BNrerEbh7PsdXZNVUSQLsDdBPDJ3rWWbFrf9YOgrO/Rwg7PT5GMSZBhrZNf33DkWn7H",
+	"cWR/BX2KKqtyuQGH56etdsta5vTL3uxsdjQt4TFhOKatg5YO+9y1XZbgMfpfgf5l4AsNOM81YgFPsGVv",
+	"ZWyXCGIX7MVSqffWNvEYhVZLl0R12ZOL10fo2f72M20jM1+D/cwoNLqBDWWe7kG2gi9RrgKwdH2XgD5Q",
+	"NugybdB0dIOFxXD67BBSaVOnO0px/6biDzb1YzXo4aI1Z4J/nob6Coh6+/ndJQhzxi4AsN3pdEpOitwV",
+	"bjk4G4m0fn+aS6IMJlVUj7Vg3UQfubKWjbRwu3QWD21qQITdkojHwA8MSGHbRzgYko0jzpTgHkn/Zz6G",
+	"fKAM2EShEZ6APVp/CrJwdqoy54C9y2Q0wmJiYJer2zJFuFsQKSA1kzksEodft1u/66m09RSEuK1CXNNG",
+	"xAeVaKzrrMt8SLg0lft87QNtH5NC5XN0YZifbWkJQw6yzwiKs+oqT2Bz8icf4vjaffy6De9T4BFRcCP/",
+	"mWo0hb/RUTLKhYIQpkyDTW4FIPQEKxMwsN3pQDy6VltbXxMiJq6qzEELqHXhskLSx0mkwNXlc2hXe8hz",
+	"W5A3NK5akvf7klSs6Vvx93t8U3WarXhe2mm+W16KP1lz/Mx6GE02NfndW+KeD2N6IgQXMzfITMngGOt8",
+	"Nf1HlOGT3dH2g+6o9HRTdYALRO1mbZ0h2NxuRc5UTlcMtOwtsnBNcCoWXy5Mtv/AsL/UHhmBiB4HRfKc",
+	"W6AYdAldW/PyMTzzvGT8n9+//56nkxpZi40aHerlSaQmNfMoo9z6k4bfDYgjonxdAVkoK0N8HUukyvbP",
+	"lS+nO+tKxWNp43LTAsldliebaGGqecLCwrMFilmiEnuenBnvaQgLSWjxbjXP1A/l0+O/2Uvd6+w96Ek/",
+	"cpcb578Aq/FR6a5ipaQka9tQ3G5DKnLCwsqH7acjc2SRGr18QQywbV2tFEBNFJwzIBjDZ7XI+HuBmGVN",
+	"vGrKeCQE+uRsiJoEpoWaR5m1UQ+whsRcpWZQ+UIOqMvHrN1l1ZJgrr+Y9hRACbsCUfOuVikZFopQr8XC",
+	"hxQLZ7ae87zVbDxytdHWAuEjYDP6BTq6WWz+97ikw6m9NRYNR1MYWEMuNPFgWhzMiYjE5OPXIvp6Av22",
+	"c18vRvLnV9L+3YTte8M4BMRAKDoiG84CmTY9YflGHgZJJHG1GuMYjFK56nMuy4yjkPSSQZdhZmxBhhMI",
+	"EnOhhVm0gCyLoC0wVNAY4UmX5cfDDsAsq7G3V2zKvtllrvGTtSEXpO3sI1vBwe0vZ9kwJXDAeKlLrirC",
+	"rJE2v41Cb9C24VE+ayfiLFcEO7XIHhQiobosq6Zt4xLalgObAa5+X7sQmm6zBdruBmW7bJjuspxJD2Br",
+	"A+d8jHQ6h86qCwCdVzycLI0CVGdWfv/+vYz836c42PY9bqSRWSMvBduGQStlW0BkNM0RyKRUOucWVoqM",
+	"YlUiQIgzIknU//voT843YSClbbHTlGUlOpamd7DpPk9YuHqWm+Yv31WPOs3B1wTfNeO2LkSlCZsdD7m0",
+	"qEGlC/G+T26b+Aq4Se1bkeAQiYh+ecXSrR6uaHx30OVCVph4rnIp39a3axsDml59KTXixmHjYtVhD4A/",
+	"RKbOcCpMu1ATd+VhCcV413tjB/5o4lqsoHNPm6jBBi6yyN1HpLnkWQCPiIcBmPegaf8/pFbUYdxfmgXA",
+	"uwK3twlzWtP3LJ3IBeI3JOtHBommw/hnU/dEDbd2+rjSFGVaO2rB35apqtG6MQ0AmK4nv+lzQJeyMX02",
+	"8eVdzJycVM81XXmST2fYRx7xi10pglugORQ3oGyI5OBxL19HTT+7xfQtmzXm6gv4XEgai+UMVLdhfyb6",
+	"e040my3ya17RdK3b6Qdhmz6leHonJr9IlqxZ/uzqPOP7tZI9pu//aDZoWg8pTcysVDz71Zdu3zWoXaVQ",
+	"oXGnjRhP+81mxUCMgBEJgsNJaa9r0uQnTWEiTEp+1m6tKfc1n+ZpRnYjDQlUSKXJEagiUMdmwCwKlUWd",
+	"e4lOUdcJPG3dijTJrrgqmjSrH97ixKl06hrUaK9J7RB7jSt20LM4URAyZwwts6Q3LQz/MPTCKh8r8EO5",
+	"N6I3sfPiQTdxlWscQyXSCiQXWNBogkwBeZsboDjkkUxQH1Mtj1tdU5aiJS+IEpONQ/2Jt4wZZ6HMtVvX",
+	"S/AkDZ3xhkpmVpjvqybqJiDSvEIQOqtwvyGpt7Swer6G5N7wiWpq/4YwIrAiEmEwHOVqmm2iavIjFZ7I",
+	"lAjlrjHjS2CLMySVhNNC7Us7VAMPDzBlrleFRDiVO+xGPIFO+tMyw7gvnW5mRTUPomSDV+oluJpFjtci",
+	"3AIiXIbcDV81+J2WIr45UWsjbdMSV7V9NI9pWj5zb1E/d0lUY3Htggws0ShoPX9TqW11OuRFua2Nu5a1",
+	"ULgWCtdC4cqEQvsMTd5d0RrXiGlkdLY0TwOWkZZl8HOJ3OckLZaSNyli9PbzVa7pFAwY4hnGgS6zDxoM",
+	"SGmwkXSi0AHCKE0QNq8r33rKpV62XSEvmxQddpmr2fXf4um2Rn38X2+kqP5VO2IemDPBundmRXrjNhM2",
+	"EATKd+BIPihDgoPUYEQwLues0BJFDn1c83eXZ1js8KMJh8sh1vm0/zLJpRvHJKIa5Q+gmlSW29iFUqU7",
+	"nZ2lHTOfrV/jtEfZbUAMmCHSvUTNKsSqkw5dJyX4Sgtej4NLoyc6rq9tzmFt0HoW+dNKWLLboCkKwUXG",
+	"/tAhS1lRXT7UZTaZ3LIjNCA29l3iUVaBq43ILUkzGzTVEYIEmRzYdgEWXebYUuZ0FuSW4CjzYU7VJRxC",
+	"JKXbu8XgVTBy2//l9LwRKy9C0AalOM480EUBNH+GLVEcRRMDRkFik9SuZ0mEi0H828gCVmW0NY6Kqb3v",
+	"+cDUjCx70Otwc83vZrgXv5l4D6vMlRjt7CIgJmzUmIC4KAod4HXHafYK+mwLj3uYedZOrp1LYJZkTpEQ",
+	"0/RyTtkRjbiJJGHbU8nB9sYzzU+z0wFfIqGu26lLFVttyZIRPsYilIWe2RbPpvVeW9ulj1Nu8cCCRXVx",
+	"mcUV3tLlmfp0Iflx5Qw4gnRUXj+btIPh4+C3K+WqXFj6GWbvtp1Xd00ZnLWi+SMomqYuhwt5sTf3veg4",
+	"TktK5RQ2oxQ2Yjs8UdU854Lc8hsiPcL9E/0YqZJpkRDUxyMaTX5ylailOZUeFkQEF4sLUc7St+uyPPKz",
+	"g3yNwywGROTrz5oq1gxYg6kGBOG4YypzUSNm+grtkSdqBeqjr43rwvT9DP4DR0XImW41VY1eWxW0fooY",
+	"64/y1BhUHIBLrpP045HDgKlPC2JGimkoiY3wgAYbEWU3M0QxrQRo3qQ70ERkI5FOJNHfuUpQupYsy8qS",
+	"WlUhArkHkojsuFQN6rL3lN1IS6osVdzeRyPKEkWkFqNshig8K+j5bTLppHKtceyzAJIP1YJ0xSkDUERz",
+	"+pHTabgJZs1Umx7RlW6kCXN3dPul3qQZBclmiPe7LILNxkRkZ8yqUQmSFkEADRrqbemweZ377nmU9j18",
+	"0NDXUHjgp5mue+d3eVJQEG00s1MQawhfO9XFt9OqEugwj26WCiJaWMv0kZA/iCnioUUSJ2pkYJQOeUmO",
+	"7aRq9eIyiP9JgF/8x5BF7AGcMKJhVSa2KYLm4LkQyd0ygk9dJdgIC7xfWLioz7ZRRG+mCm9mliegkGlK",
+	"qaGpPWIaTmh1cxO95jr1IH98U3DVVpaW02YhH32zGt6qydtyLNilAq0Z7Df/strlCs3SaaaYS5Oo9NC8",
+	"zCrNZveSmT7dmmt1OWynynIu6BkevaVvj9/GaEnZQhSX0zCoTN35mO/XchYTdnqMjjhjYDa31jqw1dly",
+	"1VG2n01vUZczGgbn7sP7jeg6Oz0+Speq8bYKZ4WYtkGisSI9ZxvFXEraiyaIcTalhuvjwbepPdPU41eT",
+	"bIqG97L1p/vy+4zsqpAKElhi1TPle1N9wn6OnuB8BVtr7NVpdIA65++OTmyHHqkgabrvfCAao6hEPZ3h",
+	"5mZ1i1j78s9KxbqEO9JbvjYTVKvcECWl0QBI8fy6PvpacrX1bV1qLKGAiDFyvzkx/Z1SuFWkBbvPm1Vf",
+	"KqHobmen+hI0bC2QigBPre+lk5SEyffcoEMRy6driK4g15FBkdzcxldAF6+GpOgkYILgYAixtFygEZXZ",
+	"uy0/T8C7at/D1Ftd/Klu6YTVHg5uKt/s5yERpPhAJWFh8QnrGcybTPdGpd7+wFS0BdOXqTttuZbRw039",
+	"adt/CZ2l5jBXotqNyDLr0y7heFQSX9uwSpf1uB2S7td4Zkxp40ITluxTkL3TFbQFJq2IQmWXuUY2KEfB",
+	"zK76AhAq1788L4MhSUyCkuJgdfxv5jH7r67xRLqs2KwilZX0EQtl9tFVXhbKDI76unO+rC6TQy7Uho5V",
+	"CDNy5/dwtREjt1k3ok8X731UUBPAI4smPhq4LOrV/tNbcMx6fxp/B8R93odlWUY5RpEiT/F+QhqavO7s",
+	"mZGqSmkgErXug147pLsDRd5eBUUuiK99LggdMMPFCy6f0+MVBjlOd3G0YaEp4ShhCGzd/i1tgpo6jlSX",
+	"jXkShVo7z+jZExBqTj4cnr6//nh2df3rycXp69OT459cLMbfkWN6jDhWL0ytGD694sjfpWRJ/NKxga0+",
+	"FwOumhnX3cdIEElUtZU9dSbd2eLto9+vYecuPP2BTTjFxX8UM3Xuvv4CZurHZ40F+FabY4vvZpHXaj6s",
+	"fKyXRLn8snStRALhRiqzEpZ3Yts4dZntfVHweHFG0JAn1nfsN8oeFjpv6im1n8s4q039pJSDuEDlfMct",
+	"rwNKklW97cLad37aMFvOqJm/nM3FspXPi7f3aGoklTJSoL/PHPveI8k7c6/Z1n4sOoKn3rMkqjCiwUu2",
+	"4QHVT/iT9AZ4KG5rgJrXnS++aVSo2ZEaXVY/VMMuXGwwVREWkgkFB1a5hQAUIAz25WtN3EQR2maxQI5Y",
+	"lzk8cF+4yMk82UhdSlRJG9LipxawL9OmC4jYOpZkBZ6dfJu0rBBskT6hjyn6XmdE0Ydzxsqgy99O9zLL",
+	"p7GkUbDmIXhCl6aHaicjT803ujWYEYayEYjnrDJ1syUemvB+wJEupJi14jBweAyenLZ9yaGJcLY9AHNX",
+	"82i8OgV0nSb2Zst55GtA7l0F4Vm9Ti4KFM92hCiJSdMl2BD5puX6QioxeESmaaOeHmyQl3Y3NduVwDdu",
+	"JzK9z3WxtAbF0m75TU7qbZqZ6MLmADXGYLEmkSRzELA9rx1FEcckemIs2huUoZDc0oDIn6oRr50PcIus",
+	"GGdaUXjdjbOQbrktE9xKtThVEQLrUoB3aB+wKHJTsE4ULmIB0jq3l5Sjr5yRaqz+h8yO4Stcb7o0mhHF",
+	"ivRObmaIs+kXYBa3mFmT7trRswjuavS7H6sz1N4DvwwDm7QWbV7VtTf5iBiSccTZDkkNs+X1WbKQvrnM",
+	"qGaFcYdciltw3bGweJLAyMpOTkBD6IAlcbUyfgT+UWdSM0n7mVG2FFQBc60gj8EsvJw8eAMQFBKlrf51",
+	"zFNL7BkBa9dg3manOaKIBBlQqYgwruysSKvr9ww3Z47+oySDv3jwMtoMonyE8xJa0pVzCDwGZc3ctMhX",
+	"fMvCS7TbNYlzr7WBOGFCnTfg8NUE4QMWN1lngX/IkksVy8ydauzuemjOc6aKobqFZsPGCF8RqgwOopWk",
+	"wcLKd6Yuv+ZParn0QrbvoqcshfbaBn4vuY7FQPrSe/s167xeGLfgqwPnFgtnebegKyy87sLLyTugvaJ9",
+	"KaDK3ogpHZR2Y9ezSNsiNk0RUUPBldKhS7HtMPIS4dxfnd0N7Iz5HlUY5bJSclZCn6OLhfnnkX/q85y8",
+	"v04REDBkrqYMoi/CxCG6e6g/gOKwquSnaWaQS4JaJONJcTTGVLl2yTlfteuYk7Kcx5/3JHP9Z+22G6ot",
+	"eoYKrluPYvUJCbeGfERmtdkAM5IRETQVk3lHt6yy5fYhqcm0k6PKdI+Br8td/8/dlGa6ns3Y74G7Av7l",
+	"YOQz3Onp02+pQqNEQYUPQVBE+grpxF10jge2dFKfqGBoZo+xTMUZ3droOkiE5MIke+mAT4TTqpb2726o",
+	"wztvz5Cf+Yi8JiRcpLesAe/yOsvu1Gosexbjr0l6zqwboizAJY2ls7xFg8i4p+Ai4d8F35Tz51FVtXMz",
+	"c6O4y2UaWN1N1dDPNAY5DNDPBemHU2liLYZ62kTv0rN6fYSe7zx/XmD0gFsAxyeCRP/stvQfuq2f2gj3",
+	"pPGGwLgIW3hvzoTd9xXKjDaJW1isWpudm3Sg0ci1SP+ZPHLm6L+hsHmyPyIhxVv4Fiss5NafN2RSnQkE",
+	"G4VoScUFxLsnox7D1BRtmK7L6sCc1j1DseB9ze9iGqhEEJPT1SNdRkY9EoaG1NCRJtKaoNjppY14T3uQ",
+	"BcRuAWZOqYKdDQUYKLuWC279cukbog7hyHX8NrCfrS8xGRTvPTXG9SjDQMymnp1PisygVqQOR3rXG0ec",
+	"KcEjT5HNaIwnEnVbcdKLaNBGI/xtAw/IP3e393efdjqdNqKjUaJ0KkK3VYccPKwJ+SPPTp5r/n9DJmXN",
+	"SyMwLqNK9nEOnU2/3zqW2HOsho5qpzNBlpcxZ1M2teCni/cSPaEKqpFgyiSSEZZDIn+qsN3ekMlCbfeB",
+	"1deQuqwswvurkp68HlBY9jFIONs/vIQDm40FgXtx+FPV+T8FH/T9R8fphxqX+/jWyKlm1bYLb7VtrAM+",
+	"6lG37Rl75v2+CeX1gLvj72p6rz5wwLU6DnDw3mZQWktnM8qCD6jtSZ2937W01lxaA0xbJEgAPvTLaO1a",
+	"njs9ZlY7S3Rp436/JlyR8FqPv6YhCtJZ4AeYp227+YM8ZpRe8xX86mkECHPoLT90C8B04Ttb7/UkruTY",
+	"wzoFs0PUUTxhm9bnV4w69XkFASt+LK/gumuDx9xcsOfFPKLBxO1VP93UR1Q0TituEcFywCeGXkEG45uz",
+	"y7Oj08P3G53O8w1POmMb5WhJZu7K0QEo2NBEvkRPskW3n268en929E4nTq4iluWX3DkeUW9luC7HSJp2",
+	"djRXnbGDmSq/HjA/sOwY/g7afkwCjYKWpIxzlZ/rNE82E+VYxNwEIL2MK8NcCq+vqA25ph3lCBKb/+yK",
+	"hJgMZgNUo3hbNHv49wfXm5WEMMhFIIH8ET1HANWCz9Fg/NTL6U3Q6XGVoDdH97cxS8YlUZj2boo/KPgp",
+	"2K2eyEcjwsyUasilc+/MtgW8IaCevZqchvcbDG0Xqisx/ajBz+tnWUPhWsA03uhVzjRpaRkti/CEyRRH",
+	"5lUQGy99hxBPytTTvZbfyhInvuTKOMSul7Ljkrx/dwZu5l2BjpctfPf4T5jKSn0NdL3loXp2mLqUK7F7",
+	"rtb1kvyp1rreX05eM/e7ltdqMAYA1YJswTzNJpxhSpPaEgRDtSe59acmRnNydkb8Nk2EN9+lzqlJPCOR",
+	"Jwuu9ZvjzMyGYpp5a+pcbjgSMEO4lo+8zyAnKVfaW9NyY3C1pohczuGpL/gxyFEjfpsVdjGX3zjoTKMK",
+	"whkOu/orlRaIhSQqB8f7kqfavm2kZ9LD2/n0ulzl1nSQJAo90cW/2yjit/p/cTIYttGYj9tI4tD0xGID",
+	"Mck1VqjyI8MGG1YQ9QqEhyFEFHtJTEpIPAiseEZi0AkOhubPEcHghrZuWg2S/NRED4S5ObNIYMMz9Bho",
+	"bk8DUioGCeOHWCLGEen3SeAhaodheBeKhnVcycpihgt45Li8rcSQR5+1WOSDXtEC/cObns8fmc0Zh+Gd",
+	"eYDlcbiJ3XlLkMyxOdv8bPZmFJwcFlSqr23TMMF2+XLDqZIk6kMpFLOgpyH9J2bWaWCpvjAbS6dcy0z+",
+	"J1wpIBkWn4qzj8bom2FdU1WChRxh++1SZSAn8N+XRcmflDTEIhfvnzFvE9MPoSL9yrgD8zxMgT5717I0",
+	"IXV/ygsaZqy0NCUncATeCjCzHu0qffXmGOWsXUEq4izc8LymvxYH7s8jvqAb/K8ohDx0QnYFX8gKStiX",
+	"kMI7D75nG4fvL04Oj3+7vjg5P7u8cnBcsT7tSF2OmzUTpAytqy1G6f87Db9vOW9dowBio8eaD10Pq7zt",
+	"a5mxxctzMepwuSP75WsuUprfMPA4XXwde7xg7HEegn+j8GOHe40ikFNYrYOQ/7pByGufzBxnvXsFiwRI",
+	"lxhViUG6R3kn5cq8zOJKxj6CsPtrtQXasOL70sGKwd5uM2BjLfqrZoWAf3Id1DR65OYoZHKnWdyTlMND",
+	"hjpP/6mGZFQVBG4vYiVx4HbtO4cJ2HlWGQ1ut1CDx6SbrR0Tnl78OlTg76sEWxxoHBGec4EVtQD9F1Nx",
+	"47FpuY+eQ6ZB3/ZW7hL3XeAMM5nkbEVykcjwdO3FgsOLrGOe1d2OXoeI33eIeIaUK3q/XCB32T9OwPhi",
+	"T3k6Zty9qXIYUlngXShyPN2kJ4zbLvAgkdzNpZ11PPdf9AFNa4t3i+6u+34aK4yZ7TTXzu0+VcP27F1l",
+	"+umjDj5fWEYwU69GvSysvbQo9KC5mrnsQPTmhLd+OPpazfxbRKSvxcNF4tMX423TIer12FsNVe/BQ9cr",
+	"ZU8zeUps1wHsS92ceyrrGPY6MewWSf8mcuM6xH41IfaOFC4cZW8nWFqg/R2J7zrW/q8Sa++Iw48c6TbF",
+	"8f5iEffzeJQV/vSPW0qYEuM1q3sPsRzq70wPB6usA0Ey3mLnUdREhoIrOqLmZFS3v6cs5GP0JA0/2dmD",
+	"LtsyT5ptq715Hfau7Mav8GChMpPpSe452us+g4/yMKihsbvh2dlnGk1XWBZ6XV6wSTFoVb7XRaJpvBPN",
+	"DDjVv2z9qfDge+1StVlYyRiiOy349Hp58lIOM/3Z4SsWBI2wKdA/HmIF5Z/VkFCBAiyJvqpPjILvWguf",
+	"aYFpPwXBg5pFaq/yW8vCKiX8EdqHpY2TqdIFgjcI05sI0ZP/Z2cXSAn5hkdxRFoHrQGPMBtUyJ940Ej8",
+	"bK8bBjyuhgF5vGrYNKDQOMNi2zo4tJpRpA9y3UjgDrxj4fK0BZTF7jZmMgwID5plRbzUhD2JiGvYDE0n",
+	"Z5kOU/86Zza1MxiSTA2JsZRjLkLbTz/iA4koJBHoSU3rcdcPFL3ng4H+kDLXO0hPMRA4ICgmgvIQUYm4",
+	"hmSAWUAi2GWXuQ1sojMWED2/HdbOwWg6uYFkSRAuYCXX818fvMuo/pCzyUib2X39Ckx0wKEZ/8AusHML",
+	"3CNtlNGGHsrZ3QMtbVN3d3N1XGA7y3tMBpDH9kZrEHA3FEmLurmsvvGQsAIij2kU6TyHOBGDFZlDpv1f",
+	"a6NHdfaBw8FVNEjL4Q2VSJFRzAUWNJoga3axqeyukVof00j/VemhSi7SOi1hikaG/fPgRlNJ07xRPvZm",
+	"aYX87LRX8UJxVubjql4iddQb2y6EMmP+Bt+BdagEhrpFk1rxDW+I0oufmwnvPeQqt1bddsTurOvYqwb+",
+	"86xX+hM55ElkHGpqEtMA67bOQxzHhCHaLyLJT4+ryKa5+QUisewbMNKPnabquc2NKFreYzOzTr+3hw0o",
+	"yq2/nNbmDkB9SiLTHNNEaqwitOgOBKZ+jNEP2PR8ranOK924ELExSNeA3uS1VNv5rm7IS7lHWLXKqnW8",
+	"0CZKUCWzpmOyKgmiuiGdr0Z+aSM/TNTL44kYKd3lYoEjnokaMbkLEkc4aIpdxnYKfQnRKJGQVI/R2/OT",
+	"N210/vGNhvyb09ddBrNZF10prkLSP4hBUjoiTFLO5CY6BR0kEDyOTbgfRvJrggVpI0GkiwEEa4hUmIVY",
+	"5JpAwpTGAmL7Q2IJe3ppLY1iQKTKje+RgI/8R/fZQD7FEcdh4ZVUMe1REikaY6G2tLiw4fhyFd/O04Cy",
+	"bmaAbMhSu0bLxyITtzP72fhD8uUMdHWs1VPEBZC0VMgI+iOWWyrKlTDjD9Q0cNa7Thvg26vjwv5Hhuig",
+	"TAM+/gCqy/bDR4IYeFFpYKSlbMwQhoJflsNs7z/4poz8b2OSp2id2fPqOYxUXDgG47bUTJjRL3W6/WkN",
+	"SQYCc6qds9qYLz3ZuzMqKY5MFbMAIhb18FlxGq/MfLC7ReI0zK4e2n/5cXp9KGjzg1SkyQO9BmG3w+1Z",
+	"H2FQiLcQzFqonUlyIpoVHoOLXcTL18tjRg1iA2UFQJJKvI3CGYTFBjmXUck9PU5rEnj8eUHZO6TlXd0k",
+	"XIuNea9ayInhCLY1eM6yrVej0m2BhC9tmryVGp1QI7XLcIJiGxDDmVf2tEaaE72BI1jqocucwKKw/p0N",
+	"Rh/JuFQWAryWi3rkyjGXuSs3q0gbZF66+EditsmyICwwqCzgoMaIteusAozTSLOqapL28rLECoSZKUqS",
+	"+YnW3r1H7d2z1NQxAUM8DGFvbJyBmRBmhVnq8rUtyzSAVHtrch1qqDjThWUd+ZWy5At4o5UkEF2lQ7Qd",
+	"p8sM/rqhVZaf3BQIkEsiLNOaP+1CsF2X5Qgb4wqGAMU3cTHmkdjoGD0m4oOBpjOJ8nFCS99XyAmnNnBn",
+	"hngFF5DLTyyzsIf1ojT20Z7kMC+sdpeskuG2LREC/uXq/ELof56JPWoOuzqmptsWKKxxtDcpszWX3zQi",
+	"mCk6egQmEPt8lkDG7VNfgIyPEkXqm0L06NqGED14lh3kg55sbQV5xFYQuKG1DWRtAynZQEYZXtQgMU7l",
+	"qTSCFF17dvQsj3G1HUTroOhEi2tdNkNeMynQVsizl0ZlQfjUK/1DFtV/r5gHlNaF/q7E2OEWX1qssYYO",
+	"NMhvYOHwud7dVTqB63GFnzgVI8U48jXB0ZRNY131pqFRY205eLyWA3iJ5WSQpsKmUdzd1zU4AGBqHSkT",
+	"B4reQnKJ5AxH+l5NMV79fTVHKGdHZraCWxwlxGRJMkiNzBLvBpimKTJQXNaUgpuWU8/tbg5hM6ACy/uN",
+	"Pa5atU5Egh90P2o88qOQlfzouIjg5J+pMg5qTpV7ZawxXCDzu3FPHJ6foiCi+uhtI9FgibqtQ1udDCB3",
+	"gF7BVlE36XR2A5gI/pN0W5tdlr0fzqKJzvhiylWyAhFD41HAYxvEZIvljzDDg8LztFVENBBll3FhTWgW",
+	"fuhUSfNAIT9Mr5S+TtDVIc/V3JVX8jJ9xqbfyUoK63v2cXf/Ex6Rdh7SHH7BkWE3kzSoyLyXhy/B7zn0",
+	"ojTKX5z/cUmMCbthfMzMjSAu3DVYy1KMpVqHMNes4w4A8yHCopXdvZPVFk7m1nK/ILf8hsh88a1pQeQf",
+	"sopZIEsMJBrhkKSVETAEDer3T8LUrs6QTxQxG6gmd3O1Me+rEzDrI3p1Zlunx+tcqXpkM0ue4vm+gHCr",
+	"jyGO/JbfLPO5m1fQ7LnPsS3nqg96IQw2Zr3qvVWKLtCjP/X/6am/1y0fk/QiGqRxkFB8QM9xgPQsso16",
+	"lLfLYZJt9IVThkytVJtlnxrXu8yTow/djsaCK1uQZDowJp/ZDZocVZOc6kVZECUhpO2byyn1S/Iqdlyg",
+	"MRGkFIQ5ahd7JfrEwzdEnQNkHjJldGrFOuJQ8f7WqaP1NveRG2twVmzVPZyaTXgeX+WRhVNIZ5GBhWji",
+	"JwvKPAgryJ+DerMqoRUkz8Rm100yg8EzHAW2dE9FfiuDz/VJa0pQeihKmEWklUhJhe50ditAECWJNFTy",
+	"NSX3N44OP+ruVFBa8vry5P3rn9ZkpCEZcfjjEiryl7/aXFBWqC9qw1aadeA36LNkMoEUdw8z3eVSSUeV",
+	"YQyc21m0wswuj3asvq8J6nE1RGM8kWgDMULBRgUzSFKo0OcrWQScpJ37M7PV98wnXG9D5wPBPCO0AZOI",
+	"LCXS/dojakxsdRw15tY3i165O8YWtlCweE514leL0LVHQ9XWNO0eadrq6dZdqdaruTSrSrQwT22WbPEB",
+	"3xBZQTOQVDy2z7W4/WnBwoxqLlmY79YtGe7MpQuAXDGb9qFMQz5tprgPRm1nzm30QVj1zIdm95RCq9iS",
+	"OfvV8oc2KoDHsMg2YlyUf2jcrvn1Qu+49IpXyEqnYFXgpbuOl74+e//+7POPw0wfNtjl7Ifq5juH9a8i",
+	"ONsRZvcuUit1Hmx7G4fvL04Oj3+z2Hj68c0jqO91Z9r9ej7lni2u2KikWtHZ07stBmSb312QjK60bCJj",
+	"+sTUD+9NIKjHTZCvSw3WJKiSjNMAOZn1wNBDXWyAP4jmtTvNjxPr/ZgrdsNmY0GAbTpGXC9UHR2nHyLK",
+	"UB/fmns0q7bzER096G7So27bM/b82CLdDb7ViZhKMXNdWrxhqP2PVGF8rYIvOQcgM14BJvyY3oaUY9bm",
+	"sTnrov5UPjYOS9lgzWHXHPYxcth5mWRrNrtms2s262Gz8LGzYlm+82PxWZ0wWNepr8cu7tPXXzc3vOuv",
+	"HoVDHw6/9n09nKcgu/kVuwlGibqTjwAw5x48BOY5ukfyMN6BxFWzn+PHb6PxkAZD1wl4qmcYfG57G+l/",
+	"9wkJ5axiwZ+Y7ptsLLxatE+UFeA1qtxSSXsRcWJHlgRtqgNzGCSIPl+gjEcBfTC32sR7/2EBCvZI6Nea",
+	"ev2V5Zm7UagP8+hTlewwu5Nmpp/bLpqCKmXrv3hzQx9WKYdI4HotNe+/UeX230spT8H3N1LKa7fZBNis",
+	"NfG1Jr4Ox/cq7MvqAvqIbeJwNHHrX/6Y3JKIxyP9as2oVruViKh10NrCMW19/z091DQDsVxQIkEiILjK",
+	"IkcpN/zJr0RI/R/bP2WnKWH5r9ut7+36S0j/pCnc685lrtA7V9rGte5caXCwd7oj96t3xgseEZtZP3Kl",
+	"eUY8tMtUQDAcUQO437//3wEAgGpQDU/3AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
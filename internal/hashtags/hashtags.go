@@ -0,0 +1,91 @@
+// Package hashtags finds the hashtags of post content. A hashtag is a hash sign followed by
+// letters, combining marks, digits and underscores of any script, with at least one letter,
+// so that "#golang", "#日本語" and "#हिन्दी" are hashtags but "#1" is not. Hashtags are
+// normalized so that the same tag is found whatever its case or Unicode form.
+package hashtags
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength is the maximum number of characters of a hashtag, without its hash sign. Longer
+// hashtags are ignored.
+const MaxLength = 100
+
+const (
+	zeroWidthNonJoiner = '\u200c'
+	zeroWidthJoiner    = '\u200d'
+)
+
+// Extract returns the normalized hashtags of the content, without duplicates, in the order
+// they first appear. A hash sign only starts a hashtag at the start of a word, so that URL
+// fragments such as "page#section" and HTML entities such as "&#39;" are not hashtags.
+func Extract(content string) []string {
+	runes := []rune(content)
+	tags := []string{}
+	seen := map[string]bool{}
+
+	for i := 0; i < len(runes); i++ {
+		if !isHash(runes[i]) || (i > 0 && !startsWord(runes[i-1])) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isTagRune(runes[end]) {
+			end++
+		}
+
+		if tag, ok := Normalize(string(runes[i+1 : end])); ok && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+		i = end - 1
+	}
+
+	return tags
+}
+
+// Normalize returns the normalized form of a hashtag given with or without its hash sign:
+// compatibility characters such as full-width letters are replaced by their usual form, and
+// case is folded. It reports false when the text is not a valid hashtag.
+func Normalize(tag string) (string, bool) {
+	if r, size := utf8.DecodeRuneInString(tag); isHash(r) {
+		tag = tag[size:]
+	}
+
+	tag = norm.NFKC.String(cases.Fold().String(norm.NFKC.String(tag)))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxLength {
+		return "", false
+	}
+
+	hasLetter := false
+	for _, r := range tag {
+		if !isTagRune(r) {
+			return "", false
+		}
+		hasLetter = hasLetter || unicode.IsLetter(r)
+	}
+	if !hasLetter {
+		return "", false
+	}
+
+	return tag, true
+}
+
+func isHash(r rune) bool {
+	return r == '#' || r == '\uff03' // Full-width number sign
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_' ||
+		r == zeroWidthNonJoiner || r == zeroWidthJoiner
+}
+
+// startsWord reports whether a hash sign after the rune starts a word.
+func startsWord(previous rune) bool {
+	return !isTagRune(previous) && previous != '&'
+}
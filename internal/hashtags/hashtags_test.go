@@ -0,0 +1,47 @@
+package hashtags_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/floroz/go-social/internal/hashtags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		tags    []string
+	}{
+		{"Learning #Go today", []string{"go"}},
+		{"#go #Go #GO", []string{"go"}},
+		{"#rust, #go! (#zig)", []string{"rust", "go", "zig"}},
+		{"東京 #日本語 #東京", []string{"日本語", "東京"}},
+		{"नमस्ते #हिन्दी", []string{"हिन्दी"}},
+		{"#Straße and #STRASSE", []string{"strasse"}},
+		{"Full-width ＃ＧＯ", []string{"go"}},
+		{"#go_lang #2025goals", []string{"go_lang", "2025goals"}},
+		{"No tags here", []string{}},
+		{"#123 #_ # #", []string{}},
+		{"page#section &#39; example.com/#top", []string{"top"}},
+		{"##go", []string{"go"}},
+		{"#" + strings.Repeat("a", hashtags.MaxLength+1), []string{}},
+	} {
+		assert.Equal(t, tc.tags, hashtags.Extract(tc.content), tc.content)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tag, ok := hashtags.Normalize("#GoLang")
+	assert.True(t, ok)
+	assert.Equal(t, "golang", tag)
+
+	tag, ok = hashtags.Normalize("Café")
+	assert.True(t, ok)
+	assert.Equal(t, "café", tag)
+
+	for _, invalid := range []string{"", "#", "42", "go lang", "go-lang", "#" + strings.Repeat("a", hashtags.MaxLength+1)} {
+		_, ok := hashtags.Normalize(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
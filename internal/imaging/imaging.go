@@ -0,0 +1,59 @@
+// Package imaging decodes uploaded images and scales them into thumbnails using only the
+// standard library.
+package imaging
+
+import (
+	"image"
+	"image/color"
+
+	// Register the formats accepted for uploads with image.Decode.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// Thumbnail crops the centered square of the image and scales it to size x size pixels,
+// averaging the source pixels covered by each thumbnail pixel. Transparent areas become
+// white, since thumbnails are stored without an alpha channel.
+func Thumbnail(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	left := bounds.Min.X + (bounds.Dx()-side)/2
+	top := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if side == 0 {
+		return dst
+	}
+
+	for y := range size {
+		y0 := top + y*side/size
+		y1 := max(top+(y+1)*side/size, y0+1)
+
+		for x := range size {
+			x0 := left + x*side/size
+			x1 := max(left+(x+1)*side/size, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+
+			// The colors are premultiplied by alpha, so adding the missing alpha composes
+			// the pixel over white.
+			white := 0xffff - a/n
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r/n + white) >> 8),
+				G: uint8((g/n + white) >> 8),
+				B: uint8((b/n + white) >> 8),
+				A: 0xff,
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,55 @@
+package imaging_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/floroz/go-social/internal/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbnail_CropsCenterSquare(t *testing.T) {
+	// A 30x10 image: red, green and blue thirds
+	src := image.NewRGBA(image.Rect(0, 0, 30, 10))
+	for y := range 10 {
+		for x := range 30 {
+			c := color.RGBA{R: 0xff, A: 0xff}
+			if x >= 10 && x < 20 {
+				c = color.RGBA{G: 0xff, A: 0xff}
+			} else if x >= 20 {
+				c = color.RGBA{B: 0xff, A: 0xff}
+			}
+			src.SetRGBA(x, y, c)
+		}
+	}
+
+	thumbnail := imaging.Thumbnail(src, 4)
+
+	assert.Equal(t, image.Rect(0, 0, 4, 4), thumbnail.Bounds())
+	for y := range 4 {
+		for x := range 4 {
+			assert.Equal(t, color.RGBA{G: 0xff, A: 0xff}, thumbnail.RGBAAt(x, y))
+		}
+	}
+}
+
+func TestThumbnail_AveragesPixels(t *testing.T) {
+	// A 2x2 checkerboard of black and white averages to grey
+	src := image.NewGray(image.Rect(0, 0, 2, 2))
+	src.SetGray(0, 0, color.Gray{Y: 0xff})
+	src.SetGray(1, 1, color.Gray{Y: 0xff})
+
+	thumbnail := imaging.Thumbnail(src, 1)
+
+	assert.Equal(t, color.RGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, thumbnail.RGBAAt(0, 0))
+}
+
+func TestThumbnail_TransparentBecomesWhite(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+
+	thumbnail := imaging.Thumbnail(src, 16)
+
+	assert.Equal(t, image.Rect(0, 0, 16, 16), thumbnail.Bounds())
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, thumbnail.RGBAAt(15, 15))
+}
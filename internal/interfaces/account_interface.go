@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// AccountService changes the credentials of a user. Every change requires the current
+// password and logs the user out of their other sessions.
+type AccountService interface {
+	ChangePassword(ctx context.Context, userId int64, currentSessionId string, changePassword *domain.ChangePasswordDTO) error
+	RequestEmailChange(ctx context.Context, userId int64, changeEmail *domain.ChangeEmailDTO) error
+	ConfirmEmailChange(ctx context.Context, userId int64, currentSessionId string, confirm *domain.ConfirmEmailChangeDTO) (*domain.User, error)
+	ScheduleDeletion(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) (*domain.AccountDeletion, error)
+	PurgeDueAccounts(ctx context.Context) (int, error)
+}
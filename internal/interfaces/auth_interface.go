@@ -5,9 +5,20 @@ import (
 	"time"
 
 	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/jwtkeys"
 )
 
 type AuthService interface {
-	GenerateJWTToken(user *domain.User, expiration time.Duration) (string, error)
-	Login(ctx context.Context, loginUser *domain.LoginUserDTO) (*domain.User, error)
+	GenerateJWTToken(user *domain.User, sessionId string, expiration time.Duration) (string, error)
+	GenerateImpersonationToken(subject *domain.User, actor *domain.Actor, impersonationId string, expiration time.Duration) (string, error)
+	AuthenticateAccessToken(ctx context.Context, rawToken string) (*domain.UserClaims, error)
+	JWKS() jwtkeys.JSONWebKeySet
+	Login(ctx context.Context, loginUser *domain.LoginUserDTO, ipAddress string) (*domain.User, error)
+	StartSession(ctx context.Context, userId int64, client *domain.SessionClient, expiration time.Duration) (*domain.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, refreshToken string, expiration time.Duration) (*domain.User, *domain.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
+	ValidateSession(ctx context.Context, claims *domain.UserClaims) error
+	ListSessions(ctx context.Context, userId int64) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, userId int64, sessionId string) error
+	RevokeOtherSessions(ctx context.Context, userId int64, currentSessionId string) error
 }
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type AvatarService interface {
+	// Upload replaces the profile picture of the user with thumbnails of the image.
+	Upload(ctx context.Context, userId int64, image io.Reader) ([]domain.AvatarThumbnail, error)
+	Delete(ctx context.Context, userId int64) error
+	// DeleteAll deletes every stored thumbnail of the user, without updating the profile. It is
+	// used when purging an account.
+	DeleteAll(ctx context.Context, userId int64) error
+	// Open returns a stored thumbnail, by its key relative to the avatars directory.
+	Open(ctx context.Context, key string) (*domain.Blob, error)
+}
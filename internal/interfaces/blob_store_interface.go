@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// BlobStore stores files, such as profile pictures, under slash separated keys.
+type BlobStore interface {
+	// Put stores the body under the key, replacing any previous file.
+	Put(ctx context.Context, key string, body io.Reader) error
+	// Open returns domain.ErrNotFound when nothing is stored under the key.
+	Open(ctx context.Context, key string) (*domain.Blob, error)
+	// Delete removes the file stored under the key, if any.
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every file stored under the directory, e.g. "avatars/42/", if any.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
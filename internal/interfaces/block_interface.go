@@ -0,0 +1,39 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// BlockRepository stores blocks. A block applies both ways: neither user sees the other's
+// content, comments on their posts or follows them.
+type BlockRepository interface {
+	// Block is idempotent, and removes the follows between the two users.
+	Block(ctx context.Context, blockerId, blockedId int64) error
+	// Unblock returns domain.ErrNotFound when the user is not blocked.
+	Unblock(ctx context.Context, blockerId, blockedId int64) error
+	// IsBlocked reports whether either user blocked the other.
+	IsBlocked(ctx context.Context, userId, otherUserId int64) (bool, error)
+	// ListBlockedUserIDs lists the users who blocked the user or were blocked by them.
+	ListBlockedUserIDs(ctx context.Context, userId int64) ([]int64, error)
+	ListBlocked(ctx context.Context, blockerId int64, limit, offset int) ([]domain.BlockedUser, error)
+}
+
+// MuteRepository stores mutes. A mute only hides the muted user from the feeds of the muter.
+type MuteRepository interface {
+	// Mute is idempotent.
+	Mute(ctx context.Context, muterId, mutedId int64) error
+	// Unmute returns domain.ErrNotFound when the user is not muted.
+	Unmute(ctx context.Context, muterId, mutedId int64) error
+	ListMuted(ctx context.Context, muterId int64, limit, offset int) ([]domain.BlockedUser, error)
+}
+
+type BlockService interface {
+	Block(ctx context.Context, userId int64, username string) error
+	Unblock(ctx context.Context, userId int64, username string) error
+	ListBlocked(ctx context.Context, userId int64, limit, offset int) ([]domain.BlockedUser, error)
+	Mute(ctx context.Context, userId int64, username string) error
+	Unmute(ctx context.Context, userId int64, username string) error
+	ListMuted(ctx context.Context, userId int64, limit, offset int) ([]domain.BlockedUser, error)
+}
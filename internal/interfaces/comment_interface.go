@@ -10,14 +10,18 @@ type CommentRepository interface {
 	Create(ctx context.Context, userId, postId int64, comment *domain.CreateCommentDTO) (*domain.Comment, error)
 	Delete(ctx context.Context, userId, commentId int64) error
 	GetByID(ctx context.Context, id int64) (*domain.Comment, error)
-	ListByPostID(ctx context.Context, postId int64, limit int, offset int) ([]domain.Comment, error)
+	ListByPostID(ctx context.Context, postId int64, page domain.PageRequest) ([]domain.Comment, error)
 	Update(ctx context.Context, userId, postId int64, comment *domain.UpdateCommentDTO) (*domain.Comment, error)
 }
 
+// CommentService keeps users blocked either way from commenting on each other's posts and
+// from seeing each other's comments.
 type CommentService interface {
 	Create(ctx context.Context, userId, postId int64, comment *domain.CreateCommentDTO) (*domain.Comment, error)
 	Delete(ctx context.Context, userId, commentId int64) error
-	GetByID(ctx context.Context, id int64) (*domain.Comment, error)
-	ListByPostID(ctx context.Context, postId int64, limit int, offset int) ([]domain.Comment, error)
+	GetByID(ctx context.Context, viewerId, postId, id int64) (*domain.Comment, error)
+	// ListByPostID returns the page after the opaque cursor, or the page after skipping offset
+	// comments when the cursor is empty. Offsets are deprecated.
+	ListByPostID(ctx context.Context, viewerId, postId int64, cursor string, limit int, offset int) (*domain.CommentPage, error)
 	Update(ctx context.Context, userId, postId, commentId int64, comment *domain.UpdateCommentDTO) (*domain.Comment, error)
 }
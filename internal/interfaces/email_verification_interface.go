@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type EmailVerificationService interface {
+	SendVerificationEmail(ctx context.Context, user *domain.User) error
+	ResendVerificationEmail(ctx context.Context, userId int64) error
+	VerifyEmail(ctx context.Context, verifyEmail *domain.VerifyEmailDTO) error
+	RequireVerified(ctx context.Context, userId int64, action domain.VerifiedAction) error
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// TimelineRepository reads the home timelines of users. The timeline of a user holds their own
+// posts and the posts of the users they follow, without the posts of users blocked either way
+// or muted by them, newest first.
+type TimelineRepository interface {
+	// ListHome lists up to limit posts of the timeline after the cursor, or from the newest
+	// post when the cursor is nil.
+	ListHome(ctx context.Context, userId int64, after *domain.Cursor, limit int) ([]domain.Post, error)
+}
+
+type FeedService interface {
+	// Home returns a page of the home timeline of the user after the opaque cursor, or the
+	// first page when the cursor is empty.
+	Home(ctx context.Context, userId int64, cursor string, limit int) (*domain.PostPage, error)
+}
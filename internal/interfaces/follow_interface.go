@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type FollowRepository interface {
+	// Create returns domain.ErrAlreadyFollowing when the follow exists already.
+	Create(ctx context.Context, followerId, followeeId int64) error
+	// Delete returns domain.ErrNotFound when the follower does not follow the followee.
+	Delete(ctx context.Context, followerId, followeeId int64) error
+	ListFollowers(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error)
+	ListFollowing(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error)
+}
+
+type FollowService interface {
+	Follow(ctx context.Context, followerId int64, username string) error
+	Unfollow(ctx context.Context, followerId int64, username string) error
+	ListFollowers(ctx context.Context, username string, cursor string, limit, offset int) (*domain.FollowPage, error)
+	ListFollowing(ctx context.Context, username string, cursor string, limit, offset int) (*domain.FollowPage, error)
+}
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type ImpersonationSessionRepository interface {
+	Create(ctx context.Context, session *domain.ImpersonationSession) (*domain.ImpersonationSession, error)
+	GetByID(ctx context.Context, impersonationId string) (*domain.ImpersonationSession, error)
+	End(ctx context.Context, impersonationId string) error
+}
+
+type ImpersonationAuditLogRepository interface {
+	Create(ctx context.Context, entry *domain.ImpersonationAuditEntry) error
+	List(ctx context.Context, limit, offset int) ([]domain.ImpersonationAuditEntry, error)
+}
+
+// ImpersonationService lets admins act as another user. Access tokens of an impersonation
+// session are issued and validated by the AuthService; this service decides who may be
+// impersonated and keeps the audit trail.
+type ImpersonationService interface {
+	Start(ctx context.Context, actorId, subjectId int64, start *domain.StartImpersonationDTO) (*domain.ImpersonationSession, *domain.User, error)
+	End(ctx context.Context, actorId int64, impersonationId string) error
+	Record(ctx context.Context, entry *domain.ImpersonationAuditEntry) error
+	ListAuditLog(ctx context.Context, limit, offset int) ([]domain.ImpersonationAuditEntry, error)
+}
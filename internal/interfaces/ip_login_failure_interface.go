@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type IPLoginFailureRepository interface {
+	GetByIP(ctx context.Context, ipAddress string) (*domain.IPLoginFailure, error)
+	RecordFailure(ctx context.Context, ipAddress string, window time.Duration) (*domain.IPLoginFailure, error)
+	LockUntil(ctx context.Context, ipAddress string, lockedUntil time.Time) error
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type MagicLinkRequestRepository interface {
+	Record(ctx context.Context, email, ipAddress string) error
+	CountByEmailSince(ctx context.Context, email string, since time.Time) (int, error)
+	CountByIPSince(ctx context.Context, ipAddress string, since time.Time) (int, error)
+	DeleteCreatedBefore(ctx context.Context, before time.Time) error
+}
+
+type MagicLinkService interface {
+	RequestLink(ctx context.Context, request *domain.MagicLinkRequestDTO, ipAddress string) error
+	Redeem(ctx context.Context, login *domain.MagicLinkLoginDTO) (*domain.User, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, message *domain.EmailMessage) error
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type ModerationLogRepository interface {
+	Create(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error)
+	List(ctx context.Context, limit, offset int) ([]domain.ModerationLogEntry, error)
+}
+
+// Authorizer decides whether a user may act on content. Owners may always act on their own
+// content; other users need a role that grants the matching moderation permission, and
+// every such override is recorded in the moderation log once it is carried out.
+type Authorizer interface {
+	Authorize(ctx context.Context, actorId int64, action domain.ModerationAction, resource *domain.ModeratedResource) error
+	RecordOverride(ctx context.Context, actorId int64, action domain.ModerationAction, resource *domain.ModeratedResource) error
+}
+
+type AdminService interface {
+	UpdateUserRole(ctx context.Context, actorId, userId int64, updateRole *domain.UpdateUserRoleDTO) (*domain.User, error)
+	ListModerationLog(ctx context.Context, limit, offset int) ([]domain.ModerationLogEntry, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type PasswordResetService interface {
+	RequestReset(ctx context.Context, forgotPassword *domain.ForgotPasswordDTO) error
+	ResetPassword(ctx context.Context, resetPassword *domain.ResetPasswordDTO) error
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *domain.PersonalAccessToken) (*domain.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)
+	ListActiveByUserID(ctx context.Context, userId int64) ([]domain.PersonalAccessToken, error)
+	Touch(ctx context.Context, tokenId int64) error
+	Revoke(ctx context.Context, userId int64, tokenId int64) error
+	RevokeAllForUser(ctx context.Context, userId int64) error
+}
+
+type PersonalAccessTokenService interface {
+	Create(ctx context.Context, userId int64, createToken *domain.CreatePersonalAccessTokenDTO) (*domain.PersonalAccessToken, error)
+	List(ctx context.Context, userId int64) ([]domain.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userId int64, tokenId int64) error
+	Authenticate(ctx context.Context, rawToken string) (*domain.UserClaims, error)
+}
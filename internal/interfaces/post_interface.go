@@ -6,18 +6,37 @@ import (
 	"github.com/floroz/go-social/internal/domain"
 )
 
+// PostRepository links posts to the tags of their content in the transaction creating or
+// updating them.
 type PostRepository interface {
 	Create(ctx context.Context, userId int64, post *domain.CreatePostDTO) (*domain.Post, error)
-	List(ctx context.Context, limit int, offset int) ([]domain.Post, error)
+	// List leaves out the posts of users blocked either way or muted by the viewer.
+	List(ctx context.Context, viewerId int64, page domain.PageRequest) ([]domain.Post, error)
+	ListByUserID(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.Post, error)
 	GetByID(ctx context.Context, postId int64) (*domain.Post, error)
 	Update(ctx context.Context, userId, postId int64, post *domain.UpdatePostDTO) (*domain.Post, error)
 	Delete(ctx context.Context, userId, postId int64) error
+	// CreateRepost returns domain.ErrAlreadyReposted when the user reposted the post already.
+	CreateRepost(ctx context.Context, userId, postId int64) (*domain.Post, error)
+	// DeleteRepost returns domain.ErrNotFound when the user did not repost the post.
+	DeleteRepost(ctx context.Context, userId, postId int64) error
+	// ListByIDs leaves out the posts that do not exist or were deleted.
+	ListByIDs(ctx context.Context, postIds []int64) ([]domain.Post, error)
 }
 
+// PostService hides posts from users blocked either way: they are not listed and cannot be
+// read. Posts of muted users are only left out of List. Reposts and quote posts embed the post
+// they share, which is left out when it was deleted or is hidden from the viewer.
 type PostService interface {
 	Create(ctx context.Context, userId int64, createPost *domain.CreatePostDTO) (*domain.Post, error)
-	List(ctx context.Context, limit int, offset int) ([]domain.Post, error)
-	GetByID(ctx context.Context, postId int64) (*domain.Post, error)
+	// List returns the page after the opaque cursor, or the page after skipping offset posts
+	// when the cursor is empty. Offsets are deprecated.
+	List(ctx context.Context, viewerId int64, cursor string, limit int, offset int) (*domain.PostPage, error)
+	ListByUserID(ctx context.Context, viewerId, userId int64, cursor string, limit int, offset int) (*domain.PostPage, error)
+	GetByID(ctx context.Context, viewerId, postId int64) (*domain.Post, error)
 	Update(ctx context.Context, userId, postId int64, post *domain.UpdatePostDTO) (*domain.Post, error)
 	Delete(ctx context.Context, userId, postId int64) error
+	// Repost shares a post; reposting a repost shares the post it shares.
+	Repost(ctx context.Context, userId, postId int64) (*domain.Post, error)
+	Unrepost(ctx context.Context, userId, postId int64) error
 }
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// ReactionRepository stores the reactions of users on posts and comments, and their counts.
+type ReactionRepository interface {
+	// Add is idempotent: reacting twice with the same type leaves a single reaction.
+	Add(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error
+	// Remove returns domain.ErrNotFound when the user did not react with the type.
+	Remove(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error
+	// Summarize returns the reactions on targets of one type by target id, with those of the
+	// viewer. Targets without reactions are left out.
+	Summarize(ctx context.Context, viewerId int64, targetType domain.ReactionTargetType, targetIds []int64) (map[int64]domain.Reactions, error)
+}
+
+// ReactionService lets users react to posts and comments with the types of the configured
+// reaction set. Users blocked either way cannot react to each other's posts and comments.
+type ReactionService interface {
+	AddPostReaction(ctx context.Context, userId, postId int64, reactionType string) error
+	RemovePostReaction(ctx context.Context, userId, postId int64, reactionType string) error
+	AddCommentReaction(ctx context.Context, userId, postId, commentId int64, reactionType string) error
+	RemoveCommentReaction(ctx context.Context, userId, postId, commentId int64, reactionType string) error
+}
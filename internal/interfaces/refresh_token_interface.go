@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, userId int64, familyId, tokenHash string, expiresAt time.Time) (*domain.RefreshToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	Revoke(ctx context.Context, tokenId int64) error
+	RevokeFamily(ctx context.Context, familyId string) error
+	RevokeAllForUser(ctx context.Context, userId int64) error
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, sessionId string, userId int64, client *domain.SessionClient) (*domain.Session, error)
+	GetByID(ctx context.Context, sessionId string) (*domain.Session, error)
+	ListActiveByUserID(ctx context.Context, userId int64) ([]domain.Session, error)
+	Touch(ctx context.Context, sessionId string) error
+	Revoke(ctx context.Context, userId int64, sessionId string) error
+	RevokeAllForUser(ctx context.Context, userId int64, exceptSessionId string) error
+}
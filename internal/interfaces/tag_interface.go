@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// TagRepository reads posts by the normalized hashtags of their content, which the
+// PostRepository links them to.
+type TagRepository interface {
+	// ListPosts lists the posts with the tag, newest first, leaving out deleted posts and the
+	// posts of users blocked either way or muted by the viewer.
+	ListPosts(ctx context.Context, viewerId int64, tag string, page domain.PageRequest) ([]domain.Post, error)
+	// ListTrending lists up to limit tags by the number of posts created since the given time
+	// that use them, most used first.
+	ListTrending(ctx context.Context, since time.Time, limit int) ([]domain.TrendingTag, error)
+}
+
+type TagService interface {
+	// ListPosts returns a page of the posts with the tag, given with or without its hash sign,
+	// after the opaque cursor, or the first page when the cursor is empty.
+	ListPosts(ctx context.Context, viewerId int64, tag string, cursor string, limit int) (*domain.PostPage, error)
+	// Trending returns the tags used by the most posts within the trending window.
+	Trending(ctx context.Context, limit int) ([]domain.TrendingTag, error)
+}
@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type TOTPRepository interface {
+	GetByUserID(ctx context.Context, userId int64) (*domain.UserTOTP, error)
+	UpsertPending(ctx context.Context, userId int64, secret string) (*domain.UserTOTP, error)
+	Confirm(ctx context.Context, userId int64, step int64) error
+	UseStep(ctx context.Context, userId int64, step int64) error
+	IncrementFailedAttempts(ctx context.Context, userId int64) (int, error)
+	ResetFailedAttempts(ctx context.Context, userId int64) error
+	Delete(ctx context.Context, userId int64) error
+}
+
+type RecoveryCodeRepository interface {
+	ReplaceAll(ctx context.Context, userId int64, codeHashes []string) error
+	Consume(ctx context.Context, userId int64, codeHash string) error
+	CountUnused(ctx context.Context, userId int64) (int, error)
+	DeleteAll(ctx context.Context, userId int64) error
+}
+
+type TwoFactorService interface {
+	GetStatus(ctx context.Context, userId int64) (*domain.TwoFactorStatus, error)
+	IsEnabled(ctx context.Context, userId int64) (bool, error)
+	Enroll(ctx context.Context, userId int64) (*domain.TOTPEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, userId int64, confirm *domain.ConfirmTOTPDTO) ([]string, error)
+	Disable(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) error
+	RegenerateRecoveryCodes(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) ([]string, error)
+	StartChallenge(ctx context.Context, userId int64) (*domain.MFAChallenge, error)
+	VerifyChallenge(ctx context.Context, verify *domain.VerifyMFAChallengeDTO) (*domain.User, error)
+}
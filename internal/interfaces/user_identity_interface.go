@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/oidc"
+)
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *domain.UserIdentity) (*domain.UserIdentity, error)
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+	Touch(ctx context.Context, identityId int64) error
+}
+
+type OIDCLoginStateRepository interface {
+	Create(ctx context.Context, state *domain.OIDCLoginState) error
+	Consume(ctx context.Context, stateHash string) (*domain.OIDCLoginState, error)
+}
+
+// OIDCProvider is an external OpenID Connect provider, see oidc.Provider.
+type OIDCProvider interface {
+	Name() string
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	Authenticate(ctx context.Context, code, codeVerifier, nonce string) (*oidc.IDToken, error)
+}
+
+type OIDCService interface {
+	Providers() []string
+	StartLogin(ctx context.Context, provider string) (*domain.OIDCLogin, error)
+	CompleteLogin(ctx context.Context, provider, state, code string) (*domain.User, error)
+}
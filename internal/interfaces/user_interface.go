@@ -2,26 +2,46 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/floroz/go-social/internal/domain"
 )
 
 type UserRepository interface {
 	Create(ctx context.Context, createUser *domain.CreateUserDTO) (*domain.User, error)
+	CreateExternal(ctx context.Context, createUser *domain.EditableUserField) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetByUsername(ctx context.Context, username string) (*domain.User, error)
+	GetProfileByUsername(ctx context.Context, username string) (*domain.PublicProfile, error)
 	Update(ctx context.Context, userId int64, updateUser *domain.UpdateUserDTO) (*domain.User, error)
 	GetByID(ctx context.Context, userId int64) (*domain.User, error)
 	Delete(ctx context.Context, userId int64) error
-	List(ctx context.Context, limit, offset int) ([]domain.User, error)
+	List(ctx context.Context, page domain.PageRequest) ([]domain.User, error)
+	UpdateProfilePicture(ctx context.Context, userId int64, url string) (string, error)
 	UpdateLastLogin(ctx context.Context, userId int64) error
+	UpdatePassword(ctx context.Context, userId int64, hashedPassword string) error
+	MarkEmailVerified(ctx context.Context, userId int64) error
+	IncrementFailedLogins(ctx context.Context, userId int64) (int, error)
+	LockUntil(ctx context.Context, userId int64, lockedUntil time.Time) error
+	ResetFailedLogins(ctx context.Context, userId int64) error
+	UpdateRole(ctx context.Context, userId int64, role domain.Role) error
+	SetPendingEmail(ctx context.Context, userId int64, email string) error
+	ConfirmPendingEmail(ctx context.Context, userId int64) (*domain.User, error)
+	ScheduleDeletion(ctx context.Context, userId int64, purgeAt time.Time) error
+	CancelDeletion(ctx context.Context, userId int64) (bool, error)
+	ListDueForDeletion(ctx context.Context, limit int) ([]int64, error)
+	Purge(ctx context.Context, userId int64) error
 }
 
 type UserService interface {
 	Create(ctx context.Context, createUser *domain.CreateUserDTO) (*domain.User, error)
 	Update(ctx context.Context, userId int64, updateUser *domain.UpdateUserDTO) (*domain.User, error)
 	GetByID(ctx context.Context, userId int64) (*domain.User, error)
+	// GetProfile returns domain.NotFoundError when either user blocked the other.
+	GetProfile(ctx context.Context, viewerId int64, username string) (*domain.PublicProfile, error)
 	Delete(ctx context.Context, userId int64) error
-	List(ctx context.Context, limit, offset int) ([]domain.User, error)
+	// List returns the page after the opaque cursor, or the page after skipping offset users
+	// when the cursor is empty. Offsets are deprecated.
+	List(ctx context.Context, cursor string, limit, offset int) (*domain.UserPage, error)
 	UpdateLastLogin(ctx context.Context, userId int64) error
 }
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+type UserTokenRepository interface {
+	Create(ctx context.Context, userId int64, purpose domain.TokenPurpose, tokenHash string, expiresAt time.Time) (*domain.UserToken, error)
+	Consume(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error)
+	GetActive(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error)
+	InvalidateAll(ctx context.Context, userId int64, purpose domain.TokenPurpose) error
+	CountCreatedSince(ctx context.Context, userId int64, purpose domain.TokenPurpose, since time.Time) (int, error)
+}
@@ -0,0 +1,189 @@
+// Package jwtkeys signs and verifies access tokens with asymmetric keys. A keyring holds the
+// active key, used to sign new tokens, and previous keys that still verify the tokens signed
+// before a rotation. Tokens name their key in the "kid" header.
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	minRSAKeyBits = 2048
+)
+
+var (
+	ErrUnknownKey     = errors.New("jwtkeys: unknown key id")
+	ErrNotConfigured  = errors.New("jwtkeys: no signing key configured")
+	ErrUnsupportedKey = errors.New("jwtkeys: unsupported key type")
+)
+
+// Key is a signing key, or a verification-only key when it has no private part.
+type Key struct {
+	ID         string
+	Algorithm  string
+	privateKey crypto.Signer
+	publicKey  crypto.PublicKey
+}
+
+// CanSign reports whether the key has a private part.
+func (k *Key) CanSign() bool {
+	return k.privateKey != nil
+}
+
+func (k *Key) signingMethod() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmEdDSA {
+		return SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// ParseKey reads a PEM encoded private key (PKCS #8, or PKCS #1 for RSA) or public key
+// (PKIX). The algorithm follows from the key type: RS256 for RSA, EdDSA for Ed25519.
+func ParseKey(id string, pemBytes []byte) (*Key, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("jwtkeys: key %q is not PEM encoded", id)
+	}
+
+	return parseBlock(id, block)
+}
+
+func parseBlock(id string, block *pem.Block) (*Key, error) {
+	var parsed any
+	var err error
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("jwtkeys: key %q: unexpected PEM block %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwtkeys: key %q: %w", id, err)
+	}
+
+	key := &Key{ID: id}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm, key.privateKey, key.publicKey = AlgorithmRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Algorithm, key.publicKey = AlgorithmRS256, k
+	case ed25519.PrivateKey:
+		key.Algorithm, key.privateKey, key.publicKey = AlgorithmEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Algorithm, key.publicKey = AlgorithmEdDSA, k
+	default:
+		return nil, fmt.Errorf("%w: key %q is a %T", ErrUnsupportedKey, id, parsed)
+	}
+
+	if rsaKey, ok := key.publicKey.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("jwtkeys: key %q: RSA keys must have at least %d bits", id, minRSAKeyBits)
+	}
+
+	return key, nil
+}
+
+// GenerateEd25519 creates a new Ed25519 signing key.
+func GenerateEd25519(id string) (*Key, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("jwtkeys: generate key: %w", err)
+	}
+
+	return &Key{ID: id, Algorithm: AlgorithmEdDSA, privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+type Keyring struct {
+	active *Key
+	// keys holds every key that verifies tokens, the active one first.
+	keys []*Key
+}
+
+// NewKeyring builds a keyring that signs with the active key and verifies with it and the
+// previous keys.
+func NewKeyring(active *Key, previous ...*Key) (*Keyring, error) {
+	if active == nil || !active.CanSign() {
+		return nil, ErrNotConfigured
+	}
+
+	keyring := &Keyring{active: active, keys: []*Key{active}}
+	seen := map[string]bool{active.ID: true}
+
+	for _, key := range previous {
+		if seen[key.ID] {
+			return nil, fmt.Errorf("jwtkeys: duplicate key id %q", key.ID)
+		}
+		seen[key.ID] = true
+		keyring.keys = append(keyring.keys, key)
+	}
+
+	return keyring, nil
+}
+
+// ActiveKeyID returns the id of the key that signs new tokens.
+func (k *Keyring) ActiveKeyID() string {
+	return k.active.ID
+}
+
+// Sign signs the claims with the active key.
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.active.signingMethod(), claims)
+	token.Header["kid"] = k.active.ID
+
+	return token.SignedString(k.active.privateKey)
+}
+
+// Parse verifies the token with the key named by its kid header and decodes its claims.
+// The algorithm of the token must be the one of the key.
+func (k *Keyring) Parse(rawToken string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key := k.find(kid)
+		if key == nil {
+			return nil, ErrUnknownKey
+		}
+
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("jwtkeys: unexpected signing method %q for key %q", token.Method.Alg(), kid)
+		}
+
+		return key.publicKey, nil
+	})
+}
+
+func (k *Keyring) find(kid string) *Key {
+	for _, key := range k.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+	return nil
+}
+
+// NewEphemeralKeyring builds a keyring around a freshly generated key, for programs that
+// never hand out tokens beyond their own lifetime, such as tests and the seeder.
+func NewEphemeralKeyring() (*Keyring, error) {
+	key, err := GenerateEd25519("ephemeral")
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyring(key)
+}
@@ -0,0 +1,212 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClaims() *jwt.StandardClaims {
+	return &jwt.StandardClaims{Subject: "42", ExpiresAt: time.Now().Add(time.Minute).Unix()}
+}
+
+func privatePEM(t *testing.T, privateKey any) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPEM(t *testing.T, publicKey any) []byte {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestKeyring_SignAndParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	for name, privateKey := range map[string]any{AlgorithmRS256: rsaKey, AlgorithmEdDSA: edKey} {
+		t.Run(name, func(t *testing.T) {
+			key, err := ParseKey("k1", privatePEM(t, privateKey))
+			assert.NoError(t, err)
+			assert.Equal(t, name, key.Algorithm)
+
+			keyring, err := NewKeyring(key)
+			assert.NoError(t, err)
+
+			// Act
+			signed, err := keyring.Sign(newClaims())
+			assert.NoError(t, err)
+
+			claims := &jwt.StandardClaims{}
+			token, err := keyring.Parse(signed, claims)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, token.Valid)
+			assert.Equal(t, "k1", token.Header["kid"])
+			assert.Equal(t, name, token.Header["alg"])
+			assert.Equal(t, "42", claims.Subject)
+		})
+	}
+}
+
+func TestKeyring_PreviousKeysStillVerify(t *testing.T) {
+	oldKey, err := GenerateEd25519("old")
+	assert.NoError(t, err)
+	newKey, err := GenerateEd25519("new")
+	assert.NoError(t, err)
+
+	oldKeyring, err := NewKeyring(oldKey)
+	assert.NoError(t, err)
+	signedBeforeRotation, err := oldKeyring.Sign(newClaims())
+	assert.NoError(t, err)
+
+	// Act: Rotate to a new key, keeping the old one for verification
+	rotated, err := NewKeyring(newKey, oldKey)
+	assert.NoError(t, err)
+	_, errAfterRotation := rotated.Parse(signedBeforeRotation, &jwt.StandardClaims{})
+
+	// Once the old key is dropped, its tokens are rejected
+	dropped, err := NewKeyring(newKey)
+	assert.NoError(t, err)
+	_, errAfterDrop := dropped.Parse(signedBeforeRotation, &jwt.StandardClaims{})
+
+	// Assert
+	assert.NoError(t, errAfterRotation)
+	assert.Error(t, errAfterDrop)
+	assert.Equal(t, "new", rotated.ActiveKeyID())
+}
+
+func TestKeyring_RejectsAlgorithmMismatchAndHMAC(t *testing.T) {
+	key, err := GenerateEd25519("k1")
+	assert.NoError(t, err)
+	keyring, err := NewKeyring(key)
+	assert.NoError(t, err)
+
+	// A token signed with HS256 using the public key as the secret must not verify.
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims())
+	token.Header["kid"] = "k1"
+	forged, err := token.SignedString([]byte(key.publicKey.(ed25519.PublicKey)))
+	assert.NoError(t, err)
+
+	// Act
+	_, err = keyring.Parse(forged, &jwt.StandardClaims{})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestNewKeyring_RequiresPrivateActiveKey(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	publicOnly, err := ParseKey("public", publicPEM(t, edKey.Public()))
+	assert.NoError(t, err)
+
+	// Act
+	_, err = NewKeyring(publicOnly)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotConfigured)
+}
+
+func TestParseKey_RejectsShortRSAKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NoError(t, err)
+
+	// Act
+	_, err = ParseKey("short", privatePEM(t, rsaKey))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestLoadDir_ActiveKeyIsTheLastPrivateKey(t *testing.T) {
+	dir := t.TempDir()
+	var publicKeys []ed25519.PublicKey
+	for _, id := range []string{"2024-01", "2024-06"} {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		publicKeys = append(publicKeys, publicKey)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, id+".pem"), privatePEM(t, privateKey), 0o600))
+	}
+	// A verification-only key of a retired signer sorts last but cannot be active.
+	retiredPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "2025-retired.pem"), publicPEM(t, retiredPublicKey), 0o600))
+
+	// Act
+	keyring, err := LoadDir(dir, "")
+	assert.NoError(t, err)
+	explicit, err := LoadDir(dir, "2024-01")
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "2024-06", keyring.ActiveKeyID())
+	assert.Len(t, keyring.JWKS().Keys, 3)
+	assert.Equal(t, "2024-01", explicit.ActiveKeyID())
+	assert.Len(t, explicit.JWKS().Keys, 3)
+}
+
+func TestLoadPEM_IdentifiesKeysByThumbprint(t *testing.T) {
+	_, first, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, second, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	pemBytes := append(privatePEM(t, first), privatePEM(t, second)...)
+
+	// Act
+	keyring, err := LoadPEM(pemBytes, "")
+
+	// Assert
+	assert.NoError(t, err)
+	jwks := keyring.JWKS()
+	if assert.Len(t, jwks.Keys, 2) {
+		assert.Equal(t, keyring.ActiveKeyID(), jwks.Keys[0].KeyID)
+		assert.Len(t, jwks.Keys[0].KeyID, 43, "Expected a base64url SHA-256 thumbprint")
+		assert.NotEqual(t, jwks.Keys[0].KeyID, jwks.Keys[1].KeyID)
+	}
+}
+
+func TestThumbprint_RFC7638Example(t *testing.T) {
+	// The RSA key of RFC 7638, section 3.1.
+	nBytes, err := jwt.DecodeSegment("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	assert.NoError(t, err)
+	n := new(big.Int).SetBytes(nBytes)
+
+	key := &Key{Algorithm: AlgorithmRS256, publicKey: &rsa.PublicKey{N: n, E: 65537}}
+
+	// Act & Assert
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", key.thumbprint())
+}
+
+func TestJSONWebKey_PublicKeyRoundTrip(t *testing.T) {
+	// Arrange
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ed25519Key, err := GenerateEd25519("ed")
+	assert.NoError(t, err)
+
+	for _, key := range []*Key{{ID: "rsa", Algorithm: AlgorithmRS256, publicKey: &rsaKey.PublicKey}, ed25519Key} {
+		// Act
+		publicKey, err := key.jwk().PublicKey()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, key.publicKey, publicKey, "Unexpected public key for %s", key.ID)
+	}
+}
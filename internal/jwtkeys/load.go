@@ -0,0 +1,121 @@
+package jwtkeys
+
+import (
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/floroz/go-social/internal/env"
+)
+
+// LoadFromEnv builds the keyring from JWT_KEYS_DIR, or from the PEM blocks in
+// JWT_SIGNING_KEYS when no directory is set. JWT_ACTIVE_KEY_ID selects the signing key.
+func LoadFromEnv() (*Keyring, error) {
+	activeKeyId := env.GetEnvValue("JWT_ACTIVE_KEY_ID")
+
+	if dir := env.GetEnvValue("JWT_KEYS_DIR"); dir != "" {
+		return LoadDir(dir, activeKeyId)
+	}
+
+	if pemBytes := env.GetEnvValue("JWT_SIGNING_KEYS"); pemBytes != "" {
+		return LoadPEM([]byte(pemBytes), activeKeyId)
+	}
+
+	return nil, ErrNotConfigured
+}
+
+// LoadDir loads every "<kid>.pem" file of the directory. Public keys only verify tokens. The
+// active key is the private key named activeKeyId or, when empty, the private key whose id
+// sorts last, so that naming keys by creation date rotates to the newest one.
+func LoadDir(dir, activeKeyId string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("jwtkeys: list %s: %w", dir, err)
+	}
+	sort.Strings(paths)
+
+	keys := make([]*Key, 0, len(paths))
+	for _, path := range paths {
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("jwtkeys: read %s: %w", path, err)
+		}
+
+		key, err := ParseKey(strings.TrimSuffix(filepath.Base(path), ".pem"), pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return newKeyringWithActive(keys, activeKeyId)
+}
+
+// LoadPEM loads keys from concatenated PEM blocks. Each key is identified by its JWK
+// thumbprint. The active key is the private key named activeKeyId or, when empty, the first
+// private key.
+func LoadPEM(pemBytes []byte, activeKeyId string) (*Keyring, error) {
+	var keys []*Key
+
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+
+		key, err := parseBlock(fmt.Sprintf("#%d", len(keys)), block)
+		if err != nil {
+			return nil, err
+		}
+		key.ID = key.thumbprint()
+		keys = append(keys, key)
+	}
+
+	if activeKeyId == "" {
+		for _, key := range keys {
+			if key.CanSign() {
+				activeKeyId = key.ID
+				break
+			}
+		}
+	}
+
+	return newKeyringWithActive(keys, activeKeyId)
+}
+
+func newKeyringWithActive(keys []*Key, activeKeyId string) (*Keyring, error) {
+	var active *Key
+	previous := make([]*Key, 0, len(keys))
+
+	for _, key := range keys {
+		if activeKeyId == "" && key.CanSign() {
+			// Keys are sorted by id: the last private key wins.
+			if active != nil {
+				previous = append(previous, active)
+			}
+			active = key
+			continue
+		}
+		if key.ID == activeKeyId {
+			active = key
+			continue
+		}
+		previous = append(previous, key)
+	}
+
+	if active == nil {
+		if activeKeyId != "" {
+			return nil, fmt.Errorf("%w: active key %q not found", ErrNotConfigured, activeKeyId)
+		}
+		return nil, ErrNotConfigured
+	}
+	if !active.CanSign() {
+		return nil, fmt.Errorf("jwtkeys: active key %q has no private key", active.ID)
+	}
+
+	return NewKeyring(active, previous...)
+}
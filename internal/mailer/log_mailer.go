@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/rs/zerolog/log"
+)
+
+// LogMailer does not deliver emails. It logs them and, when a file path is set,
+// appends each message as a JSON line so that tests can read them back.
+type LogMailer struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewLogMailer(filePath string) *LogMailer {
+	return &LogMailer{filePath: filePath}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message *domain.EmailMessage) error {
+	// Checked here too, so that messages the SMTP mailer would reject fail in development
+	if err := checkMessage(message); err != nil {
+		return err
+	}
+
+	log.Info().Str("to", message.To).Str("subject", message.Subject).Msg("email sent")
+
+	if m.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("could not encode email: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open mail log file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("could not write mail log file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultFromAddress = "no-reply@go-social.local"
+
+// ErrInvalidHeader is returned for header values with line breaks, which would let them
+// inject other headers into the email.
+var ErrInvalidHeader = errors.New("invalid email header")
+
+// NewFromEnv builds the mailer selected by MAIL_DRIVER ("log" or "smtp").
+// The log mailer is used when the driver is unset, so development works without an SMTP server.
+func NewFromEnv() (interfaces.Mailer, error) {
+	from := env.GetEnvValue("MAIL_FROM")
+	if from == "" {
+		from = defaultFromAddress
+	}
+
+	switch driver := env.GetEnvValue("MAIL_DRIVER"); driver {
+	case "smtp":
+		return NewSMTPMailer(&SMTPConfig{
+			Host:     env.GetEnvValue("SMTP_HOST"),
+			Port:     env.GetEnvValue("SMTP_PORT"),
+			Username: env.GetEnvValue("SMTP_USERNAME"),
+			Password: env.GetEnvValue("SMTP_PASSWORD"),
+			From:     from,
+		})
+	case "", "log":
+		return NewLogMailer(env.GetEnvValue("MAIL_LOG_FILE")), nil
+	default:
+		log.Warn().Msgf("unknown MAIL_DRIVER %q, falling back to log mailer", driver)
+		return NewLogMailer(env.GetEnvValue("MAIL_LOG_FILE")), nil
+	}
+}
+
+// checkMessage rejects messages whose header values contain line breaks.
+func checkMessage(message *domain.EmailMessage) error {
+	if err := checkHeaderValue("To", message.To); err != nil {
+		return err
+	}
+	return checkHeaderValue("Subject", message.Subject)
+}
+
+func checkHeaderValue(name, value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("%w: %s contains a line break", ErrInvalidHeader, name)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func validSMTPConfig() *SMTPConfig {
+	return &SMTPConfig{Host: "smtp.example.com", Port: "587", From: "no-reply@example.com"}
+}
+
+func TestNewSMTPMailer_Config(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(config *SMTPConfig)
+		wantErr string
+	}{
+		{name: "valid", change: func(config *SMTPConfig) {}},
+		{name: "named from address", change: func(config *SMTPConfig) { config.From = "Go Social <no-reply@example.com>" }},
+		{name: "missing host", change: func(config *SMTPConfig) { config.Host = "" }, wantErr: "smtp host is required"},
+		{name: "missing port", change: func(config *SMTPConfig) { config.Port = "" }, wantErr: `invalid smtp port ""`},
+		{name: "port not a number", change: func(config *SMTPConfig) { config.Port = "smtp" }, wantErr: `invalid smtp port "smtp"`},
+		{name: "port out of range", change: func(config *SMTPConfig) { config.Port = "70000" }, wantErr: `invalid smtp port "70000"`},
+		{name: "missing from address", change: func(config *SMTPConfig) { config.From = "" }, wantErr: `invalid from address ""`},
+		{name: "invalid from address", change: func(config *SMTPConfig) { config.From = "no-reply" }, wantErr: `invalid from address "no-reply"`},
+		{name: "from address with a line break", change: func(config *SMTPConfig) { config.From = "no-reply@example.com\r\nBcc: spy@example.com" }, wantErr: "invalid email header: From contains a line break"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := validSMTPConfig()
+			tt.change(config)
+
+			mailer, err := NewSMTPMailer(config)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.NotNil(t, mailer)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, mailer)
+		})
+	}
+}
+
+func TestSMTPMailer_BuildMessage(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	headers := "From: no-reply@example.com\r\n" +
+		"To: jane@example.com\r\n" +
+		"Subject: Reset your password\r\n" +
+		"Date: Tue, 05 Mar 2024 14:30:00 +0000\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n"
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "single line", body: "Hello", want: headers + "Hello"},
+		{name: "LF line endings", body: "Hi Jane,\n\nClick the link.\n", want: headers + "Hi Jane,\r\n\r\nClick the link.\r\n"},
+		{name: "CRLF line endings", body: "Hi Jane,\r\nClick the link.", want: headers + "Hi Jane,\r\nClick the link."},
+		{name: "empty body", body: "", want: headers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer, err := NewSMTPMailer(validSMTPConfig())
+			assert.NoError(t, err)
+
+			message := mailer.buildMessage(&domain.EmailMessage{To: "jane@example.com", Subject: "Reset your password", Body: tt.body}, date)
+
+			assert.Equal(t, tt.want, string(message))
+		})
+	}
+}
+
+func TestSend_RejectsLineBreaksInHeaders(t *testing.T) {
+	smtpMailer, err := NewSMTPMailer(validSMTPConfig())
+	assert.NoError(t, err)
+	logFile := filepath.Join(t.TempDir(), "mail.log")
+	mailers := map[string]interfaces.Mailer{
+		"smtp": smtpMailer,
+		"log":  NewLogMailer(logFile),
+	}
+
+	tests := []struct {
+		name    string
+		message *domain.EmailMessage
+		wantErr string
+	}{
+		{
+			name:    "CRLF in recipient",
+			message: &domain.EmailMessage{To: "jane@example.com\r\nBcc: spy@example.com", Subject: "Hello", Body: "Hi"},
+			wantErr: "invalid email header: To contains a line break",
+		},
+		{
+			name:    "LF in recipient",
+			message: &domain.EmailMessage{To: "jane@example.com\nBcc: spy@example.com", Subject: "Hello", Body: "Hi"},
+			wantErr: "invalid email header: To contains a line break",
+		},
+		{
+			name:    "CR in subject",
+			message: &domain.EmailMessage{To: "jane@example.com", Subject: "Hello\rBcc: spy@example.com", Body: "Hi"},
+			wantErr: "invalid email header: Subject contains a line break",
+		},
+		{
+			name:    "LF in subject",
+			message: &domain.EmailMessage{To: "jane@example.com", Subject: "Hello\n\nInjected body", Body: "Hi"},
+			wantErr: "invalid email header: Subject contains a line break",
+		},
+	}
+
+	for mailerName, mailer := range mailers {
+		for _, tt := range tests {
+			t.Run(mailerName+"/"+tt.name, func(t *testing.T) {
+				// The SMTP mailer rejects the message before connecting to the server.
+				err := mailer.Send(context.Background(), tt.message)
+
+				assert.ErrorIs(t, err, ErrInvalidHeader)
+				assert.EqualError(t, err, tt.wantErr)
+			})
+		}
+	}
+
+	_, err = os.Stat(logFile)
+	assert.True(t, os.IsNotExist(err), "Expected no message to be logged")
+}
+
+func TestLogMailer_Send(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []*domain.EmailMessage
+	}{
+		{name: "one message", messages: []*domain.EmailMessage{
+			{To: "jane@example.com", Subject: "Welcome", Body: "Hi Jane,\nwelcome."},
+		}},
+		{name: "messages are appended", messages: []*domain.EmailMessage{
+			{To: "jane@example.com", Subject: "Welcome", Body: "Hi Jane"},
+			{To: "john@example.com", Subject: "Reset your password", Body: "Hi John"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logFile := filepath.Join(t.TempDir(), "mail.log")
+			mailer := NewLogMailer(logFile)
+
+			for _, message := range tt.messages {
+				assert.NoError(t, mailer.Send(context.Background(), message))
+			}
+
+			content, err := os.ReadFile(logFile)
+			assert.NoError(t, err)
+			lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+			if assert.Len(t, lines, len(tt.messages)) {
+				for i, line := range lines {
+					var logged domain.EmailMessage
+					assert.NoError(t, json.Unmarshal([]byte(line), &logged))
+					assert.Equal(t, *tt.messages[i], logged)
+				}
+			}
+		})
+	}
+}
+
+func TestLogMailer_SendWithoutFile(t *testing.T) {
+	mailer := NewLogMailer("")
+
+	err := mailer.Send(context.Background(), &domain.EmailMessage{To: "jane@example.com", Subject: "Welcome", Body: "Hi"})
+
+	assert.NoError(t, err)
+}
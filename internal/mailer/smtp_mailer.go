@@ -0,0 +1,124 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+const smtpDialTimeout = 10 * time.Second
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPMailer delivers emails through an SMTP server. Authentication is only attempted
+// when a username is configured, which allows pointing it at local stand-ins like Mailpit.
+type SMTPMailer struct {
+	config *SMTPConfig
+}
+
+// NewSMTPMailer checks the configuration, so that a misconfigured server is reported on
+// startup rather than on the first email.
+func NewSMTPMailer(config *SMTPConfig) (*SMTPMailer, error) {
+	if config.Host == "" {
+		return nil, errors.New("smtp host is required")
+	}
+	if port, err := strconv.Atoi(config.Port); err != nil || port < 1 || port > 65535 {
+		return nil, fmt.Errorf("invalid smtp port %q", config.Port)
+	}
+	if err := checkHeaderValue("From", config.From); err != nil {
+		return nil, err
+	}
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("invalid from address %q: %w", config.From, err)
+	}
+
+	return &SMTPMailer{config: config}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message *domain.EmailMessage) error {
+	if err := checkMessage(message); err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.config.Host, m.config.Port))
+	if err != nil {
+		return fmt.Errorf("could not connect to smtp server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("could not create smtp client: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return fmt.Errorf("could not start tls: %w", err)
+		}
+	}
+
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("could not authenticate with smtp server: %w", err)
+		}
+	}
+
+	if err := client.Mail(m.config.From); err != nil {
+		return fmt.Errorf("smtp MAIL command failed: %w", err)
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return fmt.Errorf("smtp RCPT command failed: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA command failed: %w", err)
+	}
+	if _, err := writer.Write(m.buildMessage(message, time.Now())); err != nil {
+		return fmt.Errorf("could not write email body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("could not send email: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage renders the message sent at date. The header values are checked by Send, and
+// the line endings of the body are normalized to CRLF.
+func (m *SMTPMailer) buildMessage(message *domain.EmailMessage, date time.Time) []byte {
+	var b strings.Builder
+
+	b.WriteString("From: " + m.config.From + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + message.Subject + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedBlobStore struct {
+	mock.Mock
+}
+
+func (m *MockedBlobStore) Put(ctx context.Context, key string, body io.Reader) error {
+	args := m.Called(ctx, key, body)
+	return args.Error(0)
+}
+
+func (m *MockedBlobStore) Open(ctx context.Context, key string) (*domain.Blob, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(*domain.Blob), args.Error(1)
+}
+
+func (m *MockedBlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	args := m.Called(ctx, prefix)
+	return args.Error(0)
+}
+
+func (m *MockedBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
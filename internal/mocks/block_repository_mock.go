@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockedBlockRepository) Block(ctx context.Context, blockerId, blockedId int64) error {
+	args := m.Called(ctx, blockerId, blockedId)
+	return args.Error(0)
+}
+
+func (m *MockedBlockRepository) Unblock(ctx context.Context, blockerId, blockedId int64) error {
+	args := m.Called(ctx, blockerId, blockedId)
+	return args.Error(0)
+}
+
+func (m *MockedBlockRepository) IsBlocked(ctx context.Context, userId, otherUserId int64) (bool, error) {
+	args := m.Called(ctx, userId, otherUserId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedBlockRepository) ListBlockedUserIDs(ctx context.Context, userId int64) ([]int64, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *MockedBlockRepository) ListBlocked(ctx context.Context, blockerId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	args := m.Called(ctx, blockerId, limit, offset)
+	return args.Get(0).([]domain.BlockedUser), args.Error(1)
+}
+
+type MockedMuteRepository struct {
+	mock.Mock
+}
+
+func (m *MockedMuteRepository) Mute(ctx context.Context, muterId, mutedId int64) error {
+	args := m.Called(ctx, muterId, mutedId)
+	return args.Error(0)
+}
+
+func (m *MockedMuteRepository) Unmute(ctx context.Context, muterId, mutedId int64) error {
+	args := m.Called(ctx, muterId, mutedId)
+	return args.Error(0)
+}
+
+func (m *MockedMuteRepository) ListMuted(ctx context.Context, muterId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	args := m.Called(ctx, muterId, limit, offset)
+	return args.Get(0).([]domain.BlockedUser), args.Error(1)
+}
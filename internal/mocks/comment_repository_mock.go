@@ -26,8 +26,8 @@ func (m *MockedCommentRepository) GetByID(ctx context.Context, id int64) (*domai
 	return args.Get(0).(*domain.Comment), args.Error(1)
 }
 
-func (m *MockedCommentRepository) ListByPostID(ctx context.Context, postId int64, limit int, offset int) ([]domain.Comment, error) {
-	args := m.Called(ctx, postId, limit, offset)
+func (m *MockedCommentRepository) ListByPostID(ctx context.Context, postId int64, page domain.PageRequest) ([]domain.Comment, error) {
+	args := m.Called(ctx, postId, page)
 	return args.Get(0).([]domain.Comment), args.Error(1)
 }
 
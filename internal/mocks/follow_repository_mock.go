@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedFollowRepository struct {
+	mock.Mock
+}
+
+func (m *MockedFollowRepository) Create(ctx context.Context, followerId, followeeId int64) error {
+	args := m.Called(ctx, followerId, followeeId)
+	return args.Error(0)
+}
+
+func (m *MockedFollowRepository) Delete(ctx context.Context, followerId, followeeId int64) error {
+	args := m.Called(ctx, followerId, followeeId)
+	return args.Error(0)
+}
+
+func (m *MockedFollowRepository) ListFollowers(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error) {
+	args := m.Called(ctx, userId, page)
+	return args.Get(0).([]domain.FollowUser), args.Error(1)
+}
+
+func (m *MockedFollowRepository) ListFollowing(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error) {
+	args := m.Called(ctx, userId, page)
+	return args.Get(0).([]domain.FollowUser), args.Error(1)
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedImpersonationSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockedImpersonationSessionRepository) Create(ctx context.Context, session *domain.ImpersonationSession) (*domain.ImpersonationSession, error) {
+	args := m.Called(ctx, session)
+	return args.Get(0).(*domain.ImpersonationSession), args.Error(1)
+}
+
+func (m *MockedImpersonationSessionRepository) GetByID(ctx context.Context, impersonationId string) (*domain.ImpersonationSession, error) {
+	args := m.Called(ctx, impersonationId)
+	return args.Get(0).(*domain.ImpersonationSession), args.Error(1)
+}
+
+func (m *MockedImpersonationSessionRepository) End(ctx context.Context, impersonationId string) error {
+	args := m.Called(ctx, impersonationId)
+	return args.Error(0)
+}
+
+type MockedImpersonationAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockedImpersonationAuditLogRepository) Create(ctx context.Context, entry *domain.ImpersonationAuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockedImpersonationAuditLogRepository) List(ctx context.Context, limit, offset int) ([]domain.ImpersonationAuditEntry, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]domain.ImpersonationAuditEntry), args.Error(1)
+}
@@ -0,0 +1,28 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedIPLoginFailureRepository struct {
+	mock.Mock
+}
+
+func (m *MockedIPLoginFailureRepository) GetByIP(ctx context.Context, ipAddress string) (*domain.IPLoginFailure, error) {
+	args := m.Called(ctx, ipAddress)
+	return args.Get(0).(*domain.IPLoginFailure), args.Error(1)
+}
+
+func (m *MockedIPLoginFailureRepository) RecordFailure(ctx context.Context, ipAddress string, window time.Duration) (*domain.IPLoginFailure, error) {
+	args := m.Called(ctx, ipAddress, window)
+	return args.Get(0).(*domain.IPLoginFailure), args.Error(1)
+}
+
+func (m *MockedIPLoginFailureRepository) LockUntil(ctx context.Context, ipAddress string, lockedUntil time.Time) error {
+	args := m.Called(ctx, ipAddress, lockedUntil)
+	return args.Error(0)
+}
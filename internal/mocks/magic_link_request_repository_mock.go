@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedMagicLinkRequestRepository struct {
+	mock.Mock
+}
+
+func (m *MockedMagicLinkRequestRepository) Record(ctx context.Context, email, ipAddress string) error {
+	args := m.Called(ctx, email, ipAddress)
+	return args.Error(0)
+}
+
+func (m *MockedMagicLinkRequestRepository) CountByEmailSince(ctx context.Context, email string, since time.Time) (int, error) {
+	args := m.Called(ctx, email, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockedMagicLinkRequestRepository) CountByIPSince(ctx context.Context, ipAddress string, since time.Time) (int, error) {
+	args := m.Called(ctx, ipAddress, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockedMagicLinkRequestRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) error {
+	args := m.Called(ctx, before)
+	return args.Error(0)
+}
@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedMailer struct {
+	mock.Mock
+}
+
+func (m *MockedMailer) Send(ctx context.Context, message *domain.EmailMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedModerationLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockedModerationLogRepository) Create(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error) {
+	args := m.Called(ctx, entry)
+	return args.Get(0).(*domain.ModerationLogEntry), args.Error(1)
+}
+
+func (m *MockedModerationLogRepository) List(ctx context.Context, limit, offset int) ([]domain.ModerationLogEntry, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]domain.ModerationLogEntry), args.Error(1)
+}
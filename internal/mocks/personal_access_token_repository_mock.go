@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockedPersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) (*domain.PersonalAccessToken, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(*domain.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockedPersonalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(*domain.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockedPersonalAccessTokenRepository) ListActiveByUserID(ctx context.Context, userId int64) ([]domain.PersonalAccessToken, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]domain.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockedPersonalAccessTokenRepository) Touch(ctx context.Context, tokenId int64) error {
+	args := m.Called(ctx, tokenId)
+	return args.Error(0)
+}
+
+func (m *MockedPersonalAccessTokenRepository) Revoke(ctx context.Context, userId int64, tokenId int64) error {
+	args := m.Called(ctx, userId, tokenId)
+	return args.Error(0)
+}
+
+func (m *MockedPersonalAccessTokenRepository) RevokeAllForUser(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
@@ -16,9 +16,14 @@ func (m *MockedPostRepository) Create(ctx context.Context, userId int64, post *d
 	return args.Get(0).(*domain.Post), args.Error(1)
 }
 
-func (m *MockedPostRepository) List(ctx context.Context, limit int, offset int) ([]*domain.Post, error) {
-	args := m.Called(ctx, limit, offset)
-	return args.Get(0).([]*domain.Post), args.Error(1)
+func (m *MockedPostRepository) List(ctx context.Context, viewerId int64, page domain.PageRequest) ([]domain.Post, error) {
+	args := m.Called(ctx, viewerId, page)
+	return args.Get(0).([]domain.Post), args.Error(1)
+}
+
+func (m *MockedPostRepository) ListByUserID(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.Post, error) {
+	args := m.Called(ctx, userId, page)
+	return args.Get(0).([]domain.Post), args.Error(1)
 }
 
 func (m *MockedPostRepository) GetByID(ctx context.Context, postId int64) (*domain.Post, error) {
@@ -35,3 +40,18 @@ func (m *MockedPostRepository) Delete(ctx context.Context, userId, postId int64)
 	args := m.Called(ctx, userId, postId)
 	return args.Error(0)
 }
+
+func (m *MockedPostRepository) CreateRepost(ctx context.Context, userId, postId int64) (*domain.Post, error) {
+	args := m.Called(ctx, userId, postId)
+	return args.Get(0).(*domain.Post), args.Error(1)
+}
+
+func (m *MockedPostRepository) DeleteRepost(ctx context.Context, userId, postId int64) error {
+	args := m.Called(ctx, userId, postId)
+	return args.Error(0)
+}
+
+func (m *MockedPostRepository) ListByIDs(ctx context.Context, postIds []int64) ([]domain.Post, error) {
+	args := m.Called(ctx, postIds)
+	return args.Get(0).([]domain.Post), args.Error(1)
+}
@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedReactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockedReactionRepository) Add(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	args := m.Called(ctx, userId, target, reactionType)
+	return args.Error(0)
+}
+
+func (m *MockedReactionRepository) Remove(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	args := m.Called(ctx, userId, target, reactionType)
+	return args.Error(0)
+}
+
+func (m *MockedReactionRepository) Summarize(ctx context.Context, viewerId int64, targetType domain.ReactionTargetType, targetIds []int64) (map[int64]domain.Reactions, error) {
+	args := m.Called(ctx, viewerId, targetType, targetIds)
+	return args.Get(0).(map[int64]domain.Reactions), args.Error(1)
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockedRefreshTokenRepository) Create(ctx context.Context, userId int64, familyId, tokenHash string, expiresAt time.Time) (*domain.RefreshToken, error) {
+	args := m.Called(ctx, userId, familyId, tokenHash, expiresAt)
+	return args.Get(0).(*domain.RefreshToken), args.Error(1)
+}
+
+func (m *MockedRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(*domain.RefreshToken), args.Error(1)
+}
+
+func (m *MockedRefreshTokenRepository) Revoke(ctx context.Context, tokenId int64) error {
+	args := m.Called(ctx, tokenId)
+	return args.Error(0)
+}
+
+func (m *MockedRefreshTokenRepository) RevokeFamily(ctx context.Context, familyId string) error {
+	args := m.Called(ctx, familyId)
+	return args.Error(0)
+}
+
+func (m *MockedRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockedSessionRepository) Create(ctx context.Context, sessionId string, userId int64, client *domain.SessionClient) (*domain.Session, error) {
+	args := m.Called(ctx, sessionId, userId, client)
+	return args.Get(0).(*domain.Session), args.Error(1)
+}
+
+func (m *MockedSessionRepository) GetByID(ctx context.Context, sessionId string) (*domain.Session, error) {
+	args := m.Called(ctx, sessionId)
+	return args.Get(0).(*domain.Session), args.Error(1)
+}
+
+func (m *MockedSessionRepository) ListActiveByUserID(ctx context.Context, userId int64) ([]domain.Session, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).([]domain.Session), args.Error(1)
+}
+
+func (m *MockedSessionRepository) Touch(ctx context.Context, sessionId string) error {
+	args := m.Called(ctx, sessionId)
+	return args.Error(0)
+}
+
+func (m *MockedSessionRepository) Revoke(ctx context.Context, userId int64, sessionId string) error {
+	args := m.Called(ctx, userId, sessionId)
+	return args.Error(0)
+}
+
+func (m *MockedSessionRepository) RevokeAllForUser(ctx context.Context, userId int64, exceptSessionId string) error {
+	args := m.Called(ctx, userId, exceptSessionId)
+	return args.Error(0)
+}
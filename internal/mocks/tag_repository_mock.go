@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockedTagRepository) ListPosts(ctx context.Context, viewerId int64, tag string, page domain.PageRequest) ([]domain.Post, error) {
+	args := m.Called(ctx, viewerId, tag, page)
+	return args.Get(0).([]domain.Post), args.Error(1)
+}
+
+func (m *MockedTagRepository) ListTrending(ctx context.Context, since time.Time, limit int) ([]domain.TrendingTag, error) {
+	args := m.Called(ctx, since, limit)
+	return args.Get(0).([]domain.TrendingTag), args.Error(1)
+}
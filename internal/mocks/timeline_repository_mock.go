@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedTimelineRepository struct {
+	mock.Mock
+}
+
+func (m *MockedTimelineRepository) ListHome(ctx context.Context, userId int64, after *domain.Cursor, limit int) ([]domain.Post, error) {
+	args := m.Called(ctx, userId, after, limit)
+	return args.Get(0).([]domain.Post), args.Error(1)
+}
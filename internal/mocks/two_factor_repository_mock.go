@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedTOTPRepository struct {
+	mock.Mock
+}
+
+func (m *MockedTOTPRepository) GetByUserID(ctx context.Context, userId int64) (*domain.UserTOTP, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).(*domain.UserTOTP), args.Error(1)
+}
+
+func (m *MockedTOTPRepository) UpsertPending(ctx context.Context, userId int64, secret string) (*domain.UserTOTP, error) {
+	args := m.Called(ctx, userId, secret)
+	return args.Get(0).(*domain.UserTOTP), args.Error(1)
+}
+
+func (m *MockedTOTPRepository) Confirm(ctx context.Context, userId int64, step int64) error {
+	args := m.Called(ctx, userId, step)
+	return args.Error(0)
+}
+
+func (m *MockedTOTPRepository) UseStep(ctx context.Context, userId int64, step int64) error {
+	args := m.Called(ctx, userId, step)
+	return args.Error(0)
+}
+
+func (m *MockedTOTPRepository) IncrementFailedAttempts(ctx context.Context, userId int64) (int, error) {
+	args := m.Called(ctx, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockedTOTPRepository) ResetFailedAttempts(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+func (m *MockedTOTPRepository) Delete(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+type MockedRecoveryCodeRepository struct {
+	mock.Mock
+}
+
+func (m *MockedRecoveryCodeRepository) ReplaceAll(ctx context.Context, userId int64, codeHashes []string) error {
+	args := m.Called(ctx, userId, codeHashes)
+	return args.Error(0)
+}
+
+func (m *MockedRecoveryCodeRepository) Consume(ctx context.Context, userId int64, codeHash string) error {
+	args := m.Called(ctx, userId, codeHash)
+	return args.Error(0)
+}
+
+func (m *MockedRecoveryCodeRepository) CountUnused(ctx context.Context, userId int64) (int, error) {
+	args := m.Called(ctx, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockedRecoveryCodeRepository) DeleteAll(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedUserIdentityRepository struct {
+	mock.Mock
+}
+
+func (m *MockedUserIdentityRepository) Create(ctx context.Context, identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	args := m.Called(ctx, identity)
+	return args.Get(0).(*domain.UserIdentity), args.Error(1)
+}
+
+func (m *MockedUserIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	args := m.Called(ctx, provider, subject)
+	return args.Get(0).(*domain.UserIdentity), args.Error(1)
+}
+
+func (m *MockedUserIdentityRepository) Touch(ctx context.Context, identityId int64) error {
+	args := m.Called(ctx, identityId)
+	return args.Error(0)
+}
+
+type MockedOIDCLoginStateRepository struct {
+	mock.Mock
+}
+
+func (m *MockedOIDCLoginStateRepository) Create(ctx context.Context, state *domain.OIDCLoginState) error {
+	args := m.Called(ctx, state)
+	return args.Error(0)
+}
+
+func (m *MockedOIDCLoginStateRepository) Consume(ctx context.Context, stateHash string) (*domain.OIDCLoginState, error) {
+	args := m.Called(ctx, stateHash)
+	return args.Get(0).(*domain.OIDCLoginState), args.Error(1)
+}
+
+type MockedOIDCProvider struct {
+	mock.Mock
+}
+
+func (m *MockedOIDCProvider) Name() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockedOIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	args := m.Called(ctx, state, nonce, codeVerifier)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockedOIDCProvider) Authenticate(ctx context.Context, code, codeVerifier, nonce string) (*oidc.IDToken, error) {
+	args := m.Called(ctx, code, codeVerifier, nonce)
+	return args.Get(0).(*oidc.IDToken), args.Error(1)
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -31,6 +32,11 @@ func (m *MockedUserRepository) Update(ctx context.Context, userId int64, updateU
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
+func (m *MockedUserRepository) GetProfileByUsername(ctx context.Context, username string) (*domain.PublicProfile, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(*domain.PublicProfile), args.Error(1)
+}
+
 func (m *MockedUserRepository) GetByID(ctx context.Context, userId int64) (*domain.User, error) {
 	args := m.Called(ctx, userId)
 	return args.Get(0).(*domain.User), args.Error(1)
@@ -41,12 +47,82 @@ func (m *MockedUserRepository) Delete(ctx context.Context, userId int64) error {
 	return args.Error(0)
 }
 
-func (m *MockedUserRepository) List(ctx context.Context, limit, offset int) ([]domain.User, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockedUserRepository) List(ctx context.Context, page domain.PageRequest) ([]domain.User, error) {
+	args := m.Called(ctx, page)
 	return args.Get(0).([]domain.User), args.Error(1)
 }
 
+func (m *MockedUserRepository) UpdateProfilePicture(ctx context.Context, userId int64, url string) (string, error) {
+	args := m.Called(ctx, userId, url)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockedUserRepository) UpdateLastLogin(ctx context.Context, userId int64) error {
 	args := m.Called(ctx, userId)
 	return args.Error(0)
 }
+
+func (m *MockedUserRepository) UpdatePassword(ctx context.Context, userId int64, hashedPassword string) error {
+	args := m.Called(ctx, userId, hashedPassword)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) MarkEmailVerified(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) IncrementFailedLogins(ctx context.Context, userId int64) (int, error) {
+	args := m.Called(ctx, userId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockedUserRepository) LockUntil(ctx context.Context, userId int64, lockedUntil time.Time) error {
+	args := m.Called(ctx, userId, lockedUntil)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) ResetFailedLogins(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) UpdateRole(ctx context.Context, userId int64, role domain.Role) error {
+	args := m.Called(ctx, userId, role)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) SetPendingEmail(ctx context.Context, userId int64, email string) error {
+	args := m.Called(ctx, userId, email)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) ConfirmPendingEmail(ctx context.Context, userId int64) (*domain.User, error) {
+	args := m.Called(ctx, userId)
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockedUserRepository) ScheduleDeletion(ctx context.Context, userId int64, purgeAt time.Time) error {
+	args := m.Called(ctx, userId, purgeAt)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) CancelDeletion(ctx context.Context, userId int64) (bool, error) {
+	args := m.Called(ctx, userId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedUserRepository) ListDueForDeletion(ctx context.Context, limit int) ([]int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *MockedUserRepository) Purge(ctx context.Context, userId int64) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
+}
+
+func (m *MockedUserRepository) CreateExternal(ctx context.Context, createUser *domain.EditableUserField) (*domain.User, error) {
+	args := m.Called(ctx, createUser)
+	return args.Get(0).(*domain.User), args.Error(1)
+}
@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedUserTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockedUserTokenRepository) Create(ctx context.Context, userId int64, purpose domain.TokenPurpose, tokenHash string, expiresAt time.Time) (*domain.UserToken, error) {
+	args := m.Called(ctx, userId, purpose, tokenHash, expiresAt)
+	return args.Get(0).(*domain.UserToken), args.Error(1)
+}
+
+func (m *MockedUserTokenRepository) Consume(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	args := m.Called(ctx, purpose, tokenHash)
+	return args.Get(0).(*domain.UserToken), args.Error(1)
+}
+
+func (m *MockedUserTokenRepository) GetActive(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	args := m.Called(ctx, purpose, tokenHash)
+	return args.Get(0).(*domain.UserToken), args.Error(1)
+}
+
+func (m *MockedUserTokenRepository) InvalidateAll(ctx context.Context, userId int64, purpose domain.TokenPurpose) error {
+	args := m.Called(ctx, userId, purpose)
+	return args.Error(0)
+}
+
+func (m *MockedUserTokenRepository) CountCreatedSince(ctx context.Context, userId int64, purpose domain.TokenPurpose, since time.Time) (int, error) {
+	args := m.Called(ctx, userId, purpose, since)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,56 @@
+package oidc
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/floroz/go-social/internal/env"
+)
+
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// LoadFromEnv reads the providers listed in OIDC_PROVIDERS (comma separated names). Each
+// provider NAME is configured by OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID,
+// OIDC_<NAME>_CLIENT_SECRET and optionally OIDC_<NAME>_SCOPES (space separated). The callback
+// is API_URL/api/v1/auth/oidc/<name>/callback unless OIDC_<NAME>_REDIRECT_URL is set.
+func LoadFromEnv() ([]Config, error) {
+	var configs []Config
+
+	for _, name := range strings.Split(env.GetEnvValue("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !providerNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("oidc: invalid provider name %q", name)
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := Config{
+			Name:         name,
+			IssuerURL:    env.GetEnvValue(prefix + "ISSUER"),
+			ClientID:     env.GetEnvValue(prefix + "CLIENT_ID"),
+			ClientSecret: env.GetEnvValue(prefix + "CLIENT_SECRET"),
+			// Optional settings are read directly, so that leaving them unset logs no warning.
+			RedirectURL: os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:      strings.Fields(os.Getenv(prefix + "SCOPES")),
+		}
+		if config.IssuerURL == "" || config.ClientID == "" {
+			return nil, fmt.Errorf("oidc: provider %q needs %sISSUER and %sCLIENT_ID", name, prefix, prefix)
+		}
+		if config.RedirectURL == "" {
+			config.RedirectURL = CallbackURL(env.GetEnvValue("API_URL"), name)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// CallbackURL returns the callback of the API for a provider.
+func CallbackURL(apiURL, name string) string {
+	return fmt.Sprintf("%s/api/v1/auth/oidc/%s/callback", strings.TrimSuffix(apiURL, "/"), name)
+}
@@ -0,0 +1,123 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/floroz/go-social/internal/jwtkeys"
+)
+
+// clockSkew is the leeway granted to the clock of the provider when checking token times.
+const clockSkew = time.Minute
+
+// IDToken holds the claims of a validated ID token.
+type IDToken struct {
+	Issuer            string        `json:"iss"`
+	Subject           string        `json:"sub"`
+	Audience          audience      `json:"aud"`
+	AuthorizedParty   string        `json:"azp"`
+	ExpiresAt         int64         `json:"exp"`
+	IssuedAt          int64         `json:"iat"`
+	Nonce             string        `json:"nonce"`
+	Email             string        `json:"email"`
+	EmailVerified     emailVerified `json:"email_verified"`
+	Name              string        `json:"name"`
+	GivenName         string        `json:"given_name"`
+	FamilyName        string        `json:"family_name"`
+	PreferredUsername string        `json:"preferred_username"`
+}
+
+// Valid checks the times of the token; jwt-go calls it once the signature is verified.
+func (t *IDToken) Valid() error {
+	now := time.Now()
+	if t.ExpiresAt == 0 || now.After(time.Unix(t.ExpiresAt, 0).Add(clockSkew)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+	if t.IssuedAt != 0 && now.Add(clockSkew).Before(time.Unix(t.IssuedAt, 0)) {
+		return fmt.Errorf("%w: issued in the future", ErrInvalidToken)
+	}
+	return nil
+}
+
+// verifyIDToken checks the ID token as required by OpenID Connect Core, section 3.1.3.7:
+// signed by a key of the provider, issued by it, for this client and this login attempt.
+func (p *Provider) verifyIDToken(ctx context.Context, metadata *Metadata, rawIDToken, nonce string) (*IDToken, error) {
+	claims := &IDToken{}
+
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		// Only asymmetric signatures: the client secret must not be usable to forge tokens.
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		default:
+			if token.Method != jwtkeys.SigningMethodEdDSA {
+				return nil, fmt.Errorf("%w: unexpected signing method %q", ErrInvalidToken, token.Method.Alg())
+			}
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return p.publicKey(ctx, metadata, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if claims.Issuer != metadata.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	if !slices.Contains(claims.Audience, p.config.ClientID) {
+		return nil, fmt.Errorf("%w: issued for another client", ErrInvalidToken)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, fmt.Errorf("%w: issued for another authorized party", ErrInvalidToken)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// audience is the "aud" claim, a single string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// emailVerified is the "email_verified" claim, which some providers send as a string.
+type emailVerified bool
+
+func (v *emailVerified) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case bool:
+		*v = emailVerified(value)
+	case string:
+		*v = value == "true"
+	default:
+		*v = false
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package oidc signs users in with an external OpenID Connect provider: the authorization
+// code flow with PKCE (RFC 7636), provider discovery and ID token validation. A provider is
+// configured by its issuer URL and client credentials; its endpoints and signing keys are
+// read from its discovery document on first use.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/floroz/go-social/internal/jwtkeys"
+)
+
+const (
+	// keysRefreshInterval throttles refetching the signing keys when a token names an
+	// unknown key, so that forged tokens cannot make the server hammer the provider.
+	keysRefreshInterval = time.Minute
+	// maxResponseSize caps the documents read from the provider.
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrNotConfigured = errors.New("oidc: provider is not configured")
+	ErrInvalidToken  = errors.New("oidc: invalid ID token")
+)
+
+// Config configures a provider.
+type Config struct {
+	// Name identifies the provider in URLs and in the linked identities, e.g. "google".
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback of the API, registered with the provider.
+	RedirectURL string
+	Scopes      []string
+}
+
+// Metadata is the part of the discovery document the client uses.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect provider. It is safe for concurrent use.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	metadata      *Metadata
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewProvider returns a provider that discovers its endpoints on first use, so that the API
+// starts even while the provider is unreachable.
+func NewProvider(config Config, httpClient *http.Client) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Provider{config: config, httpClient: httpClient}
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// AuthCodeURL returns the URL that sends the user to the provider. The state and nonce are
+// checked when the user comes back; the code challenge binds the code to the verifier.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.config.ClientID)
+	params.Set("redirect_uri", p.config.RedirectURL)
+	params.Set("scope", strings.Join(p.config.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return metadata.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Authenticate exchanges the authorization code for tokens and returns the validated claims
+// of the ID token.
+func (p *Provider) Authenticate(ctx context.Context, code, codeVerifier, nonce string) (*IDToken, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+
+	var tokenResponse struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := p.do(req, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("oidc: exchange code: %w", err)
+	}
+	if tokenResponse.IDToken == "" {
+		return nil, fmt.Errorf("%w: the token response has no ID token", ErrInvalidToken)
+	}
+
+	return p.verifyIDToken(ctx, metadata, tokenResponse.IDToken, nonce)
+}
+
+// discover fetches the discovery document once. A failure is retried on the next call.
+func (p *Provider) discover(ctx context.Context) (*Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	if p.config.IssuerURL == "" || p.config.ClientID == "" {
+		return nil, ErrNotConfigured
+	}
+
+	discoveryURL := strings.TrimSuffix(p.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: build discovery request: %w", err)
+	}
+
+	var metadata Metadata
+	if err := p.do(req, &metadata); err != nil {
+		return nil, fmt.Errorf("oidc: discover %s: %w", p.config.IssuerURL, err)
+	}
+
+	// The issuer must be the configured one, or the provider could vouch for another one.
+	if metadata.Issuer != p.config.IssuerURL {
+		return nil, fmt.Errorf("oidc: discovery document of %s names issuer %q", p.config.IssuerURL, metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc: discovery document of %s is incomplete", p.config.IssuerURL)
+	}
+
+	p.metadata = &metadata
+	return p.metadata, nil
+}
+
+// publicKey returns the signing key of the provider named kid, refetching the key set when
+// the provider rotated its keys.
+func (p *Provider) publicKey(ctx context.Context, metadata *Metadata, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	if time.Since(p.keysFetchedAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadata.JWKSURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: build JWKS request: %w", err)
+	}
+
+	var keySet jwtkeys.JSONWebKeySet
+	if err := p.do(req, &keySet); err != nil {
+		return nil, fmt.Errorf("oidc: fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// Keys of an unsupported type cannot have signed a token we accept anyway.
+		if key, err := jwk.PublicKey(); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, kid)
+	}
+	return key, nil
+}
+
+func (p *Provider) do(req *http.Request, target any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Host)
+	}
+
+	return json.NewDecoder(http.MaxBytesReader(nil, resp.Body, maxResponseSize)).Decode(target)
+}
+
+// CodeChallenge derives the S256 code challenge of a PKCE code verifier.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,129 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/floroz/go-social/internal/oidc/oidctest"
+	"github.com/stretchr/testify/assert"
+)
+
+const redirectURL = "http://api.example.com/callback"
+
+// authorize follows the authorization URL and returns the code the provider redirects with.
+func authorize(t *testing.T, provider *oidc.Provider, state, nonce, codeVerifier string) string {
+	authURL, err := provider.AuthCodeURL(context.Background(), state, nonce, codeVerifier)
+	assert.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	assert.NoError(t, err)
+	assert.Equal(t, state, location.Query().Get("state"))
+
+	return location.Query().Get("code")
+}
+
+func newProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	server := oidctest.NewServer()
+	t.Cleanup(server.Close)
+	server.SetIdentity(oidctest.Identity{Subject: "subject-1", Email: "jane@example.com", EmailVerified: true, GivenName: "Jane"})
+
+	return server, oidc.NewProvider(server.Config("mock", redirectURL), nil)
+}
+
+func TestProvider_Authenticate(t *testing.T) {
+	// Arrange
+	_, provider := newProvider(t)
+	code := authorize(t, provider, "state", "nonce", "verifier-verifier-verifier-verifier-verifier")
+
+	// Act
+	idToken, err := provider.Authenticate(context.Background(), code, "verifier-verifier-verifier-verifier-verifier", "nonce")
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, idToken) {
+		assert.Equal(t, "subject-1", idToken.Subject)
+		assert.Equal(t, "jane@example.com", idToken.Email)
+		assert.True(t, bool(idToken.EmailVerified))
+		assert.Equal(t, "Jane", idToken.GivenName)
+	}
+}
+
+func TestProvider_Authenticate_WrongCodeVerifier(t *testing.T) {
+	// Arrange
+	_, provider := newProvider(t)
+	code := authorize(t, provider, "state", "nonce", "verifier-verifier-verifier-verifier-verifier")
+
+	// Act
+	idToken, err := provider.Authenticate(context.Background(), code, "another-verifier-another-verifier-another", "nonce")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, idToken)
+}
+
+func TestProvider_Authenticate_RejectsInvalidTokens(t *testing.T) {
+	testCases := []struct {
+		name   string
+		nonce  string
+		tamper func(claims jwt.MapClaims)
+	}{
+		{name: "nonce of another login", nonce: "other-nonce"},
+		{name: "another audience", nonce: "nonce", tamper: func(claims jwt.MapClaims) { claims["aud"] = "other-client" }},
+		{name: "another issuer", nonce: "nonce", tamper: func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" }},
+		{name: "expired", nonce: "nonce", tamper: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "missing subject", nonce: "nonce", tamper: func(claims jwt.MapClaims) { claims["sub"] = "" }},
+		{
+			name:  "several audiences without authorized party",
+			nonce: "nonce",
+			tamper: func(claims jwt.MapClaims) {
+				claims["aud"] = []string{oidctest.ClientID, "other-client"}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			server, provider := newProvider(t)
+			server.Tamper(tc.tamper)
+			code := authorize(t, provider, "state", "nonce", "verifier-verifier-verifier-verifier-verifier")
+
+			// Act
+			idToken, err := provider.Authenticate(context.Background(), code, "verifier-verifier-verifier-verifier-verifier", tc.nonce)
+
+			// Assert
+			assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+			assert.Nil(t, idToken)
+		})
+	}
+}
+
+func TestProvider_AuthCodeURL_IssuerMismatch(t *testing.T) {
+	// Arrange: A client configured with another issuer than the one the document names
+	server, _ := newProvider(t)
+	config := server.Config("mock", redirectURL)
+	config.IssuerURL = server.URL + "/"
+	provider := oidc.NewProvider(config, nil)
+
+	// Act
+	_, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+
+	// Assert
+	assert.ErrorContains(t, err, "names issuer")
+}
+
+func TestCodeChallenge_RFC7636Example(t *testing.T) {
+	// The example of RFC 7636, appendix B.
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", oidc.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
@@ -0,0 +1,207 @@
+// Package oidctest provides an in-memory OpenID Connect provider for tests. Its authorization
+// endpoint logs in the configured identity without any user interaction.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/floroz/go-social/internal/tokens"
+)
+
+const (
+	ClientID     = "test-client"
+	ClientSecret = "test-secret"
+)
+
+// Identity is the user the provider logs in.
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	GivenName         string
+	FamilyName        string
+	PreferredUsername string
+}
+
+type authorization struct {
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	identity      Identity
+}
+
+// Server is a provider accepting the ClientID and ClientSecret client.
+type Server struct {
+	*httptest.Server
+
+	keyring *jwtkeys.Keyring
+
+	mu       sync.Mutex
+	identity Identity
+	codes    map[string]authorization
+	// tamper, when set, alters the claims of the next ID tokens.
+	tamper func(claims jwt.MapClaims)
+}
+
+func NewServer() *Server {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		panic(err)
+	}
+	key, err := jwtkeys.ParseKey("test-key", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		panic(err)
+	}
+	keyring, err := jwtkeys.NewKeyring(key)
+	if err != nil {
+		panic(err)
+	}
+
+	s := &Server{keyring: keyring, codes: map[string]authorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("GET /authorize", s.authorize)
+	mux.HandleFunc("POST /token", s.token)
+	mux.HandleFunc("GET /jwks", s.jwks)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Config returns the configuration of a client of the provider.
+func (s *Server) Config(name, redirectURL string) oidc.Config {
+	return oidc.Config{
+		Name:         name,
+		IssuerURL:    s.URL,
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectURL:  redirectURL,
+	}
+}
+
+// SetIdentity sets the user logged in by the next authorizations.
+func (s *Server) SetIdentity(identity Identity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identity = identity
+}
+
+// Tamper alters the claims of the next ID tokens, to test their validation.
+func (s *Server) Tamper(tamper func(claims jwt.MapClaims)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tamper = tamper
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.Metadata{
+		Issuer:                s.URL,
+		AuthorizationEndpoint: s.URL + "/authorize",
+		TokenEndpoint:         s.URL + "/token",
+		JWKSURI:               s.URL + "/jwks",
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.keyring.JWKS())
+}
+
+// authorize logs the identity in and sends the user back to the client with a code.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != ClientID || query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	code, _ := tokens.Generate(16)
+
+	s.mu.Lock()
+	s.codes[code] = authorization{
+		redirectURI:   query.Get("redirect_uri"),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		identity:      s.identity,
+	}
+	s.mu.Unlock()
+
+	params := url.Values{}
+	params.Set("code", code)
+	params.Set("state", query.Get("state"))
+	http.Redirect(w, r, query.Get("redirect_uri")+"?"+params.Encode(), http.StatusFound)
+}
+
+// token exchanges a code for an ID token, once, for the client and verifier it was issued to.
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok || clientID != ClientID || clientSecret != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	s.mu.Lock()
+	code := r.PostFormValue("code")
+	auth, found := s.codes[code]
+	delete(s.codes, code)
+	tamper := s.tamper
+	s.mu.Unlock()
+
+	if !found || r.PostFormValue("grant_type") != "authorization_code" || r.PostFormValue("redirect_uri") != auth.redirectURI ||
+		oidc.CodeChallenge(r.PostFormValue("code_verifier")) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":                s.URL,
+		"sub":                auth.identity.Subject,
+		"aud":                ClientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"nonce":              auth.nonce,
+		"email":              auth.identity.Email,
+		"email_verified":     auth.identity.EmailVerified,
+		"given_name":         auth.identity.GivenName,
+		"family_name":        auth.identity.FamilyName,
+		"preferred_username": auth.identity.PreferredUsername,
+	}
+	if tamper != nil {
+		tamper(claims)
+	}
+
+	idToken, err := s.keyring.Sign(claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "unused",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
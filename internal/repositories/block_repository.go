@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type BlockRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewBlockRepository(db *sql.DB) interfaces.BlockRepository {
+	return &BlockRepositoryImpl{db: db}
+}
+
+// Block blocks the user and removes the follows between the two users, in a single
+// transaction. Blocking a user that is blocked already only removes the follows.
+func (r *BlockRepositoryImpl) Block(ctx context.Context, blockerId, blockedId int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insertQuery := `
+		INSERT INTO user_blocks (blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		`
+
+	if _, err := tx.ExecContext(ctx, insertQuery, blockerId, blockedId); err != nil {
+		return err
+	}
+
+	unfollowQuery := `
+		DELETE FROM follows
+		WHERE (follower_id = $1 AND followee_id = $2) OR (follower_id = $2 AND followee_id = $1)
+		`
+
+	if _, err := tx.ExecContext(ctx, unfollowQuery, blockerId, blockedId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *BlockRepositoryImpl) Unblock(ctx context.Context, blockerId, blockedId int64) error {
+	query := `
+		DELETE FROM user_blocks
+		WHERE blocker_id = $1 AND blocked_id = $2
+		`
+
+	result, err := r.db.ExecContext(ctx, query, blockerId, blockedId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *BlockRepositoryImpl) IsBlocked(ctx context.Context, userId, otherUserId int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM user_blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1)
+		)
+		`
+
+	var blocked bool
+	if err := r.db.QueryRowContext(ctx, query, userId, otherUserId).Scan(&blocked); err != nil {
+		return false, err
+	}
+
+	return blocked, nil
+}
+
+func (r *BlockRepositoryImpl) ListBlockedUserIDs(ctx context.Context, userId int64) ([]int64, error) {
+	query := `
+		SELECT blocked_id FROM user_blocks WHERE blocker_id = $1
+		UNION
+		SELECT blocker_id FROM user_blocks WHERE blocked_id = $1
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	userIds := make([]int64, 0)
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		userIds = append(userIds, id)
+	}
+
+	return userIds, nil
+}
+
+// ListBlocked lists the users blocked by the user, most recent block first. Deleted users
+// are left out.
+func (r *BlockRepositoryImpl) ListBlocked(ctx context.Context, blockerId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	query := `
+		SELECT u.id, u.username, u.first_name, u.last_name, COALESCE(u.profile_picture_url, '') AS profile_picture_url, b.created_at
+		FROM user_blocks b
+		JOIN users u ON u.id = b.blocked_id
+		WHERE b.blocker_id = $1 AND u.is_deleted = false
+		ORDER BY b.created_at DESC, u.id DESC
+		LIMIT $2 OFFSET $3
+		`
+
+	return listBlockedUsers(ctx, r.db, query, blockerId, limit, offset)
+}
+
+func listBlockedUsers(ctx context.Context, db *sql.DB, query string, userId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	rows, err := db.QueryContext(ctx, query, userId, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	users := make([]domain.BlockedUser, 0)
+
+	for rows.Next() {
+		user := domain.BlockedUser{}
+
+		err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.FirstName,
+			&user.LastName,
+			&user.ProfilePictureURL,
+			&user.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
@@ -0,0 +1,137 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockRepositoryImpl_Block_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO user_blocks \(blocker_id, blocked_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM follows WHERE \(follower_id = \$1 AND followee_id = \$2\) OR \(follower_id = \$2 AND followee_id = \$1\)`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	// Act
+	err := repo.Block(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepositoryImpl_Block_Error(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO user_blocks`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM follows`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnError(errors.New("some error"))
+	mock.ExpectRollback()
+
+	// Act
+	err := repo.Block(context.Background(), 1, 2)
+
+	// Assert
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepositoryImpl_Unblock_NotBlocked(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	mock.ExpectExec(`DELETE FROM user_blocks WHERE blocker_id = \$1 AND blocked_id = \$2`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Unblock(context.Background(), 1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepositoryImpl_IsBlocked_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	mock.ExpectQuery(`SELECT EXISTS \( SELECT 1 FROM user_blocks WHERE \(blocker_id = \$1 AND blocked_id = \$2\) OR \(blocker_id = \$2 AND blocked_id = \$1\) \)`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	// Act
+	blocked, err := repo.IsBlocked(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, blocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepositoryImpl_ListBlockedUserIDs_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	mock.ExpectQuery(`SELECT blocked_id FROM user_blocks WHERE blocker_id = \$1 UNION SELECT blocker_id FROM user_blocks WHERE blocked_id = \$1`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"blocked_id"}).AddRow(int64(2)).AddRow(int64(3)))
+
+	// Act
+	userIds, err := repo.ListBlockedUserIDs(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, userIds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepositoryImpl_ListBlocked_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewBlockRepository(db)
+
+	blockedAt := time.Now()
+	mock.ExpectQuery(`SELECT u.id, u.username, u.first_name, u.last_name, COALESCE\(u.profile_picture_url, ''\) AS profile_picture_url, b.created_at FROM user_blocks b JOIN users u ON u.id = b.blocked_id WHERE b.blocker_id = \$1 AND u.is_deleted = false ORDER BY b.created_at DESC, u.id DESC LIMIT \$2 OFFSET \$3`).
+		WithArgs(int64(1), 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "first_name", "last_name", "profile_picture_url", "created_at"}).
+			AddRow(int64(2), "jane", "Jane", "Doe", "", blockedAt))
+
+	// Act
+	users, err := repo.ListBlocked(context.Background(), 1, 20, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.BlockedUser{{ID: 2, Username: "jane", FirstName: "Jane", LastName: "Doe", CreatedAt: blockedAt}}, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
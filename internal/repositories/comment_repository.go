@@ -114,17 +114,16 @@ func (r *CommentRepositoryImpl) GetByID(ctx context.Context, id int64) (*domain.
 	return &comment, nil
 }
 
-func (r *CommentRepositoryImpl) ListByPostID(ctx context.Context, postId int64, limit int, offset int) ([]domain.Comment, error) {
+// ListByPostID lists the comments on a post, newest first.
+func (r *CommentRepositoryImpl) ListByPostID(ctx context.Context, postId int64, page domain.PageRequest) ([]domain.Comment, error) {
 	query := `
 		SELECT id, user_id, post_id, content, created_at, updated_at
 		FROM comments
 		WHERE post_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2
-		OFFSET $3
 		`
+	query, args := appendPage(query, []any{postId}, page, "created_at", "id")
 
-	rows, err := r.db.QueryContext(ctx, query, postId, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -175,20 +175,20 @@ func TestCommentRepositoryImpl_ListByPostID_Success(t *testing.T) {
 	repo := repositories.NewCommentRepository(db)
 
 	const postId int64 = 1
-	const limit, offset = 10, 0
+	page := domain.PageRequest{After: &domain.Cursor{CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: 42}, Limit: 10}
 	expectedComments := []domain.Comment{
 		{ID: 1, UserID: 1, PostID: postId, Content: "Comment 1"},
 		{ID: 2, UserID: 2, PostID: postId, Content: "Comment 2"},
 	}
 
-	mock.ExpectQuery(`SELECT id, user_id, post_id, content, created_at, updated_at FROM comments WHERE post_id = \$1 ORDER BY created_at DESC LIMIT \$2 OFFSET \$3`).
-		WithArgs(postId, limit, offset).
+	mock.ExpectQuery(`SELECT id, user_id, post_id, content, created_at, updated_at FROM comments WHERE post_id = \$1 AND \(created_at, id\) < \(\$2, \$3\) ORDER BY created_at DESC, id DESC LIMIT \$4`).
+		WithArgs(postId, page.After.CreatedAt, page.After.ID, page.Limit).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "post_id", "content", "created_at", "updated_at"}).
 			AddRow(expectedComments[0].ID, expectedComments[0].UserID, expectedComments[0].PostID, expectedComments[0].Content, expectedComments[0].CreatedAt, expectedComments[0].UpdatedAt).
 			AddRow(expectedComments[1].ID, expectedComments[1].UserID, expectedComments[1].PostID, expectedComments[1].Content, expectedComments[1].CreatedAt, expectedComments[1].UpdatedAt))
 
 	// Act
-	comments, err := repo.ListByPostID(context.Background(), postId, limit, offset)
+	comments, err := repo.ListByPostID(context.Background(), postId, page)
 
 	// Assert
 	assert.Nil(t, err)
@@ -204,14 +204,14 @@ func TestCommentRepositoryImpl_ListByPostID_Error(t *testing.T) {
 	repo := repositories.NewCommentRepository(db)
 
 	const postId int64 = 1
-	const limit, offset = 10, 0
+	page := domain.PageRequest{After: &domain.Cursor{CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: 42}, Limit: 10}
 
-	mock.ExpectQuery(`SELECT id, user_id, post_id, content, created_at, updated_at FROM comments WHERE post_id = \$1 ORDER BY created_at DESC LIMIT \$2 OFFSET \$3`).
-		WithArgs(postId, limit, offset).
+	mock.ExpectQuery(`SELECT id, user_id, post_id, content, created_at, updated_at FROM comments WHERE post_id = \$1 AND \(created_at, id\) < \(\$2, \$3\) ORDER BY created_at DESC, id DESC LIMIT \$4`).
+		WithArgs(postId, page.After.CreatedAt, page.After.ID, page.Limit).
 		WillReturnError(errors.New("some error"))
 
 	// Act
-	comments, err := repo.ListByPostID(context.Background(), postId, limit, offset)
+	comments, err := repo.ListByPostID(context.Background(), postId, page)
 
 	// Assert
 	assert.Error(t, err)
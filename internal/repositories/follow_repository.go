@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type FollowRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewFollowRepository(db *sql.DB) interfaces.FollowRepository {
+	return &FollowRepositoryImpl{db: db}
+}
+
+func (r *FollowRepositoryImpl) Create(ctx context.Context, followerId, followeeId int64) error {
+	query := `
+		INSERT INTO follows (follower_id, followee_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		`
+
+	result, err := r.db.ExecContext(ctx, query, followerId, followeeId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrAlreadyFollowing
+	}
+
+	return nil
+}
+
+func (r *FollowRepositoryImpl) Delete(ctx context.Context, followerId, followeeId int64) error {
+	query := `
+		DELETE FROM follows
+		WHERE follower_id = $1 AND followee_id = $2
+		`
+
+	result, err := r.db.ExecContext(ctx, query, followerId, followeeId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListFollowers lists the users following the user, most recent follow first. Deleted
+// users are left out.
+func (r *FollowRepositoryImpl) ListFollowers(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error) {
+	query := `
+		SELECT u.id, u.username, u.first_name, u.last_name, COALESCE(u.profile_picture_url, '') AS profile_picture_url, f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.follower_id
+		WHERE f.followee_id = $1 AND u.is_deleted = false
+		`
+
+	return r.list(ctx, query, userId, page)
+}
+
+// ListFollowing lists the users the user follows, most recent follow first. Deleted users
+// are left out.
+func (r *FollowRepositoryImpl) ListFollowing(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.FollowUser, error) {
+	query := `
+		SELECT u.id, u.username, u.first_name, u.last_name, COALESCE(u.profile_picture_url, '') AS profile_picture_url, f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = $1 AND u.is_deleted = false
+		`
+
+	return r.list(ctx, query, userId, page)
+}
+
+// list runs a query selecting the follows of the user, ordered by follow time then user id.
+func (r *FollowRepositoryImpl) list(ctx context.Context, query string, userId int64, page domain.PageRequest) ([]domain.FollowUser, error) {
+	query, args := appendPage(query, []any{userId}, page, "f.created_at", "u.id")
+	rows, err := r.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	users := make([]domain.FollowUser, 0)
+
+	for rows.Next() {
+		user := domain.FollowUser{}
+
+		err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.FirstName,
+			&user.LastName,
+			&user.ProfilePictureURL,
+			&user.FollowedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
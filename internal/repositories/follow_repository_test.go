@@ -0,0 +1,109 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowRepositoryImpl_Create_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewFollowRepository(db)
+
+	mock.ExpectExec(`INSERT INTO follows \(follower_id, followee_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err := repo.Create(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowRepositoryImpl_Create_AlreadyFollowing(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewFollowRepository(db)
+
+	mock.ExpectExec(`INSERT INTO follows`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Create(context.Background(), 1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAlreadyFollowing)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowRepositoryImpl_Delete_NotFollowing(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewFollowRepository(db)
+
+	mock.ExpectExec(`DELETE FROM follows WHERE follower_id = \$1 AND followee_id = \$2`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Delete(context.Background(), 1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowRepositoryImpl_ListFollowers_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewFollowRepository(db)
+
+	followedAt := time.Now()
+	mock.ExpectQuery(`SELECT u.id, u.username, u.first_name, u.last_name, COALESCE\(u.profile_picture_url, ''\) AS profile_picture_url, f.created_at FROM follows f JOIN users u ON u.id = f.follower_id WHERE f.followee_id = \$1 AND u.is_deleted = false ORDER BY f.created_at DESC, u.id DESC LIMIT \$2`).
+		WithArgs(int64(2), 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "first_name", "last_name", "profile_picture_url", "created_at"}).
+			AddRow(int64(1), "jane", "Jane", "Doe", "", followedAt))
+
+	// Act
+	followers, err := repo.ListFollowers(context.Background(), 2, domain.PageRequest{Limit: 21})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.FollowUser{{ID: 1, Username: "jane", FirstName: "Jane", LastName: "Doe", FollowedAt: followedAt}}, followers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowRepositoryImpl_ListFollowing_Error(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewFollowRepository(db)
+
+	after := domain.Cursor{CreatedAt: time.Now(), ID: 10}
+	mock.ExpectQuery(`FROM follows f JOIN users u ON u.id = f.followee_id WHERE f.follower_id = \$1 AND u.is_deleted = false AND \(f.created_at, u.id\) < \(\$2, \$3\) ORDER BY f.created_at DESC, u.id DESC LIMIT \$4`).
+		WithArgs(int64(1), after.CreatedAt, after.ID, 21).
+		WillReturnError(errors.New("some error"))
+
+	// Act
+	following, err := repo.ListFollowing(context.Background(), 1, domain.PageRequest{After: &after, Limit: 21})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, following)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type ImpersonationAuditLogRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewImpersonationAuditLogRepository(db *sql.DB) interfaces.ImpersonationAuditLogRepository {
+	return &ImpersonationAuditLogRepositoryImpl{db: db}
+}
+
+func (r *ImpersonationAuditLogRepositoryImpl) Create(ctx context.Context, entry *domain.ImpersonationAuditEntry) error {
+	query := `
+		INSERT INTO impersonation_audit_log (impersonation_id, actor_id, subject_id, method, path, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		entry.ImpersonationID,
+		entry.ActorID,
+		entry.SubjectID,
+		entry.Method,
+		entry.Path,
+		entry.Status,
+	)
+
+	return err
+}
+
+func (r *ImpersonationAuditLogRepositoryImpl) List(ctx context.Context, limit, offset int) ([]domain.ImpersonationAuditEntry, error) {
+	query := `
+		SELECT id, impersonation_id, actor_id, subject_id, method, path, status, created_at
+		FROM impersonation_audit_log
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := make([]domain.ImpersonationAuditEntry, 0)
+
+	for rows.Next() {
+		entry := domain.ImpersonationAuditEntry{}
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ImpersonationID,
+			&entry.ActorID,
+			&entry.SubjectID,
+			&entry.Method,
+			&entry.Path,
+			&entry.Status,
+			&entry.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
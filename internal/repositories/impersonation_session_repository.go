@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type ImpersonationSessionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewImpersonationSessionRepository(db *sql.DB) interfaces.ImpersonationSessionRepository {
+	return &ImpersonationSessionRepositoryImpl{db: db}
+}
+
+func (r *ImpersonationSessionRepositoryImpl) Create(ctx context.Context, session *domain.ImpersonationSession) (*domain.ImpersonationSession, error) {
+	query := `
+		INSERT INTO impersonation_sessions (id, actor_id, subject_id, reason, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, actor_id, subject_id, reason, created_at, expires_at, ended_at
+		`
+
+	row := r.db.QueryRowContext(
+		ctx,
+		query,
+		session.ID,
+		session.ActorID,
+		session.SubjectID,
+		session.Reason,
+		session.ExpiresAt,
+	)
+
+	return scanImpersonationSession(row)
+}
+
+func (r *ImpersonationSessionRepositoryImpl) GetByID(ctx context.Context, impersonationId string) (*domain.ImpersonationSession, error) {
+	query := `
+		SELECT id, actor_id, subject_id, reason, created_at, expires_at, ended_at
+		FROM impersonation_sessions
+		WHERE id = $1
+		`
+
+	session, err := scanImpersonationSession(r.db.QueryRowContext(ctx, query, impersonationId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// End ends an impersonation session that is still active. It returns ErrNotFound when there
+// is no such session.
+func (r *ImpersonationSessionRepositoryImpl) End(ctx context.Context, impersonationId string) error {
+	query := `
+		UPDATE impersonation_sessions
+		SET ended_at = NOW()
+		WHERE id = $1 AND ended_at IS NULL AND expires_at > NOW()
+		`
+
+	result, err := r.db.ExecContext(ctx, query, impersonationId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanImpersonationSession(row rowScanner) (*domain.ImpersonationSession, error) {
+	session := domain.ImpersonationSession{}
+
+	err := row.Scan(
+		&session.ID,
+		&session.ActorID,
+		&session.SubjectID,
+		&session.Reason,
+		&session.CreatedAt,
+		&session.ExpiresAt,
+		&session.EndedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,125 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var impersonationSessionColumns = []string{"id", "actor_id", "subject_id", "reason", "created_at", "expires_at", "ended_at"}
+
+func TestImpersonationSessionRepositoryImpl_Create(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewImpersonationSessionRepository(db)
+
+	now := time.Now()
+	expiresAt := now.Add(30 * time.Minute)
+	mock.ExpectQuery(`INSERT INTO impersonation_sessions \(id, actor_id, subject_id, reason, expires_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+		WithArgs("impersonation-1", int64(1), int64(7), "ticket 42", expiresAt).
+		WillReturnRows(sqlmock.NewRows(impersonationSessionColumns).
+			AddRow("impersonation-1", 1, 7, "ticket 42", now, expiresAt, nil))
+
+	// Act
+	session, err := repo.Create(context.Background(), &domain.ImpersonationSession{
+		ID:        "impersonation-1",
+		ActorID:   1,
+		SubjectID: 7,
+		Reason:    "ticket 42",
+		ExpiresAt: expiresAt,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), session.SubjectID)
+	assert.Equal(t, now, session.CreatedAt)
+	assert.Nil(t, session.EndedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImpersonationSessionRepositoryImpl_GetByID_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewImpersonationSessionRepository(db)
+
+	mock.ExpectQuery(`SELECT (.+) FROM impersonation_sessions WHERE id = \$1`).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	session, err := repo.GetByID(context.Background(), "missing")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, session)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImpersonationSessionRepositoryImpl_End(t *testing.T) {
+	testCases := []struct {
+		name         string
+		rowsAffected int64
+		expectedErr  error
+	}{
+		{name: "active session", rowsAffected: 1},
+		{name: "ended or expired session", rowsAffected: 0, expectedErr: domain.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, cleanup := mocks.SetupMockDB(t)
+			defer cleanup()
+
+			repo := repositories.NewImpersonationSessionRepository(db)
+
+			mock.ExpectExec(`UPDATE impersonation_sessions SET ended_at = NOW\(\) WHERE id = \$1 AND ended_at IS NULL AND expires_at > NOW\(\)`).
+				WithArgs("impersonation-1").
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+
+			// Act
+			err := repo.End(context.Background(), "impersonation-1")
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImpersonationAuditLogRepositoryImpl_Create(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewImpersonationAuditLogRepository(db)
+
+	mock.ExpectExec(`INSERT INTO impersonation_audit_log \(impersonation_id, actor_id, subject_id, method, path, status\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+		WithArgs("impersonation-1", int64(1), int64(7), "GET", "/api/v1/users", 200).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Act
+	err := repo.Create(context.Background(), &domain.ImpersonationAuditEntry{
+		ImpersonationID: "impersonation-1",
+		ActorID:         1,
+		SubjectID:       7,
+		Method:          "GET",
+		Path:            "/api/v1/users",
+		Status:          200,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
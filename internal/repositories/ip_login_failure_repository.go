@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type IPLoginFailureRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewIPLoginFailureRepository(db *sql.DB) interfaces.IPLoginFailureRepository {
+	return &IPLoginFailureRepositoryImpl{db: db}
+}
+
+func (r *IPLoginFailureRepositoryImpl) GetByIP(ctx context.Context, ipAddress string) (*domain.IPLoginFailure, error) {
+	query := `
+		SELECT ip_address, failed_attempts, last_failed_at, locked_until
+		FROM ip_login_failures
+		WHERE ip_address = $1
+		`
+
+	failure := domain.IPLoginFailure{}
+
+	err := r.db.QueryRowContext(ctx, query, ipAddress).Scan(
+		&failure.IPAddress,
+		&failure.FailedAttempts,
+		&failure.LastFailedAt,
+		&failure.LockedUntil,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &failure, nil
+}
+
+// RecordFailure counts a failed login from the IP. Failures older than the window are
+// forgotten, so the count restarts at one after a quiet period.
+func (r *IPLoginFailureRepositoryImpl) RecordFailure(ctx context.Context, ipAddress string, window time.Duration) (*domain.IPLoginFailure, error) {
+	query := `
+		INSERT INTO ip_login_failures (ip_address, failed_attempts, last_failed_at)
+		VALUES ($1, 1, NOW())
+		ON CONFLICT (ip_address) DO UPDATE
+		SET failed_attempts = CASE
+				WHEN ip_login_failures.last_failed_at < NOW() - make_interval(secs => $2) THEN 1
+				ELSE ip_login_failures.failed_attempts + 1
+			END,
+			last_failed_at = NOW()
+		RETURNING ip_address, failed_attempts, last_failed_at, locked_until
+		`
+
+	failure := domain.IPLoginFailure{}
+
+	err := r.db.QueryRowContext(ctx, query, ipAddress, window.Seconds()).Scan(
+		&failure.IPAddress,
+		&failure.FailedAttempts,
+		&failure.LastFailedAt,
+		&failure.LockedUntil,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &failure, nil
+}
+
+func (r *IPLoginFailureRepositoryImpl) LockUntil(ctx context.Context, ipAddress string, lockedUntil time.Time) error {
+	query := `
+		UPDATE ip_login_failures
+		SET locked_until = $1
+		WHERE ip_address = $2
+		`
+
+	_, err := r.db.ExecContext(ctx, query, lockedUntil, ipAddress)
+
+	return err
+}
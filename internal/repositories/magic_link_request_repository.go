@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type MagicLinkRequestRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewMagicLinkRequestRepository(db *sql.DB) interfaces.MagicLinkRequestRepository {
+	return &MagicLinkRequestRepositoryImpl{db: db}
+}
+
+func (r *MagicLinkRequestRepositoryImpl) Record(ctx context.Context, email, ipAddress string) error {
+	query := `INSERT INTO magic_link_requests (email, ip_address) VALUES ($1, $2)`
+
+	_, err := r.db.ExecContext(ctx, query, email, ipAddress)
+
+	return err
+}
+
+func (r *MagicLinkRequestRepositoryImpl) CountByEmailSince(ctx context.Context, email string, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM magic_link_requests WHERE email = $1 AND created_at > $2`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, email, since).Scan(&count)
+
+	return count, err
+}
+
+func (r *MagicLinkRequestRepositoryImpl) CountByIPSince(ctx context.Context, ipAddress string, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM magic_link_requests WHERE ip_address = $1 AND created_at > $2`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, ipAddress, since).Scan(&count)
+
+	return count, err
+}
+
+// DeleteCreatedBefore forgets the requests that no longer count towards any limit.
+func (r *MagicLinkRequestRepositoryImpl) DeleteCreatedBefore(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM magic_link_requests WHERE created_at <= $1`
+
+	_, err := r.db.ExecContext(ctx, query, before)
+
+	return err
+}
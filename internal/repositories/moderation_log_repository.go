@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type ModerationLogRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewModerationLogRepository(db *sql.DB) interfaces.ModerationLogRepository {
+	return &ModerationLogRepositoryImpl{db: db}
+}
+
+func (r *ModerationLogRepositoryImpl) Create(ctx context.Context, entry *domain.ModerationLogEntry) (*domain.ModerationLogEntry, error) {
+	query := `
+		INSERT INTO moderation_actions (actor_id, actor_role, action, target_type, target_id, target_user_id, previous_content)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, actor_id, actor_role, action, target_type, target_id, target_user_id, previous_content, created_at
+		`
+
+	row := r.db.QueryRowContext(
+		ctx,
+		query,
+		entry.ActorID,
+		entry.ActorRole,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		entry.TargetUserID,
+		entry.PreviousContent,
+	)
+
+	return scanModerationLogEntry(row)
+}
+
+func (r *ModerationLogRepositoryImpl) List(ctx context.Context, limit, offset int) ([]domain.ModerationLogEntry, error) {
+	query := `
+		SELECT id, actor_id, actor_role, action, target_type, target_id, target_user_id, previous_content, created_at
+		FROM moderation_actions
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := make([]domain.ModerationLogEntry, 0)
+
+	for rows.Next() {
+		entry, err := scanModerationLogEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+func scanModerationLogEntry(row rowScanner) (*domain.ModerationLogEntry, error) {
+	entry := domain.ModerationLogEntry{}
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.ActorID,
+		&entry.ActorRole,
+		&entry.Action,
+		&entry.TargetType,
+		&entry.TargetID,
+		&entry.TargetUserID,
+		&entry.PreviousContent,
+		&entry.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,71 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderationLogColumns = []string{"id", "actor_id", "actor_role", "action", "target_type", "target_id", "target_user_id", "previous_content", "created_at"}
+
+func TestModerationLogRepositoryImpl_Create(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewModerationLogRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`INSERT INTO moderation_actions \(actor_id, actor_role, action, target_type, target_id, target_user_id, previous_content\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+		WithArgs(int64(2), domain.RoleModerator, domain.ModerationActionDelete, domain.ModerationTargetPost, int64(10), int64(7), "spam").
+		WillReturnRows(sqlmock.NewRows(moderationLogColumns).
+			AddRow(1, 2, "moderator", "delete", "post", 10, 7, "spam", now))
+
+	// Act
+	entry, err := repo.Create(context.Background(), &domain.ModerationLogEntry{
+		ActorID:         2,
+		ActorRole:       domain.RoleModerator,
+		Action:          domain.ModerationActionDelete,
+		TargetType:      domain.ModerationTargetPost,
+		TargetID:        10,
+		TargetUserID:    7,
+		PreviousContent: "spam",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), entry.ID)
+	assert.Equal(t, domain.ModerationActionDelete, entry.Action)
+	assert.Equal(t, now, entry.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestModerationLogRepositoryImpl_List(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewModerationLogRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT (.+) FROM moderation_actions ORDER BY created_at DESC, id DESC LIMIT \$1 OFFSET \$2`).
+		WithArgs(20, 0).
+		WillReturnRows(sqlmock.NewRows(moderationLogColumns).
+			AddRow(2, 3, "admin", "update", "comment", 11, 7, "rude", now).
+			AddRow(1, 2, "moderator", "delete", "post", 10, 7, "spam", now.Add(-time.Minute)))
+
+	// Act
+	entries, err := repo.List(context.Background(), 20, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, domain.ModerationTargetComment, entries[0].TargetType)
+		assert.Equal(t, domain.RoleModerator, entries[1].ActorRole)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type MuteRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewMuteRepository(db *sql.DB) interfaces.MuteRepository {
+	return &MuteRepositoryImpl{db: db}
+}
+
+func (r *MuteRepositoryImpl) Mute(ctx context.Context, muterId, mutedId int64) error {
+	query := `
+		INSERT INTO user_mutes (muter_id, muted_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		`
+
+	_, err := r.db.ExecContext(ctx, query, muterId, mutedId)
+	return err
+}
+
+func (r *MuteRepositoryImpl) Unmute(ctx context.Context, muterId, mutedId int64) error {
+	query := `
+		DELETE FROM user_mutes
+		WHERE muter_id = $1 AND muted_id = $2
+		`
+
+	result, err := r.db.ExecContext(ctx, query, muterId, mutedId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListMuted lists the users muted by the user, most recent mute first. Deleted users are
+// left out.
+func (r *MuteRepositoryImpl) ListMuted(ctx context.Context, muterId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	query := `
+		SELECT u.id, u.username, u.first_name, u.last_name, COALESCE(u.profile_picture_url, '') AS profile_picture_url, m.created_at
+		FROM user_mutes m
+		JOIN users u ON u.id = m.muted_id
+		WHERE m.muter_id = $1 AND u.is_deleted = false
+		ORDER BY m.created_at DESC, u.id DESC
+		LIMIT $2 OFFSET $3
+		`
+
+	return listBlockedUsers(ctx, r.db, query, muterId, limit, offset)
+}
@@ -0,0 +1,68 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuteRepositoryImpl_Mute_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewMuteRepository(db)
+
+	mock.ExpectExec(`INSERT INTO user_mutes \(muter_id, muted_id\) VALUES \(\$1, \$2\) ON CONFLICT DO NOTHING`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Mute(context.Background(), 1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMuteRepositoryImpl_Unmute_NotMuted(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewMuteRepository(db)
+
+	mock.ExpectExec(`DELETE FROM user_mutes WHERE muter_id = \$1 AND muted_id = \$2`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Unmute(context.Background(), 1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMuteRepositoryImpl_ListMuted_Error(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewMuteRepository(db)
+
+	mock.ExpectQuery(`FROM user_mutes m JOIN users u ON u.id = m.muted_id WHERE m.muter_id = \$1`).
+		WithArgs(int64(1), 20, 0).
+		WillReturnError(errors.New("some error"))
+
+	// Act
+	users, err := repo.ListMuted(context.Background(), 1, 20, 0)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
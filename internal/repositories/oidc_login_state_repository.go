@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type OIDCLoginStateRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewOIDCLoginStateRepository(db *sql.DB) interfaces.OIDCLoginStateRepository {
+	return &OIDCLoginStateRepositoryImpl{db: db}
+}
+
+// Create stores a login in progress. Logins that were abandoned are cleaned up on the way.
+func (r *OIDCLoginStateRepositoryImpl) Create(ctx context.Context, state *domain.OIDCLoginState) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM oidc_login_states WHERE expires_at <= NOW()`); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO oidc_login_states (state_hash, provider, nonce, code_verifier, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		`
+
+	_, err := r.db.ExecContext(ctx, query, state.StateHash, state.Provider, state.Nonce, state.CodeVerifier, state.ExpiresAt)
+
+	return err
+}
+
+// Consume deletes an unexpired login in progress and returns it, so that a state can only be
+// used once. It returns domain.ErrNotFound for unknown, expired or already used states.
+func (r *OIDCLoginStateRepositoryImpl) Consume(ctx context.Context, stateHash string) (*domain.OIDCLoginState, error) {
+	query := `
+		DELETE FROM oidc_login_states
+		WHERE state_hash = $1 AND expires_at > NOW()
+		RETURNING state_hash, provider, nonce, code_verifier, expires_at
+		`
+
+	state := domain.OIDCLoginState{}
+
+	err := r.db.QueryRowContext(ctx, query, stateHash).Scan(
+		&state.StateHash,
+		&state.Provider,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.ExpiresAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &state, nil
+}
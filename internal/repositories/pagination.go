@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// appendPage appends the condition, ordering and limit selecting a page to a query ending with
+// a WHERE clause. Rows are ordered by the creation time then id columns, both descending; the
+// page starts after its cursor, or after skipping its offset when there is no cursor. args are
+// the arguments of the query so far.
+func appendPage(query string, args []any, page domain.PageRequest, createdAtColumn, idColumn string) (string, []any) {
+	if page.After != nil {
+		query += fmt.Sprintf("AND (%s, %s) < ($%d, $%d)\n", createdAtColumn, idColumn, len(args)+1, len(args)+2)
+		args = append(args, page.After.CreatedAt, page.After.ID)
+	}
+
+	query += fmt.Sprintf("ORDER BY %s DESC, %s DESC\nLIMIT $%d", createdAtColumn, idColumn, len(args)+1)
+	args = append(args, page.Limit)
+
+	if page.After == nil && page.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, page.Offset)
+	}
+
+	return query, args
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+type PersonalAccessTokenRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPersonalAccessTokenRepository(db *sql.DB) interfaces.PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepositoryImpl{db: db}
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) Create(ctx context.Context, token *domain.PersonalAccessToken) (*domain.PersonalAccessToken, error) {
+	query := `
+		INSERT INTO personal_access_tokens (user_id, name, token_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, user_id, name, token_hash, scopes, expires_at, last_used_at, created_at, revoked_at
+		`
+
+	row := r.db.QueryRowContext(
+		ctx,
+		query,
+		token.UserID,
+		token.Name,
+		token.TokenHash,
+		pq.Array(scopesToStrings(token.Scopes)),
+		token.ExpiresAt,
+	)
+
+	return scanPersonalAccessToken(row)
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, created_at, revoked_at
+		FROM personal_access_tokens
+		WHERE token_hash = $1
+		`
+
+	token, err := scanPersonalAccessToken(r.db.QueryRowContext(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) ListActiveByUserID(ctx context.Context, userId int64) ([]domain.PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, created_at, revoked_at
+		FROM personal_access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	personalAccessTokens := make([]domain.PersonalAccessToken, 0)
+
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		personalAccessTokens = append(personalAccessTokens, *token)
+	}
+
+	return personalAccessTokens, nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) Touch(ctx context.Context, tokenId int64) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET last_used_at = NOW()
+		WHERE id = $1
+		`
+
+	_, err := r.db.ExecContext(ctx, query, tokenId)
+
+	return err
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) Revoke(ctx context.Context, userId int64, tokenId int64) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		`
+
+	result, err := r.db.ExecContext(ctx, query, tokenId, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) RevokeAllForUser(ctx context.Context, userId int64) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPersonalAccessToken(row rowScanner) (*domain.PersonalAccessToken, error) {
+	token := domain.PersonalAccessToken{}
+	var scopes []string
+
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.TokenHash,
+		pq.Array(&scopes),
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+		&token.CreatedAt,
+		&token.RevokedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token.Scopes = make([]domain.Scope, len(scopes))
+	for i, scope := range scopes {
+		token.Scopes[i] = domain.Scope(scope)
+	}
+
+	return &token, nil
+}
+
+func scopesToStrings(scopes []domain.Scope) []string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return values
+}
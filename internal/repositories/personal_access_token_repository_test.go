@@ -0,0 +1,109 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var personalAccessTokenColumns = []string{"id", "user_id", "name", "token_hash", "scopes", "expires_at", "last_used_at", "created_at", "revoked_at"}
+
+func TestPersonalAccessTokenRepositoryImpl_Create(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPersonalAccessTokenRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`INSERT INTO personal_access_tokens \(user_id, name, token_hash, scopes, expires_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+		WithArgs(int64(7), "ci", "hash", pq.Array([]string{"posts:read", "posts:write"}), nil).
+		WillReturnRows(sqlmock.NewRows(personalAccessTokenColumns).
+			AddRow(1, 7, "ci", "hash", "{posts:read,posts:write}", nil, nil, now, nil))
+
+	// Act
+	token, err := repo.Create(context.Background(), &domain.PersonalAccessToken{
+		UserID:    7,
+		Name:      "ci",
+		TokenHash: "hash",
+		Scopes:    []domain.Scope{domain.ScopePostsRead, domain.ScopePostsWrite},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), token.ID)
+	assert.Equal(t, []domain.Scope{domain.ScopePostsRead, domain.ScopePostsWrite}, token.Scopes)
+	assert.Nil(t, token.ExpiresAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPersonalAccessTokenRepositoryImpl_GetByHash_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPersonalAccessTokenRepository(db)
+
+	mock.ExpectQuery(`SELECT (.+) FROM personal_access_tokens WHERE token_hash = \$1`).
+		WithArgs("hash").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	token, err := repo.GetByHash(context.Background(), "hash")
+
+	// Assert
+	assert.Nil(t, token)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPersonalAccessTokenRepositoryImpl_ListActiveByUserID(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPersonalAccessTokenRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT (.+) FROM personal_access_tokens WHERE user_id = \$1 AND revoked_at IS NULL ORDER BY created_at DESC`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows(personalAccessTokenColumns).
+			AddRow(2, 7, "deploy", "hash2", "{users:read}", now.Add(time.Hour), now, now, nil).
+			AddRow(1, 7, "ci", "hash1", "{posts:read}", nil, nil, now, nil))
+
+	// Act
+	personalAccessTokens, err := repo.ListActiveByUserID(context.Background(), 7)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, personalAccessTokens, 2) {
+		assert.Equal(t, "deploy", personalAccessTokens[0].Name)
+		assert.NotNil(t, personalAccessTokens[0].ExpiresAt)
+		assert.Equal(t, []domain.Scope{domain.ScopeUsersRead}, personalAccessTokens[0].Scopes)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPersonalAccessTokenRepositoryImpl_Revoke_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPersonalAccessTokenRepository(db)
+
+	mock.ExpectExec(`UPDATE personal_access_tokens SET revoked_at = NOW\(\) WHERE id = \$1 AND user_id = \$2 AND revoked_at IS NULL`).
+		WithArgs(int64(3), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Revoke(context.Background(), 7, 3)
+
+	// Assert
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
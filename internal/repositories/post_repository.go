@@ -7,6 +7,7 @@ import (
 
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/lib/pq"
 )
 
 type PostRepositoryImpl struct {
@@ -18,23 +19,33 @@ func NewPostRepository(db *sql.DB) interfaces.PostRepository {
 }
 
 func (r *PostRepositoryImpl) Create(ctx context.Context, userId int64, createPost *domain.CreatePostDTO) (*domain.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO posts (user_id, content)
-		VALUES ($1, $2)
-		RETURNING id, user_id, content, created_at, updated_at
+		INSERT INTO posts (user_id, content, kind, referenced_post_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, content, kind, referenced_post_id, created_at, updated_at
 		`
 
 	newPost := domain.Post{}
 
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		userId,
 		createPost.Content,
+		createPost.Kind(),
+		createPost.QuotedPostID,
 	).Scan(
 		&newPost.ID,
 		&newPost.UserID,
 		&newPost.Content,
+		&newPost.Kind,
+		&newPost.ReferencedPostID,
 		&newPost.CreatedAt,
 		&newPost.UpdatedAt,
 	)
@@ -43,17 +54,78 @@ func (r *PostRepositoryImpl) Create(ctx context.Context, userId int64, createPos
 		return nil, err
 	}
 
+	if err := addTags(ctx, tx, newPost.ID, createPost.Tags); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &newPost, nil
 }
 
-func (r *PostRepositoryImpl) List(ctx context.Context, limit int, offset int) ([]domain.Post, error) {
+// List lists posts as seen by the viewer, newest first: deleted posts and posts of users
+// blocked either way or muted by the viewer are left out.
+func (r *PostRepositoryImpl) List(ctx context.Context, viewerId int64, page domain.PageRequest) ([]domain.Post, error) {
 	query := `
-		SELECT id, user_id, content, created_at, updated_at
+		SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at
+		FROM posts p
+		WHERE p.is_deleted = false
+		AND NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = $1 AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = $1)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM user_mutes m
+			WHERE m.muter_id = $1 AND m.muted_id = p.user_id
+		)
+		`
+	query, args := appendPage(query, []any{viewerId}, page, "p.created_at", "p.id")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	posts := make([]domain.Post, 0)
+
+	for rows.Next() {
+		post := domain.Post{}
+
+		err := rows.Scan(
+			&post.ID,
+			&post.UserID,
+			&post.Content,
+			&post.Kind,
+			&post.ReferencedPostID,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// ListByUserID lists the posts of a user, newest first.
+func (r *PostRepositoryImpl) ListByUserID(ctx context.Context, userId int64, page domain.PageRequest) ([]domain.Post, error) {
+	query := `
+		SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at
 		FROM posts
-		LIMIT $1 OFFSET $2
+		WHERE user_id = $1 AND is_deleted = false
 		`
+	query, args := appendPage(query, []any{userId}, page, "created_at", "id")
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		return nil, err
@@ -70,6 +142,8 @@ func (r *PostRepositoryImpl) List(ctx context.Context, limit int, offset int) ([
 			&post.ID,
 			&post.UserID,
 			&post.Content,
+			&post.Kind,
+			&post.ReferencedPostID,
 			&post.CreatedAt,
 			&post.UpdatedAt,
 		)
@@ -86,7 +160,7 @@ func (r *PostRepositoryImpl) List(ctx context.Context, limit int, offset int) ([
 
 func (r *PostRepositoryImpl) GetByID(ctx context.Context, postId int64) (*domain.Post, error) {
 	query := `
-		SELECT id, user_id, content, created_at, updated_at
+		SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at
 		FROM posts
 		WHERE id = $1
 		`
@@ -97,6 +171,8 @@ func (r *PostRepositoryImpl) GetByID(ctx context.Context, postId int64) (*domain
 		&post.ID,
 		&post.UserID,
 		&post.Content,
+		&post.Kind,
+		&post.ReferencedPostID,
 		&post.CreatedAt,
 		&post.UpdatedAt,
 	)
@@ -111,17 +187,24 @@ func (r *PostRepositoryImpl) GetByID(ctx context.Context, postId int64) (*domain
 	return &post, nil
 }
 
+// Update updates the content of a post and replaces its tags, in a single transaction.
 func (r *PostRepositoryImpl) Update(ctx context.Context, userId, postId int64, post *domain.UpdatePostDTO) (*domain.Post, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE posts
 		SET content = $1
 		WHERE id = $2 AND user_id = $3
-		RETURNING id, user_id, content, created_at, updated_at
+		RETURNING id, user_id, content, kind, referenced_post_id, created_at, updated_at
 		`
 
 	updatedPost := domain.Post{}
 
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		post.Content,
@@ -131,6 +214,8 @@ func (r *PostRepositoryImpl) Update(ctx context.Context, userId, postId int64, p
 		&updatedPost.ID,
 		&updatedPost.UserID,
 		&updatedPost.Content,
+		&updatedPost.Kind,
+		&updatedPost.ReferencedPostID,
 		&updatedPost.CreatedAt,
 		&updatedPost.UpdatedAt,
 	)
@@ -142,6 +227,29 @@ func (r *PostRepositoryImpl) Update(ctx context.Context, userId, postId int64, p
 		return nil, err
 	}
 
+	tags := post.Tags
+	if tags == nil {
+		// A NULL array would match no tag, leaving every link in place.
+		tags = []string{}
+	}
+
+	unlinkQuery := `
+		DELETE FROM post_tags
+		WHERE post_id = $1 AND NOT (tag = ANY($2))
+		`
+
+	if _, err := tx.ExecContext(ctx, unlinkQuery, postId, pq.Array(tags)); err != nil {
+		return nil, err
+	}
+
+	if err := addTags(ctx, tx, postId, tags); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &updatedPost, nil
 }
 
@@ -162,3 +270,118 @@ func (r *PostRepositoryImpl) Delete(ctx context.Context, userId, postId int64) e
 
 	return nil
 }
+
+// CreateRepost reposts a post, and returns domain.ErrAlreadyReposted when the user reposted it
+// already.
+func (r *PostRepositoryImpl) CreateRepost(ctx context.Context, userId, postId int64) (*domain.Post, error) {
+	query := `
+		INSERT INTO posts (user_id, content, kind, referenced_post_id)
+		VALUES ($1, '', 'repost', $2)
+		ON CONFLICT DO NOTHING
+		RETURNING id, user_id, content, kind, referenced_post_id, created_at, updated_at
+		`
+
+	repost := domain.Post{}
+
+	err := r.db.QueryRowContext(ctx, query, userId, postId).Scan(
+		&repost.ID,
+		&repost.UserID,
+		&repost.Content,
+		&repost.Kind,
+		&repost.ReferencedPostID,
+		&repost.CreatedAt,
+		&repost.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAlreadyReposted
+		}
+		return nil, err
+	}
+
+	return &repost, nil
+}
+
+// DeleteRepost deletes the repost of a post by the user, and returns domain.ErrNotFound when
+// the user did not repost it.
+func (r *PostRepositoryImpl) DeleteRepost(ctx context.Context, userId, postId int64) error {
+	query := `
+		DELETE FROM posts
+		WHERE user_id = $1 AND referenced_post_id = $2 AND kind = 'repost'
+		`
+
+	result, err := r.db.ExecContext(ctx, query, userId, postId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListByIDs lists the posts with the given ids, in no particular order. Posts that do not
+// exist or were deleted are left out.
+func (r *PostRepositoryImpl) ListByIDs(ctx context.Context, postIds []int64) ([]domain.Post, error) {
+	query := `
+		SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at
+		FROM posts
+		WHERE id = ANY($1) AND is_deleted = false
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(postIds))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	posts := make([]domain.Post, 0, len(postIds))
+
+	for rows.Next() {
+		post := domain.Post{}
+
+		err := rows.Scan(
+			&post.ID,
+			&post.UserID,
+			&post.Content,
+			&post.Kind,
+			&post.ReferencedPostID,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// addTags links the post to the tags, keeping the links it has already.
+func addTags(ctx context.Context, tx *sql.Tx, postId int64, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO post_tags (post_id, tag)
+		SELECT $1, UNNEST($2::VARCHAR[])
+		ON CONFLICT DO NOTHING
+		`
+
+	_, err := tx.ExecContext(ctx, query, postId, pq.Array(tags))
+	return err
+}
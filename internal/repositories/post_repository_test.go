@@ -10,6 +10,7 @@ import (
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/mocks"
 	"github.com/floroz/go-social/internal/repositories"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,22 +22,29 @@ func TestPostRepositoryImpl_Create_Success(t *testing.T) {
 
 	createPostDTO := &domain.CreatePostDTO{
 		EditablePostFields: domain.EditablePostFields{
-			Content: "Post Content",
+			Content: "Post Content #go",
+			Tags:    []string{"go"},
 		},
 	}
 
 	expectedPost := &domain.Post{
 		ID:        1,
 		UserID:    1,
-		Content:   "Post Content",
+		Content:   "Post Content #go",
+		Kind:      domain.PostKindPost,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO posts`).
-		WithArgs(expectedPost.UserID, createPostDTO.Content).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "created_at", "updated_at"}).
-			AddRow(expectedPost.ID, expectedPost.UserID, expectedPost.Content, expectedPost.CreatedAt, expectedPost.UpdatedAt))
+		WithArgs(expectedPost.UserID, createPostDTO.Content, domain.PostKindPost, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(expectedPost.ID, expectedPost.UserID, expectedPost.Content, expectedPost.Kind, nil, expectedPost.CreatedAt, expectedPost.UpdatedAt))
+	mock.ExpectExec(`INSERT INTO post_tags \(post_id, tag\) SELECT \$1, UNNEST\(\$2::VARCHAR\[\]\) ON CONFLICT DO NOTHING`).
+		WithArgs(expectedPost.ID, pq.Array([]string{"go"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	// Act
 	post, err := repo.Create(context.Background(), expectedPost.UserID, createPostDTO)
@@ -59,9 +67,11 @@ func TestPostRepositoryImpl_Create_Error(t *testing.T) {
 			Content: "Post Content",
 		},
 	}
+	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT INTO posts").
-		WithArgs(int64(1), createPostDTO.Content).
+		WithArgs(int64(1), createPostDTO.Content, domain.PostKindPost, nil).
 		WillReturnError(errors.New("some error"))
+	mock.ExpectRollback()
 
 	// Act
 	post, err := repo.Create(context.Background(), int64(1), createPostDTO)
@@ -83,14 +93,15 @@ func TestPostRepositoryImpl_GetByID_Success(t *testing.T) {
 		ID:        postId,
 		UserID:    1,
 		Content:   "Post Content",
+		Kind:      domain.PostKindPost,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	mock.ExpectQuery(`SELECT id, user_id, content, created_at, updated_at FROM posts WHERE id = \$1`).
+	mock.ExpectQuery(`SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at FROM posts WHERE id = \$1`).
 		WithArgs(postId).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "created_at", "updated_at"}).
-			AddRow(expectedPost.ID, expectedPost.UserID, expectedPost.Content, expectedPost.CreatedAt, expectedPost.UpdatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(expectedPost.ID, expectedPost.UserID, expectedPost.Content, expectedPost.Kind, nil, expectedPost.CreatedAt, expectedPost.UpdatedAt))
 
 	// Act
 	post, err := repo.GetByID(context.Background(), postId)
@@ -110,7 +121,7 @@ func TestPostRepositoryImpl_GetByID_Error(t *testing.T) {
 
 	const postId int64 = 1
 
-	mock.ExpectQuery(`SELECT id, user_id, content, created_at, updated_at FROM posts WHERE id = \$1`).
+	mock.ExpectQuery(`SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at FROM posts WHERE id = \$1`).
 		WithArgs(postId).
 		WillReturnError(errors.New("some error"))
 
@@ -123,6 +134,92 @@ func TestPostRepositoryImpl_GetByID_Error(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestPostRepositoryImpl_Update_ReplacesTags(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	const postId, userId int64 = 1, 2
+	updatePostDTO := &domain.UpdatePostDTO{
+		EditablePostFields: domain.EditablePostFields{
+			Content: "Now about #rust",
+			Tags:    []string{"rust"},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE posts SET content = \$1 WHERE id = \$2 AND user_id = \$3 RETURNING id, user_id, content, kind, referenced_post_id, created_at, updated_at`).
+		WithArgs(updatePostDTO.Content, postId, userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(postId, userId, updatePostDTO.Content, "post", nil, time.Time{}, time.Time{}))
+	mock.ExpectExec(`DELETE FROM post_tags WHERE post_id = \$1 AND NOT \(tag = ANY\(\$2\)\)`).
+		WithArgs(postId, pq.Array([]string{"rust"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO post_tags`).
+		WithArgs(postId, pq.Array([]string{"rust"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	post, err := repo.Update(context.Background(), userId, postId, updatePostDTO)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, updatePostDTO.Content, post.Content)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_Update_RemovesAllTags(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	const postId, userId int64 = 1, 2
+	updatePostDTO := &domain.UpdatePostDTO{
+		EditablePostFields: domain.EditablePostFields{Content: "No tags anymore"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE posts`).
+		WithArgs(updatePostDTO.Content, postId, userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(postId, userId, updatePostDTO.Content, "post", nil, time.Time{}, time.Time{}))
+	mock.ExpectExec(`DELETE FROM post_tags`).
+		WithArgs(postId, pq.Array([]string{})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	// Act
+	_, err := repo.Update(context.Background(), userId, postId, updatePostDTO)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_Update_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE posts`).
+		WithArgs("Content", int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}))
+	mock.ExpectRollback()
+
+	// Act
+	post, err := repo.Update(context.Background(), 2, 1, &domain.UpdatePostDTO{EditablePostFields: domain.EditablePostFields{Content: "Content"}})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, post)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestPostRepositoryImpl_Delete_Success(t *testing.T) {
 	db, mock, cleanup := mocks.SetupMockDB(t)
 	defer cleanup()
@@ -171,23 +268,24 @@ func TestPostRepositoryImpl_List_Success(t *testing.T) {
 
 	repo := repositories.NewPostRepository(db)
 
-	const limit, offset = 10, 0
+	const viewerId int64 = 3
+	page := domain.PageRequest{Limit: 10, Offset: 20}
 	expectedPosts := []domain.Post{
-		{ID: 1, UserID: 1, Content: "Content 1"},
-		{ID: 2, UserID: 2, Content: "Content 2"},
+		{ID: 1, UserID: 1, Content: "Content 1", Kind: domain.PostKindPost},
+		{ID: 2, UserID: 2, Content: "Content 2", Kind: domain.PostKindPost},
 	}
 
 	post1 := expectedPosts[0]
 	post2 := expectedPosts[1]
 
-	mock.ExpectQuery(`SELECT id, user_id, content, created_at, updated_at FROM posts LIMIT \$1 OFFSET \$2`).
-		WithArgs(limit, offset).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "created_at", "updated_at"}).
-			AddRow(post1.ID, post1.UserID, post1.Content, post1.CreatedAt, post1.UpdatedAt).
-			AddRow(post2.ID, post2.UserID, post2.Content, post2.CreatedAt, post2.UpdatedAt))
+	mock.ExpectQuery(`SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at FROM posts p WHERE p.is_deleted = false AND NOT EXISTS \( SELECT 1 FROM user_blocks b .+ AND NOT EXISTS \( SELECT 1 FROM user_mutes m WHERE m.muter_id = \$1 AND m.muted_id = p.user_id \) ORDER BY p.created_at DESC, p.id DESC LIMIT \$2 OFFSET \$3`).
+		WithArgs(viewerId, page.Limit, page.Offset).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(post1.ID, post1.UserID, post1.Content, post1.Kind, nil, post1.CreatedAt, post1.UpdatedAt).
+			AddRow(post2.ID, post2.UserID, post2.Content, post2.Kind, nil, post2.CreatedAt, post2.UpdatedAt))
 
 	// Act
-	posts, err := repo.List(context.Background(), limit, offset)
+	posts, err := repo.List(context.Background(), viewerId, page)
 
 	// Assert
 	assert.Nil(t, err)
@@ -202,17 +300,134 @@ func TestPostRepositoryImpl_List_Error(t *testing.T) {
 
 	repo := repositories.NewPostRepository(db)
 
-	const limit, offset = 10, 0
+	const viewerId int64 = 3
+	page := domain.PageRequest{Limit: 10, Offset: 20}
 
-	mock.ExpectQuery(`SELECT id, user_id, content, created_at, updated_at FROM posts LIMIT \$1 OFFSET \$2`).
-		WithArgs(limit, offset).
+	mock.ExpectQuery(`SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at FROM posts p WHERE p.is_deleted = false AND NOT EXISTS \( SELECT 1 FROM user_blocks b .+ AND NOT EXISTS \( SELECT 1 FROM user_mutes m WHERE m.muter_id = \$1 AND m.muted_id = p.user_id \) ORDER BY p.created_at DESC, p.id DESC LIMIT \$2 OFFSET \$3`).
+		WithArgs(viewerId, page.Limit, page.Offset).
 		WillReturnError(errors.New("some error"))
 
 	// Act
-	posts, err := repo.List(context.Background(), limit, offset)
+	posts, err := repo.List(context.Background(), viewerId, page)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, posts)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestPostRepositoryImpl_ListByUserID_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	const userId int64 = 1
+	const viewerId int64 = 3
+	page := domain.PageRequest{After: &domain.Cursor{CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: 42}, Limit: 11}
+	expectedPosts := []domain.Post{
+		{ID: 2, UserID: userId, Content: "Content 2", Kind: domain.PostKindPost},
+		{ID: 1, UserID: userId, Content: "Content 1", Kind: domain.PostKindPost},
+	}
+
+	mock.ExpectQuery(`SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at FROM posts WHERE user_id = \$1 AND is_deleted = false AND \(created_at, id\) < \(\$2, \$3\) ORDER BY created_at DESC, id DESC LIMIT \$4`).
+		WithArgs(userId, page.After.CreatedAt, page.After.ID, page.Limit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(expectedPosts[0].ID, expectedPosts[0].UserID, expectedPosts[0].Content, expectedPosts[0].Kind, nil, expectedPosts[0].CreatedAt, expectedPosts[0].UpdatedAt).
+			AddRow(expectedPosts[1].ID, expectedPosts[1].UserID, expectedPosts[1].Content, expectedPosts[1].Kind, nil, expectedPosts[1].CreatedAt, expectedPosts[1].UpdatedAt))
+
+	// Act
+	posts, err := repo.ListByUserID(context.Background(), userId, page)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPosts, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_CreateRepost_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	referencedPostId := int64(5)
+	expectedRepost := &domain.Post{
+		ID:               6,
+		UserID:           1,
+		Kind:             domain.PostKindRepost,
+		ReferencedPostID: &referencedPostId,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	mock.ExpectQuery(`INSERT INTO posts \(user_id, content, kind, referenced_post_id\) VALUES \(\$1, '', 'repost', \$2\) ON CONFLICT DO NOTHING RETURNING id, user_id, content, kind, referenced_post_id, created_at, updated_at`).
+		WithArgs(int64(1), referencedPostId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(expectedRepost.ID, expectedRepost.UserID, "", "repost", referencedPostId, expectedRepost.CreatedAt, expectedRepost.UpdatedAt))
+
+	// Act
+	repost, err := repo.CreateRepost(context.Background(), 1, referencedPostId)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRepost, repost)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_CreateRepost_AlreadyReposted(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO posts`).
+		WithArgs(int64(1), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}))
+
+	// Act
+	repost, err := repo.CreateRepost(context.Background(), 1, 5)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAlreadyReposted)
+	assert.Nil(t, repost)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_DeleteRepost_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	mock.ExpectExec(`DELETE FROM posts WHERE user_id = \$1 AND referenced_post_id = \$2 AND kind = 'repost'`).
+		WithArgs(int64(1), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.DeleteRepost(context.Background(), 1, 5)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepositoryImpl_ListByIDs_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewPostRepository(db)
+
+	mock.ExpectQuery(`SELECT id, user_id, content, kind, referenced_post_id, created_at, updated_at FROM posts WHERE id = ANY\(\$1\) AND is_deleted = false`).
+		WithArgs(pq.Array([]int64{1, 2})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(int64(2), int64(1), "Content 2", "post", nil, time.Time{}, time.Time{}))
+
+	// Act
+	posts, err := repo.ListByIDs(context.Background(), []int64{1, 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Post{{ID: 2, UserID: 1, Content: "Content 2", Kind: domain.PostKindPost}}, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+type ReactionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewReactionRepository(db *sql.DB) interfaces.ReactionRepository {
+	return &ReactionRepositoryImpl{db: db}
+}
+
+// reactionTargetColumns are the columns of the reactions and reaction_counts tables
+// referencing each type of target.
+var reactionTargetColumns = map[domain.ReactionTargetType]string{
+	domain.ReactionTargetPost:    "post_id",
+	domain.ReactionTargetComment: "comment_id",
+}
+
+func reactionTargetColumn(targetType domain.ReactionTargetType) (string, error) {
+	column, ok := reactionTargetColumns[targetType]
+	if !ok {
+		return "", fmt.Errorf("unknown reaction target type %q", targetType)
+	}
+	return column, nil
+}
+
+// Add leaves the counts to the update_reaction_counts trigger, which only counts the
+// reactions actually inserted.
+func (r *ReactionRepositoryImpl) Add(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	column, err := reactionTargetColumn(target.Type)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO reactions (user_id, %s, type)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		`, column)
+
+	_, err = r.db.ExecContext(ctx, query, userId, target.ID, reactionType)
+	return err
+}
+
+func (r *ReactionRepositoryImpl) Remove(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	column, err := reactionTargetColumn(target.Type)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM reactions
+		WHERE user_id = $1 AND %s = $2 AND type = $3
+		`, column)
+
+	result, err := r.db.ExecContext(ctx, query, userId, target.ID, reactionType)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Summarize reads the counts and the reactions of the viewer in a single statement, so that
+// both come from the same snapshot.
+func (r *ReactionRepositoryImpl) Summarize(ctx context.Context, viewerId int64, targetType domain.ReactionTargetType, targetIds []int64) (map[int64]domain.Reactions, error) {
+	summaries := map[int64]domain.Reactions{}
+	if len(targetIds) == 0 {
+		return summaries, nil
+	}
+
+	column, err := reactionTargetColumn(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT c.%[1]s, c.type, c.count, EXISTS (
+			SELECT 1 FROM reactions r
+			WHERE r.%[1]s = c.%[1]s AND r.user_id = $2 AND r.type = c.type
+		) AS mine
+		FROM reaction_counts c
+		WHERE c.%[1]s = ANY($1) AND c.count > 0
+		ORDER BY c.%[1]s, c.type
+		`, column)
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(targetIds), viewerId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			targetId     int64
+			reactionType string
+			count        int64
+			mine         bool
+		)
+		if err := rows.Scan(&targetId, &reactionType, &count, &mine); err != nil {
+			return nil, err
+		}
+
+		summary, ok := summaries[targetId]
+		if !ok {
+			summary = domain.Reactions{Counts: map[string]int64{}, Mine: []string{}}
+		}
+		summary.Counts[reactionType] = count
+		if mine {
+			summary.Mine = append(summary.Mine, reactionType)
+		}
+		summaries[targetId] = summary
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
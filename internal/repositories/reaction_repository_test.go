@@ -0,0 +1,141 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionRepositoryImpl_Add_Post(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	mock.ExpectExec(`INSERT INTO reactions \(user_id, post_id, type\) VALUES \(\$1, \$2, \$3\) ON CONFLICT DO NOTHING`).
+		WithArgs(int64(1), int64(2), "like").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err := repo.Add(context.Background(), 1, domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 2}, "like")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Add_UnknownTargetType(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	// Act
+	err := repo.Add(context.Background(), 1, domain.ReactionTarget{Type: "user", ID: 2}, "like")
+
+	// Assert
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Remove_Comment(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	mock.ExpectExec(`DELETE FROM reactions WHERE user_id = \$1 AND comment_id = \$2 AND type = \$3`).
+		WithArgs(int64(1), int64(3), "laugh").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err := repo.Remove(context.Background(), 1, domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: 3}, "laugh")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Remove_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	mock.ExpectExec(`DELETE FROM reactions WHERE user_id = \$1 AND post_id = \$2 AND type = \$3`).
+		WithArgs(int64(1), int64(2), "like").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Remove(context.Background(), 1, domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 2}, "like")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Summarize_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	mock.ExpectQuery(`SELECT c.post_id, c.type, c.count, EXISTS \( SELECT 1 FROM reactions r WHERE r.post_id = c.post_id AND r.user_id = \$2 AND r.type = c.type \) AS mine FROM reaction_counts c WHERE c.post_id = ANY\(\$1\) AND c.count > 0`).
+		WithArgs(pq.Array([]int64{1, 2, 3}), int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "type", "count", "mine"}).
+			AddRow(1, "laugh", 1, false).
+			AddRow(1, "like", 3, true).
+			AddRow(2, "love", 2, true))
+
+	// Act
+	summaries, err := repo.Summarize(context.Background(), 7, domain.ReactionTargetPost, []int64{1, 2, 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]domain.Reactions{
+		1: {Counts: map[string]int64{"laugh": 1, "like": 3}, Mine: []string{"like"}},
+		2: {Counts: map[string]int64{"love": 2}, Mine: []string{"love"}},
+	}, summaries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Summarize_NoTargets(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	// Act
+	summaries, err := repo.Summarize(context.Background(), 7, domain.ReactionTargetComment, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, summaries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepositoryImpl_Summarize_Error(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewReactionRepository(db)
+
+	mock.ExpectQuery(`FROM reaction_counts c WHERE c.comment_id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{1}), int64(7)).
+		WillReturnError(errors.New("some error"))
+
+	// Act
+	summaries, err := repo.Summarize(context.Background(), 7, domain.ReactionTargetComment, []int64{1})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, summaries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
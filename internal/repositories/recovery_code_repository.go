@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+type RecoveryCodeRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewRecoveryCodeRepository(db *sql.DB) interfaces.RecoveryCodeRepository {
+	return &RecoveryCodeRepositoryImpl{db: db}
+}
+
+// ReplaceAll swaps the recovery codes of the user for a new set in a single statement,
+// so the old codes stop working exactly when the new ones become valid.
+func (r *RecoveryCodeRepositoryImpl) ReplaceAll(ctx context.Context, userId int64, codeHashes []string) error {
+	query := `
+		WITH deleted AS (
+			DELETE FROM recovery_codes WHERE user_id = $1
+		)
+		INSERT INTO recovery_codes (user_id, code_hash)
+		SELECT $1, unnest($2::text[])
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId, pq.Array(codeHashes))
+
+	return err
+}
+
+// Consume marks an unused recovery code as used. It returns domain.ErrNotFound for unknown
+// or already used codes.
+func (r *RecoveryCodeRepositoryImpl) Consume(ctx context.Context, userId int64, codeHash string) error {
+	query := `
+		UPDATE recovery_codes
+		SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		`
+
+	result, err := r.db.ExecContext(ctx, query, userId, codeHash)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *RecoveryCodeRepositoryImpl) CountUnused(ctx context.Context, userId int64) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+		`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(&count)
+
+	return count, err
+}
+
+func (r *RecoveryCodeRepositoryImpl) DeleteAll(ctx context.Context, userId int64) error {
+	query := `
+		DELETE FROM recovery_codes
+		WHERE user_id = $1
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
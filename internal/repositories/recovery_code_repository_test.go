@@ -0,0 +1,69 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryCodeRepositoryImpl_ReplaceAll(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRecoveryCodeRepository(db)
+
+	hashes := []string{"hash1", "hash2"}
+	mock.ExpectExec(`WITH deleted AS \( DELETE FROM recovery_codes WHERE user_id = \$1 \) INSERT INTO recovery_codes \(user_id, code_hash\) SELECT \$1, unnest\(\$2::text\[\]\)`).
+		WithArgs(int64(7), pq.Array(hashes)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	// Act
+	err := repo.ReplaceAll(context.Background(), 7, hashes)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecoveryCodeRepositoryImpl_Consume_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRecoveryCodeRepository(db)
+
+	mock.ExpectExec(`UPDATE recovery_codes SET used_at = NOW\(\) WHERE user_id = \$1 AND code_hash = \$2 AND used_at IS NULL`).
+		WithArgs(int64(7), "hash").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Act
+	err := repo.Consume(context.Background(), 7, "hash")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecoveryCodeRepositoryImpl_Consume_AlreadyUsed(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRecoveryCodeRepository(db)
+
+	mock.ExpectExec(`UPDATE recovery_codes SET used_at = NOW\(\)`).
+		WithArgs(int64(7), "hash").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Consume(context.Background(), 7, "hash")
+
+	// Assert
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
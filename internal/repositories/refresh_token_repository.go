@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type RefreshTokenRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewRefreshTokenRepository(db *sql.DB) interfaces.RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{db: db}
+}
+
+func (r *RefreshTokenRepositoryImpl) Create(ctx context.Context, userId int64, familyId, tokenHash string, expiresAt time.Time) (*domain.RefreshToken, error) {
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, family_id, token_hash, expires_at, created_at, revoked_at
+		`
+
+	token := domain.RefreshToken{}
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		userId,
+		familyId,
+		tokenHash,
+		expiresAt,
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.RevokedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *RefreshTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, created_at, revoked_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+		`
+
+	token := domain.RefreshToken{}
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.RevokedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Revoke marks a single token as used. It returns domain.ErrNotFound when the
+// token was already revoked, which lets callers detect concurrent rotations.
+func (r *RefreshTokenRepositoryImpl) Revoke(ctx context.Context, tokenId int64) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		`
+
+	result, err := r.db.ExecContext(ctx, query, tokenId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyId string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := r.db.ExecContext(ctx, query, familyId)
+
+	return err
+}
+
+func (r *RefreshTokenRepositoryImpl) RevokeAllForUser(ctx context.Context, userId int64) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
@@ -0,0 +1,101 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var refreshTokenColumns = []string{"id", "user_id", "family_id", "token_hash", "expires_at", "created_at", "revoked_at"}
+
+func TestRefreshTokenRepositoryImpl_Create_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRefreshTokenRepository(db)
+
+	expiresAt := time.Now().Add(time.Hour)
+	expected := &domain.RefreshToken{
+		ID:        1,
+		UserID:    2,
+		FamilyID:  "family",
+		TokenHash: "hash",
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectQuery(`INSERT INTO refresh_tokens`).
+		WithArgs(expected.UserID, expected.FamilyID, expected.TokenHash, expiresAt).
+		WillReturnRows(sqlmock.NewRows(refreshTokenColumns).
+			AddRow(expected.ID, expected.UserID, expected.FamilyID, expected.TokenHash, expected.ExpiresAt, expected.CreatedAt, nil))
+
+	// Act
+	token, err := repo.Create(context.Background(), expected.UserID, expected.FamilyID, expected.TokenHash, expiresAt)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepositoryImpl_GetByHash_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRefreshTokenRepository(db)
+
+	mock.ExpectQuery(`SELECT (.+) FROM refresh_tokens WHERE token_hash = \$1`).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(refreshTokenColumns))
+
+	// Act
+	token, err := repo.GetByHash(context.Background(), "missing")
+
+	// Assert
+	assert.Nil(t, token)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepositoryImpl_Revoke_AlreadyRevoked(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRefreshTokenRepository(db)
+
+	mock.ExpectExec(`UPDATE refresh_tokens SET revoked_at = NOW\(\) WHERE id = \$1 AND revoked_at IS NULL`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Revoke(context.Background(), 1)
+
+	// Assert
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshTokenRepositoryImpl_RevokeFamily_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewRefreshTokenRepository(db)
+
+	mock.ExpectExec(`UPDATE refresh_tokens SET revoked_at = NOW\(\) WHERE family_id = \$1`).
+		WithArgs("family").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// Act
+	err := repo.RevokeFamily(context.Background(), "family")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
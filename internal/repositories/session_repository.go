@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type SessionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewSessionRepository(db *sql.DB) interfaces.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+func (r *SessionRepositoryImpl) Create(ctx context.Context, sessionId string, userId int64, client *domain.SessionClient) (*domain.Session, error) {
+	query := `
+		INSERT INTO sessions (id, user_id, user_agent, ip_address)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), created_at, last_used_at, revoked_at
+		`
+
+	session := domain.Session{}
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		sessionId,
+		userId,
+		client.UserAgent,
+		client.IPAddress,
+	).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.RevokedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *SessionRepositoryImpl) GetByID(ctx context.Context, sessionId string) (*domain.Session, error) {
+	query := `
+		SELECT id, user_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), created_at, last_used_at, revoked_at
+		FROM sessions
+		WHERE id = $1
+		`
+
+	session := domain.Session{}
+
+	err := r.db.QueryRowContext(ctx, query, sessionId).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.RevokedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *SessionRepositoryImpl) ListActiveByUserID(ctx context.Context, userId int64) ([]domain.Session, error) {
+	query := `
+		SELECT id, user_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), created_at, last_used_at, revoked_at
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY last_used_at DESC
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, userId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	sessions := make([]domain.Session, 0)
+
+	for rows.Next() {
+		session := domain.Session{}
+
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.CreatedAt,
+			&session.LastUsedAt,
+			&session.RevokedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (r *SessionRepositoryImpl) Touch(ctx context.Context, sessionId string) error {
+	query := `
+		UPDATE sessions
+		SET last_used_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		`
+
+	_, err := r.db.ExecContext(ctx, query, sessionId)
+
+	return err
+}
+
+func (r *SessionRepositoryImpl) Revoke(ctx context.Context, userId int64, sessionId string) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		`
+
+	result, err := r.db.ExecContext(ctx, query, sessionId, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// RevokeAllForUser revokes every active session of the user except exceptSessionId.
+// Pass an empty exceptSessionId to revoke all of them.
+func (r *SessionRepositoryImpl) RevokeAllForUser(ctx context.Context, userId int64, exceptSessionId string) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL AND ($2 = '' OR id::text <> $2)
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId, exceptSessionId)
+
+	return err
+}
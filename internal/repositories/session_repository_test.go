@@ -0,0 +1,105 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var sessionColumns = []string{"id", "user_id", "user_agent", "ip_address", "created_at", "last_used_at", "revoked_at"}
+
+func TestSessionRepositoryImpl_Create_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewSessionRepository(db)
+
+	client := &domain.SessionClient{UserAgent: "agent", IPAddress: "127.0.0.1"}
+	expected := &domain.Session{
+		ID:         "session-1",
+		UserID:     1,
+		UserAgent:  client.UserAgent,
+		IPAddress:  client.IPAddress,
+		CreatedAt:  time.Now(),
+		LastUsedAt: time.Now(),
+	}
+
+	mock.ExpectQuery(`INSERT INTO sessions`).
+		WithArgs(expected.ID, expected.UserID, client.UserAgent, client.IPAddress).
+		WillReturnRows(sqlmock.NewRows(sessionColumns).
+			AddRow(expected.ID, expected.UserID, expected.UserAgent, expected.IPAddress, expected.CreatedAt, expected.LastUsedAt, nil))
+
+	// Act
+	session, err := repo.Create(context.Background(), expected.ID, expected.UserID, client)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, session)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepositoryImpl_ListActiveByUserID_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewSessionRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT (.+) FROM sessions WHERE user_id = \$1 AND revoked_at IS NULL ORDER BY last_used_at DESC`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows(sessionColumns).
+			AddRow("s1", 1, "agent-1", "10.0.0.1", now, now, nil).
+			AddRow("s2", 1, "agent-2", "10.0.0.2", now, now, nil))
+
+	// Act
+	sessions, err := repo.ListActiveByUserID(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "s1", sessions[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepositoryImpl_Revoke_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewSessionRepository(db)
+
+	mock.ExpectExec(`UPDATE sessions SET revoked_at = NOW\(\) WHERE id = \$1 AND user_id = \$2 AND revoked_at IS NULL`).
+		WithArgs("s1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.Revoke(context.Background(), 1, "s1")
+
+	// Assert
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepositoryImpl_RevokeAllForUser_KeepsCurrent(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewSessionRepository(db)
+
+	mock.ExpectExec(`UPDATE sessions SET revoked_at = NOW\(\) WHERE user_id = \$1`).
+		WithArgs(int64(1), "current").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	// Act
+	err := repo.RevokeAllForUser(context.Background(), 1, "current")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
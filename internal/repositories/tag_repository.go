@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type TagRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewTagRepository(db *sql.DB) interfaces.TagRepository {
+	return &TagRepositoryImpl{db: db}
+}
+
+func (r *TagRepositoryImpl) ListPosts(ctx context.Context, viewerId int64, tag string, page domain.PageRequest) ([]domain.Post, error) {
+	query := `
+		SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at
+		FROM post_tags t
+		JOIN posts p ON p.id = t.post_id
+		WHERE t.tag = $2
+		AND p.is_deleted = false
+		AND NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = $1 AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = $1)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM user_mutes m
+			WHERE m.muter_id = $1 AND m.muted_id = p.user_id
+		)
+		`
+	query, args := appendPage(query, []any{viewerId, tag}, page, "p.created_at", "p.id")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	posts := make([]domain.Post, 0)
+
+	for rows.Next() {
+		post := domain.Post{}
+
+		err := rows.Scan(
+			&post.ID,
+			&post.UserID,
+			&post.Content,
+			&post.Kind,
+			&post.ReferencedPostID,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// ListTrending counts the posts by their creation time rather than by when they were tagged,
+// so that editing an old post does not make its tags trend.
+func (r *TagRepositoryImpl) ListTrending(ctx context.Context, since time.Time, limit int) ([]domain.TrendingTag, error) {
+	query := `
+		SELECT t.tag, COUNT(*) AS post_count
+		FROM post_tags t
+		JOIN posts p ON p.id = t.post_id
+		WHERE p.created_at >= $1 AND p.is_deleted = false
+		GROUP BY t.tag
+		ORDER BY post_count DESC, t.tag
+		LIMIT $2
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, since, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tags := make([]domain.TrendingTag, 0)
+
+	for rows.Next() {
+		tag := domain.TrendingTag{}
+
+		if err := rows.Scan(&tag.Tag, &tag.PostCount); err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,78 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagRepositoryImpl_ListPosts_AfterCursor(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTagRepository(db)
+
+	after := domain.Cursor{CreatedAt: time.Now(), ID: 10}
+	createdAt := time.Now()
+	mock.ExpectQuery(`SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at FROM post_tags t JOIN posts p ON p.id = t.post_id WHERE t.tag = \$2 AND p.is_deleted = false AND NOT EXISTS \( SELECT 1 FROM user_blocks b .+ AND NOT EXISTS \( SELECT 1 FROM user_mutes m WHERE m.muter_id = \$1 AND m.muted_id = p.user_id \) AND \(p.created_at, p.id\) < \(\$3, \$4\) ORDER BY p.created_at DESC, p.id DESC LIMIT \$5`).
+		WithArgs(int64(1), "golang", after.CreatedAt, after.ID, 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(int64(9), int64(2), "Hello #golang", "post", nil, createdAt, createdAt))
+
+	// Act
+	posts, err := repo.ListPosts(context.Background(), 1, "golang", domain.PageRequest{After: &after, Limit: 21})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Post{{ID: 9, UserID: 2, Content: "Hello #golang", Kind: domain.PostKindPost, CreatedAt: createdAt, UpdatedAt: createdAt}}, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepositoryImpl_ListTrending_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTagRepository(db)
+
+	since := time.Now().Add(-24 * time.Hour)
+	mock.ExpectQuery(`SELECT t.tag, COUNT\(\*\) AS post_count FROM post_tags t JOIN posts p ON p.id = t.post_id WHERE p.created_at >= \$1 AND p.is_deleted = false GROUP BY t.tag ORDER BY post_count DESC, t.tag LIMIT \$2`).
+		WithArgs(since, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "post_count"}).
+			AddRow("golang", 3).
+			AddRow("rust", 1))
+
+	// Act
+	tags, err := repo.ListTrending(context.Background(), since, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TrendingTag{{Tag: "golang", PostCount: 3}, {Tag: "rust", PostCount: 1}}, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTagRepositoryImpl_ListTrending_Error(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTagRepository(db)
+
+	since := time.Now()
+	mock.ExpectQuery(`FROM post_tags t`).
+		WithArgs(since, 10).
+		WillReturnError(errors.New("some error"))
+
+	// Act
+	tags, err := repo.ListTrending(context.Background(), since, 10)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+// TimelineRepositoryImpl builds timelines when they are read, from the follows of the user.
+// Timelines can later be written to a table as posts are created instead, behind the same
+// interface.
+type TimelineRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewTimelineRepository(db *sql.DB) interfaces.TimelineRepository {
+	return &TimelineRepositoryImpl{db: db}
+}
+
+func (r *TimelineRepositoryImpl) ListHome(ctx context.Context, userId int64, after *domain.Cursor, limit int) ([]domain.Post, error) {
+	query := `
+		SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at
+		FROM posts p
+		WHERE (p.user_id = $1 OR p.user_id IN (SELECT followee_id FROM follows WHERE follower_id = $1))
+		AND p.is_deleted = false
+		AND NOT EXISTS (
+			SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id = $1 AND b.blocked_id = p.user_id) OR (b.blocker_id = p.user_id AND b.blocked_id = $1)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM user_mutes m
+			WHERE m.muter_id = $1 AND m.muted_id = p.user_id
+		)
+		`
+	args := []any{userId, limit}
+
+	if after != nil {
+		query += `AND (p.created_at, p.id) < ($3, $4)
+		`
+		args = append(args, after.CreatedAt, after.ID)
+	}
+
+	query += `ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $2
+		`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	posts := make([]domain.Post, 0)
+
+	for rows.Next() {
+		post := domain.Post{}
+
+		err := rows.Scan(
+			&post.ID,
+			&post.UserID,
+			&post.Content,
+			&post.Kind,
+			&post.ReferencedPostID,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
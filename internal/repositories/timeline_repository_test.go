@@ -0,0 +1,55 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineRepositoryImpl_ListHome_FirstPage(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTimelineRepository(db)
+
+	createdAt := time.Now()
+	mock.ExpectQuery(`SELECT p.id, p.user_id, p.content, p.kind, p.referenced_post_id, p.created_at, p.updated_at FROM posts p WHERE \(p.user_id = \$1 OR p.user_id IN \(SELECT followee_id FROM follows WHERE follower_id = \$1\)\) AND p.is_deleted = false AND NOT EXISTS \( SELECT 1 FROM user_blocks b .+ AND NOT EXISTS \( SELECT 1 FROM user_mutes m WHERE m.muter_id = \$1 AND m.muted_id = p.user_id \) ORDER BY p.created_at DESC, p.id DESC LIMIT \$2`).
+		WithArgs(int64(1), 21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "kind", "referenced_post_id", "created_at", "updated_at"}).
+			AddRow(int64(10), int64(2), "Hello", "post", nil, createdAt, createdAt))
+
+	// Act
+	posts, err := repo.ListHome(context.Background(), 1, nil, 21)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Post{{ID: 10, UserID: 2, Content: "Hello", Kind: domain.PostKindPost, CreatedAt: createdAt, UpdatedAt: createdAt}}, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTimelineRepositoryImpl_ListHome_AfterCursor(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTimelineRepository(db)
+
+	after := domain.Cursor{CreatedAt: time.Now(), ID: 10}
+	mock.ExpectQuery(`AND \(p.created_at, p.id\) < \(\$3, \$4\) ORDER BY p.created_at DESC, p.id DESC LIMIT \$2`).
+		WithArgs(int64(1), 21, after.CreatedAt, after.ID).
+		WillReturnError(errors.New("some error"))
+
+	// Act
+	posts, err := repo.ListHome(context.Background(), 1, &after, 21)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, posts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type TOTPRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewTOTPRepository(db *sql.DB) interfaces.TOTPRepository {
+	return &TOTPRepositoryImpl{db: db}
+}
+
+func (r *TOTPRepositoryImpl) GetByUserID(ctx context.Context, userId int64) (*domain.UserTOTP, error) {
+	query := `
+		SELECT user_id, secret, confirmed_at, last_used_step, failed_attempts, created_at
+		FROM user_totp
+		WHERE user_id = $1
+		`
+
+	totp := domain.UserTOTP{}
+
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(
+		&totp.UserID,
+		&totp.Secret,
+		&totp.ConfirmedAt,
+		&totp.LastUsedStep,
+		&totp.FailedAttempts,
+		&totp.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &totp, nil
+}
+
+// UpsertPending starts or restarts an enrollment with a new secret. A confirmed second factor
+// is never overwritten: domain.ErrNotFound is returned instead.
+func (r *TOTPRepositoryImpl) UpsertPending(ctx context.Context, userId int64, secret string) (*domain.UserTOTP, error) {
+	query := `
+		INSERT INTO user_totp (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, failed_attempts = 0, created_at = NOW()
+		WHERE user_totp.confirmed_at IS NULL
+		RETURNING user_id, secret, confirmed_at, last_used_step, failed_attempts, created_at
+		`
+
+	totp := domain.UserTOTP{}
+
+	err := r.db.QueryRowContext(ctx, query, userId, secret).Scan(
+		&totp.UserID,
+		&totp.Secret,
+		&totp.ConfirmedAt,
+		&totp.LastUsedStep,
+		&totp.FailedAttempts,
+		&totp.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &totp, nil
+}
+
+// Confirm enables a pending second factor with the time step of its first valid code.
+// It returns domain.ErrNotFound when there is no pending enrollment.
+func (r *TOTPRepositoryImpl) Confirm(ctx context.Context, userId int64, step int64) error {
+	query := `
+		UPDATE user_totp
+		SET confirmed_at = NOW(), last_used_step = $1, failed_attempts = 0
+		WHERE user_id = $2 AND confirmed_at IS NULL
+		`
+
+	result, err := r.db.ExecContext(ctx, query, step, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// UseStep records an accepted code. Steps only move forward, so a code cannot be used twice;
+// domain.ErrNotFound is returned for a step that is not newer than the last one used.
+func (r *TOTPRepositoryImpl) UseStep(ctx context.Context, userId int64, step int64) error {
+	query := `
+		UPDATE user_totp
+		SET last_used_step = $1, failed_attempts = 0
+		WHERE user_id = $2 AND last_used_step < $1
+		`
+
+	result, err := r.db.ExecContext(ctx, query, step, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *TOTPRepositoryImpl) IncrementFailedAttempts(ctx context.Context, userId int64) (int, error) {
+	query := `
+		UPDATE user_totp
+		SET failed_attempts = failed_attempts + 1
+		WHERE user_id = $1
+		RETURNING failed_attempts
+		`
+
+	var attempts int
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (r *TOTPRepositoryImpl) ResetFailedAttempts(ctx context.Context, userId int64) error {
+	query := `
+		UPDATE user_totp
+		SET failed_attempts = 0
+		WHERE user_id = $1
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
+
+func (r *TOTPRepositoryImpl) Delete(ctx context.Context, userId int64) error {
+	query := `
+		DELETE FROM user_totp
+		WHERE user_id = $1
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
@@ -0,0 +1,93 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var totpColumns = []string{"user_id", "secret", "confirmed_at", "last_used_step", "failed_attempts", "created_at"}
+
+func TestTOTPRepositoryImpl_UpsertPending_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTOTPRepository(db)
+
+	createdAt := time.Now()
+	mock.ExpectQuery(`INSERT INTO user_totp .* ON CONFLICT \(user_id\) DO UPDATE .* WHERE user_totp.confirmed_at IS NULL`).
+		WithArgs(int64(7), "SECRET").
+		WillReturnRows(sqlmock.NewRows(totpColumns).AddRow(7, "SECRET", nil, 0, 0, createdAt))
+
+	// Act
+	totp, err := repo.UpsertPending(context.Background(), 7, "SECRET")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.UserTOTP{UserID: 7, Secret: "SECRET", CreatedAt: createdAt}, totp)
+	assert.False(t, totp.Enabled())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTOTPRepositoryImpl_UpsertPending_AlreadyConfirmed(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTOTPRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO user_totp`).
+		WithArgs(int64(7), "SECRET").
+		WillReturnRows(sqlmock.NewRows(totpColumns))
+
+	// Act
+	totp, err := repo.UpsertPending(context.Background(), 7, "SECRET")
+
+	// Assert
+	assert.Nil(t, totp)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTOTPRepositoryImpl_UseStep_Replay(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTOTPRepository(db)
+
+	mock.ExpectExec(`UPDATE user_totp SET last_used_step = \$1, failed_attempts = 0 WHERE user_id = \$2 AND last_used_step < \$1`).
+		WithArgs(int64(100), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.UseStep(context.Background(), 7, 100)
+
+	// Assert
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTOTPRepositoryImpl_IncrementFailedAttempts(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewTOTPRepository(db)
+
+	mock.ExpectQuery(`UPDATE user_totp SET failed_attempts = failed_attempts \+ 1 WHERE user_id = \$1 RETURNING failed_attempts`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"failed_attempts"}).AddRow(3))
+
+	// Act
+	attempts, err := repo.IncrementFailedAttempts(context.Background(), 7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
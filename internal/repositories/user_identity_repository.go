@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type UserIdentityRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewUserIdentityRepository(db *sql.DB) interfaces.UserIdentityRepository {
+	return &UserIdentityRepositoryImpl{db: db}
+}
+
+func (r *UserIdentityRepositoryImpl) Create(ctx context.Context, identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email, last_login_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, user_id, provider, subject, COALESCE(email, ''), created_at, last_login_at
+		`
+
+	created := domain.UserIdentity{}
+
+	err := r.db.QueryRowContext(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email).Scan(
+		&created.ID,
+		&created.UserID,
+		&created.Provider,
+		&created.Subject,
+		&created.Email,
+		&created.CreatedAt,
+		&created.LastLoginAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *UserIdentityRepositoryImpl) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, COALESCE(email, ''), created_at, last_login_at
+		FROM user_identities
+		WHERE provider = $1 AND subject = $2
+		`
+
+	identity := domain.UserIdentity{}
+
+	err := r.db.QueryRowContext(ctx, query, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+		&identity.LastLoginAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (r *UserIdentityRepositoryImpl) Touch(ctx context.Context, identityId int64) error {
+	query := `UPDATE user_identities SET last_login_at = NOW() WHERE id = $1`
+
+	_, err := r.db.ExecContext(ctx, query, identityId)
+
+	return err
+}
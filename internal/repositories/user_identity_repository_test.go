@@ -0,0 +1,74 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserIdentityRepositoryImpl_GetByProviderSubject_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserIdentityRepository(db)
+
+	mock.ExpectQuery(`SELECT id, user_id, provider, subject, COALESCE\(email, ''\), created_at, last_login_at FROM user_identities WHERE provider = \$1 AND subject = \$2`).
+		WithArgs("google", "sub-1").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	identity, err := repo.GetByProviderSubject(context.Background(), "google", "sub-1")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, identity)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCLoginStateRepositoryImpl_Consume(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewOIDCLoginStateRepository(db)
+
+	expiresAt := time.Now().Add(time.Minute)
+	mock.ExpectQuery(`DELETE FROM oidc_login_states WHERE state_hash = \$1 AND expires_at > NOW\(\) RETURNING state_hash, provider, nonce, code_verifier, expires_at`).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"state_hash", "provider", "nonce", "code_verifier", "expires_at"}).
+			AddRow("hash", "google", "nonce", "verifier", expiresAt))
+
+	// Act
+	state, err := repo.Consume(context.Background(), "hash")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "google", state.Provider)
+	assert.Equal(t, "verifier", state.CodeVerifier)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOIDCLoginStateRepositoryImpl_Consume_UsedOrExpired(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewOIDCLoginStateRepository(db)
+
+	mock.ExpectQuery(`DELETE FROM oidc_login_states WHERE state_hash = \$1`).
+		WithArgs("hash").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	state, err := repo.Consume(context.Background(), "hash")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, state)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
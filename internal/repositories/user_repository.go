@@ -5,11 +5,16 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/lib/pq"
 )
 
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
 type UserRepositoryImpl struct {
 	db *sql.DB
 }
@@ -24,7 +29,7 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, createUser *domain.Crea
 	query := `
         INSERT INTO users (first_name, last_name, email, username, password)
         VALUES ($1, $2, $3, $4, $5)
-        RETURNING id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+        RETURNING id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 		`
 
 	row := r.db.QueryRowContext(
@@ -47,6 +52,12 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, createUser *domain.Crea
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
 	); err != nil {
 		return nil, err
 	}
@@ -56,7 +67,7 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, createUser *domain.Crea
 
 func (r *UserRepositoryImpl) GetByID(ctx context.Context, userId int64) (*domain.User, error) {
 	query := `
-			SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+			SELECT id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 			FROM users
 			WHERE id = $1 AND is_deleted = false`
 
@@ -71,6 +82,12 @@ func (r *UserRepositoryImpl) GetByID(ctx context.Context, userId int64) (*domain
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
 	)
 
 	if err != nil {
@@ -85,7 +102,7 @@ func (r *UserRepositoryImpl) GetByID(ctx context.Context, userId int64) (*domain
 
 func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+		SELECT id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 		FROM users
 		WHERE email = $1 AND is_deleted = false`
 
@@ -100,6 +117,12 @@ func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*dom
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
 	)
 
 	if err != nil {
@@ -114,7 +137,7 @@ func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*dom
 
 func (r *UserRepositoryImpl) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	query := `
-		SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+		SELECT id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 		FROM users
 		WHERE username = $1 AND is_deleted = false`
 
@@ -129,6 +152,12 @@ func (r *UserRepositoryImpl) GetByUsername(ctx context.Context, username string)
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
 	)
 
 	if err != nil {
@@ -141,12 +170,49 @@ func (r *UserRepositoryImpl) GetByUsername(ctx context.Context, username string)
 	return user, nil
 }
 
+// GetProfileByUsername returns the public profile of a user with the number of posts and
+// comments they wrote.
+func (r *UserRepositoryImpl) GetProfileByUsername(ctx context.Context, username string) (*domain.PublicProfile, error) {
+	query := `
+		SELECT u.id, u.username, u.first_name, u.last_name, COALESCE(u.bio, '') AS bio, COALESCE(u.profile_picture_url, '') AS profile_picture_url, u.created_at,
+			(SELECT COUNT(*) FROM posts p WHERE p.user_id = u.id AND p.is_deleted = false) AS post_count,
+			(SELECT COUNT(*) FROM comments c WHERE c.user_id = u.id AND c.is_deleted = false) AS comment_count,
+			(SELECT COUNT(*) FROM follows f JOIN users fu ON fu.id = f.follower_id WHERE f.followee_id = u.id AND fu.is_deleted = false) AS follower_count,
+			(SELECT COUNT(*) FROM follows f JOIN users fu ON fu.id = f.followee_id WHERE f.follower_id = u.id AND fu.is_deleted = false) AS following_count
+		FROM users u
+		WHERE u.username = $1 AND u.is_deleted = false`
+
+	profile := &domain.PublicProfile{}
+	err := r.db.QueryRowContext(ctx, query, username).Scan(
+		&profile.ID,
+		&profile.Username,
+		&profile.FirstName,
+		&profile.LastName,
+		&profile.Bio,
+		&profile.ProfilePictureURL,
+		&profile.JoinedAt,
+		&profile.PostCount,
+		&profile.CommentCount,
+		&profile.FollowerCount,
+		&profile.FollowingCount,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return profile, nil
+}
+
 func (r *UserRepositoryImpl) Update(ctx context.Context, userId int64, updateUser *domain.UpdateUserDTO) (*domain.User, error) {
 	query := `
 			UPDATE users
-			SET first_name = $1, last_name = $2, email = $3, username = $4
+			SET first_name = $1, last_name = $2, username = $3, bio = COALESCE($4, bio)
 			WHERE id = $5 AND is_deleted = false
-			RETURNING id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+			RETURNING id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 			`
 
 	user := domain.User{}
@@ -156,8 +222,8 @@ func (r *UserRepositoryImpl) Update(ctx context.Context, userId int64, updateUse
 		query,
 		updateUser.FirstName,
 		updateUser.LastName,
-		updateUser.Email,
 		updateUser.Username,
+		updateUser.Bio,
 		userId,
 	).Scan(
 		&user.ID,
@@ -169,6 +235,12 @@ func (r *UserRepositoryImpl) Update(ctx context.Context, userId int64, updateUse
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
 	)
 
 	if err != nil {
@@ -195,18 +267,20 @@ func (r *UserRepositoryImpl) Delete(ctx context.Context, userId int64) error {
 	return err
 }
 
-func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]domain.User, error) {
-	if limit == 0 {
-		limit = 100
+// List lists the users that are not deleted, newest first.
+func (r *UserRepositoryImpl) List(ctx context.Context, page domain.PageRequest) ([]domain.User, error) {
+	if page.Limit == 0 {
+		page.Limit = 100
 	}
 
 	query := `
-			SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login
+			SELECT id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url
 			FROM users
 			WHERE is_deleted = false
-			LIMIT $1 OFFSET $2`
+			`
+	query, args := appendPage(query, nil, page, "created_at", "id")
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("list users: %w", err)
 	}
@@ -225,6 +299,12 @@ func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]dom
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			&user.LastLogin,
+			&user.EmailVerifiedAt,
+			&user.FailedLoginAttempts,
+			&user.LockedUntil,
+			&user.Role,
+			&user.Bio,
+			&user.ProfilePictureURL,
 		)
 		if err != nil {
 			return nil, err
@@ -235,6 +315,31 @@ func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]dom
 	return users, nil
 }
 
+// UpdateProfilePicture sets the profile picture of the user, or removes it when the URL is
+// empty, and returns the previous one so that its files can be deleted.
+func (r *UserRepositoryImpl) UpdateProfilePicture(ctx context.Context, userId int64, url string) (string, error) {
+	query := `
+		WITH previous AS (
+			SELECT id, profile_picture_url FROM users WHERE id = $2 AND is_deleted = false FOR UPDATE
+		)
+		UPDATE users u
+		SET profile_picture_url = NULLIF($1, '')
+		FROM previous
+		WHERE u.id = previous.id
+		RETURNING COALESCE(previous.profile_picture_url, '')`
+
+	var previousURL string
+	err := r.db.QueryRowContext(ctx, query, url, userId).Scan(&previousURL)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrNotFound
+		}
+		return "", err
+	}
+
+	return previousURL, nil
+}
+
 func (r *UserRepositoryImpl) UpdateLastLogin(ctx context.Context, userId int64) error {
 	query := `
 		UPDATE users
@@ -251,3 +356,371 @@ func (r *UserRepositoryImpl) UpdateLastLogin(ctx context.Context, userId int64)
 
 	return err
 }
+
+func (r *UserRepositoryImpl) UpdatePassword(ctx context.Context, userId int64, hashedPassword string) error {
+	query := `
+		UPDATE users
+		SET password = $1
+		WHERE id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, hashedPassword, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// MarkEmailVerified records that the user proved ownership of their current email address.
+func (r *UserRepositoryImpl) MarkEmailVerified(ctx context.Context, userId int64) error {
+	query := `
+		UPDATE users
+		SET email_verified_at = COALESCE(email_verified_at, NOW())
+		WHERE id = $1 AND is_deleted = false`
+
+	result, err := r.db.ExecContext(ctx, query, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// IncrementFailedLogins records a failed login and returns the number of consecutive failures.
+func (r *UserRepositoryImpl) IncrementFailedLogins(ctx context.Context, userId int64) (int, error) {
+	query := `
+		UPDATE users
+		SET failed_login_attempts = failed_login_attempts + 1
+		WHERE id = $1 AND is_deleted = false
+		RETURNING failed_login_attempts`
+
+	var attempts int
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (r *UserRepositoryImpl) LockUntil(ctx context.Context, userId int64, lockedUntil time.Time) error {
+	query := `
+		UPDATE users
+		SET locked_until = $1
+		WHERE id = $2 AND is_deleted = false`
+
+	_, err := r.db.ExecContext(ctx, query, lockedUntil, userId)
+
+	return err
+}
+
+// ResetFailedLogins clears the failure counter and any lock after a successful login.
+func (r *UserRepositoryImpl) ResetFailedLogins(ctx context.Context, userId int64) error {
+	query := `
+		UPDATE users
+		SET failed_login_attempts = 0, locked_until = NULL
+		WHERE id = $1 AND (failed_login_attempts <> 0 OR locked_until IS NOT NULL)`
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+
+	return err
+}
+
+func (r *UserRepositoryImpl) UpdateRole(ctx context.Context, userId int64, role domain.Role) error {
+	query := `
+		UPDATE users
+		SET role = $1
+		WHERE id = $2 AND is_deleted = false`
+
+	result, err := r.db.ExecContext(ctx, query, role, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// SetPendingEmail records the address the user wants to change their email to, replacing
+// any earlier pending change.
+func (r *UserRepositoryImpl) SetPendingEmail(ctx context.Context, userId int64, email string) error {
+	query := `
+		UPDATE users
+		SET pending_email = $1
+		WHERE id = $2 AND is_deleted = false`
+
+	result, err := r.db.ExecContext(ctx, query, email, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// ConfirmPendingEmail replaces the email of the user with the pending one, which is verified
+// by the confirmation. It returns domain.ErrNotFound when no change is pending, and
+// domain.ErrDuplicateEmailOrUsername when another account took the address in the meantime.
+func (r *UserRepositoryImpl) ConfirmPendingEmail(ctx context.Context, userId int64) (*domain.User, error) {
+	query := `
+		UPDATE users
+		SET email = pending_email, pending_email = NULL, email_verified_at = NOW()
+		WHERE id = $1 AND pending_email IS NOT NULL AND is_deleted = false
+		RETURNING id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url`
+
+	user := domain.User{}
+
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(
+		&user.ID,
+		&user.FirstName,
+		&user.LastName,
+		&user.Email,
+		&user.Username,
+		&user.Password,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, domain.ErrDuplicateEmailOrUsername
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// ScheduleDeletion marks the account to be purged at purgeAt.
+func (r *UserRepositoryImpl) ScheduleDeletion(ctx context.Context, userId int64, purgeAt time.Time) error {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = $1
+		WHERE id = $2 AND is_deleted = false`
+
+	result, err := r.db.ExecContext(ctx, query, purgeAt, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// CancelDeletion cancels a scheduled deletion that has not been purged yet. It reports
+// whether a deletion was cancelled.
+func (r *UserRepositoryImpl) CancelDeletion(ctx context.Context, userId int64) (bool, error) {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = NULL
+		WHERE id = $1 AND deletion_scheduled_at IS NOT NULL AND is_deleted = false`
+
+	result, err := r.db.ExecContext(ctx, query, userId)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// ListDueForDeletion returns the ids of up to limit accounts whose grace period is over.
+func (r *UserRepositoryImpl) ListDueForDeletion(ctx context.Context, limit int) ([]int64, error) {
+	query := `
+		SELECT id
+		FROM users
+		WHERE deletion_scheduled_at <= NOW() AND is_deleted = false
+		ORDER BY deletion_scheduled_at
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIds []int64
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			return nil, err
+		}
+		userIds = append(userIds, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIds, nil
+}
+
+// purgeStatements remove everything an account owns once its row has been anonymized.
+// Deleting the posts also deletes the comments of other users on them, and the reactions on both.
+var purgeStatements = []string{
+	`DELETE FROM reactions WHERE user_id = $1`,
+	`DELETE FROM comments WHERE user_id = $1`,
+	`DELETE FROM posts WHERE user_id = $1`,
+	`DELETE FROM refresh_tokens WHERE user_id = $1`,
+	`DELETE FROM sessions WHERE user_id = $1`,
+	`DELETE FROM user_tokens WHERE user_id = $1`,
+	`DELETE FROM recovery_codes WHERE user_id = $1`,
+	`DELETE FROM user_totp WHERE user_id = $1`,
+	`DELETE FROM personal_access_tokens WHERE user_id = $1`,
+	`DELETE FROM user_identities WHERE user_id = $1`,
+	`DELETE FROM follows WHERE follower_id = $1 OR followee_id = $1`,
+	`DELETE FROM user_blocks WHERE blocker_id = $1 OR blocked_id = $1`,
+	`DELETE FROM user_mutes WHERE muter_id = $1 OR muted_id = $1`,
+	`UPDATE moderation_actions SET previous_content = '' WHERE target_user_id = $1`,
+}
+
+// Purge anonymizes an account whose grace period is over and deletes its content and
+// credentials, in a single transaction. The row is kept so that the ids referenced by the
+// moderation log stay meaningful. It returns domain.ErrNotFound when the account is not due,
+// which includes accounts that were purged already.
+func (r *UserRepositoryImpl) Purge(ctx context.Context, userId int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The update locks the row, so a login cancelling the deletion either happens before
+	// and wins, or waits and finds the account deleted.
+	query := `
+		UPDATE users
+		SET first_name = 'Deleted', last_name = 'User',
+			username = 'deleted_' || id, email = 'deleted-' || id || '@deleted.invalid',
+			password = NULL, bio = NULL, profile_picture_url = NULL, pending_email = NULL,
+			last_login = NULL, email_verified_at = NULL, role = 'user',
+			is_deleted = true, deleted_at = NOW(), deletion_scheduled_at = NULL
+		WHERE id = $1 AND deletion_scheduled_at <= NOW() AND is_deleted = false`
+
+	result, err := tx.ExecContext(ctx, query, userId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+
+	for _, statement := range purgeStatements {
+		if _, err := tx.ExecContext(ctx, statement, userId); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CreateExternal creates a user signed up through an external provider, which verified the
+// email address. The user has no password until they set one with a password reset.
+func (r *UserRepositoryImpl) CreateExternal(ctx context.Context, createUser *domain.EditableUserField) (*domain.User, error) {
+	user := domain.User{}
+
+	query := `
+		INSERT INTO users (first_name, last_name, email, username, password, email_verified_at)
+		VALUES ($1, $2, $3, $4, NULL, NOW())
+		RETURNING id, first_name, last_name, email, username, COALESCE(password, '') AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE(bio, '') AS bio, COALESCE(profile_picture_url, '') AS profile_picture_url`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		createUser.FirstName,
+		createUser.LastName,
+		createUser.Email,
+		createUser.Username,
+	).Scan(
+		&user.ID,
+		&user.FirstName,
+		&user.LastName,
+		&user.Email,
+		&user.Username,
+		&user.Password,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.LastLogin,
+		&user.EmailVerifiedAt,
+		&user.FailedLoginAttempts,
+		&user.LockedUntil,
+		&user.Role,
+		&user.Bio,
+		&user.ProfilePictureURL,
+	)
+
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, domain.ErrDuplicateEmailOrUsername
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -10,6 +10,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/repositories"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,8 +56,8 @@ func TestUserRepositoryImpl_Create_Success(t *testing.T) {
 
 	mock.ExpectQuery(`INSERT INTO users`).
 		WithArgs(createUserDTO.FirstName, createUserDTO.LastName, createUserDTO.Email, createUserDTO.Username, createUserDTO.Password).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login"}).
-			AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, nil))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login", "email_verified_at", "failed_login_attempts", "locked_until", "role", "bio", "profile_picture_url"}).
+			AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, nil, nil, 0, nil, "user", "", ""))
 
 	// Act
 	user, err := repo.Create(context.Background(), createUserDTO)
@@ -119,15 +120,16 @@ func TestUserRepositoryImpl_GetByID_Success(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		LastLogin: nil,
+		Role:      domain.RoleUser,
 	}
 	// Create a time pointer for the expected value
 	expectedLastLogin := time.Now()
 	expectedUser.LastLogin = &expectedLastLogin
 
-	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login FROM users WHERE id = \$1`). // Added last_login to query
-																			WithArgs(userId).
-																			WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login"}).
-																				AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, expectedUser.LastLogin))
+	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url FROM users WHERE id = \$1`). // Added last_login to query
+																																										WithArgs(userId).
+																																										WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login", "email_verified_at", "failed_login_attempts", "locked_until", "role", "bio", "profile_picture_url"}).
+																																											AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, expectedUser.LastLogin, nil, 0, nil, "user", "", ""))
 
 	// Act
 	user, err := repo.GetByID(context.Background(), userId)
@@ -147,7 +149,7 @@ func TestUserRepositoryImpl_GetByID_Error(t *testing.T) {
 
 	const userId int64 = 1
 
-	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login FROM users WHERE id = \$1`).
+	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url FROM users WHERE id = \$1`).
 		WithArgs(userId).
 		WillReturnError(errors.New("some error"))
 
@@ -168,12 +170,9 @@ func TestUserRepositoryImpl_Update_Success(t *testing.T) {
 
 	const userId int64 = 1
 	updateUserDTO := &domain.UpdateUserDTO{
-		EditableUserField: domain.EditableUserField{
-			FirstName: "John",
-			LastName:  "Doe",
-			Email:     "john.doe@example.com",
-			Username:  "johndoe",
-		},
+		FirstName: "John",
+		LastName:  "Doe",
+		Username:  "johndoe",
 	}
 
 	expectedUser := &domain.User{
@@ -186,14 +185,15 @@ func TestUserRepositoryImpl_Update_Success(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		LastLogin: nil,
+		Role:      domain.RoleUser,
 	}
 	expectedLastLoginUpdate := time.Now()
 	expectedUser.LastLogin = &expectedLastLoginUpdate
 
-	mock.ExpectQuery(`UPDATE users SET first_name = \$1, last_name = \$2, email = \$3, username = \$4 WHERE id = \$5 AND is_deleted = false RETURNING id, first_name, last_name, email, username, password, created_at, updated_at, last_login`).
-		WithArgs(updateUserDTO.FirstName, updateUserDTO.LastName, updateUserDTO.Email, updateUserDTO.Username, userId).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login"}).
-			AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, expectedUser.LastLogin))
+	mock.ExpectQuery(`UPDATE users SET first_name = \$1, last_name = \$2, username = \$3, bio = COALESCE\(\$4, bio\) WHERE id = \$5 AND is_deleted = false RETURNING id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url`).
+		WithArgs(updateUserDTO.FirstName, updateUserDTO.LastName, updateUserDTO.Username, updateUserDTO.Bio, userId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login", "email_verified_at", "failed_login_attempts", "locked_until", "role", "bio", "profile_picture_url"}).
+			AddRow(expectedUser.ID, expectedUser.FirstName, expectedUser.LastName, expectedUser.Email, expectedUser.Username, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt, expectedUser.LastLogin, nil, 0, nil, "user", "", ""))
 	// Act
 	user, err := repo.Update(context.Background(), userId, updateUserDTO)
 
@@ -212,16 +212,13 @@ func TestUserRepositoryImpl_Update_Error(t *testing.T) {
 
 	const userId int64 = 1
 	updateUserDTO := &domain.UpdateUserDTO{
-		EditableUserField: domain.EditableUserField{
-			FirstName: "John",
-			LastName:  "Doe",
-			Email:     "john.doe@example.com",
-			Username:  "johndoe",
-		},
+		FirstName: "John",
+		LastName:  "Doe",
+		Username:  "johndoe",
 	}
 
-	mock.ExpectQuery(`UPDATE users SET first_name = \$1, last_name = \$2, email = \$3, username = \$4 WHERE id = \$5 AND is_deleted = false RETURNING id, first_name, last_name, email, username, password, created_at, updated_at`).
-		WithArgs(updateUserDTO.FirstName, updateUserDTO.LastName, updateUserDTO.Email, updateUserDTO.Username, userId).
+	mock.ExpectQuery(`UPDATE users SET first_name = \$1, last_name = \$2, username = \$3, bio = COALESCE\(\$4, bio\) WHERE id = \$5 AND is_deleted = false RETURNING id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at`).
+		WithArgs(updateUserDTO.FirstName, updateUserDTO.LastName, updateUserDTO.Username, updateUserDTO.Bio, userId).
 		WillReturnError(errors.New("some error"))
 
 	// Act
@@ -279,23 +276,23 @@ func TestUserRepositoryImpl_List_Success(t *testing.T) {
 
 	repo := repositories.NewUserRepository(db)
 
-	const limit, offset = 10, 0
+	page := domain.PageRequest{Limit: 10}
 	// Create time pointers for expected values
 	lastLogin1 := time.Now()
 	lastLogin2 := time.Now().Add(-time.Hour) // Use a different time for variety
 	expectedUsers := []domain.User{
-		{ID: 1, FirstName: "Test1", LastName: "User1", Email: "test1@test.com", Username: "test1", LastLogin: &lastLogin1},
-		{ID: 2, FirstName: "Test2", LastName: "User2", Email: "test2@test.com", Username: "test2", LastLogin: &lastLogin2},
+		{ID: 1, FirstName: "Test1", LastName: "User1", Email: "test1@test.com", Username: "test1", LastLogin: &lastLogin1, Role: domain.RoleUser},
+		{ID: 2, FirstName: "Test2", LastName: "User2", Email: "test2@test.com", Username: "test2", LastLogin: &lastLogin2, Role: domain.RoleUser},
 	}
 
-	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login FROM users WHERE is_deleted = false LIMIT \$1 OFFSET \$2`).
-		WithArgs(limit, offset).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login"}).
-			AddRow(expectedUsers[0].ID, expectedUsers[0].FirstName, expectedUsers[0].LastName, expectedUsers[0].Email, expectedUsers[0].Username, expectedUsers[0].Password, expectedUsers[0].CreatedAt, expectedUsers[0].UpdatedAt, expectedUsers[0].LastLogin).
-			AddRow(expectedUsers[1].ID, expectedUsers[1].FirstName, expectedUsers[1].LastName, expectedUsers[1].Email, expectedUsers[1].Username, expectedUsers[1].Password, expectedUsers[1].CreatedAt, expectedUsers[1].UpdatedAt, expectedUsers[1].LastLogin))
+	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url FROM users WHERE is_deleted = false ORDER BY created_at DESC, id DESC LIMIT \$1`).
+		WithArgs(page.Limit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login", "email_verified_at", "failed_login_attempts", "locked_until", "role", "bio", "profile_picture_url"}).
+			AddRow(expectedUsers[0].ID, expectedUsers[0].FirstName, expectedUsers[0].LastName, expectedUsers[0].Email, expectedUsers[0].Username, expectedUsers[0].Password, expectedUsers[0].CreatedAt, expectedUsers[0].UpdatedAt, expectedUsers[0].LastLogin, nil, 0, nil, "user", "", "").
+			AddRow(expectedUsers[1].ID, expectedUsers[1].FirstName, expectedUsers[1].LastName, expectedUsers[1].Email, expectedUsers[1].Username, expectedUsers[1].Password, expectedUsers[1].CreatedAt, expectedUsers[1].UpdatedAt, expectedUsers[1].LastLogin, nil, 0, nil, "user", "", ""))
 
 	// Act
-	users, err := repo.List(context.Background(), limit, offset)
+	users, err := repo.List(context.Background(), page)
 
 	// Assert
 	assert.Nil(t, err)
@@ -310,17 +307,277 @@ func TestUserRepositoryImpl_List_Error(t *testing.T) {
 
 	repo := repositories.NewUserRepository(db)
 
-	const limit, offset = 10, 0
+	page := domain.PageRequest{Limit: 10}
 
-	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, password, created_at, updated_at, last_login FROM users WHERE is_deleted = false LIMIT \$1 OFFSET \$2`).
-		WithArgs(limit, offset).
+	mock.ExpectQuery(`SELECT id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url FROM users WHERE is_deleted = false ORDER BY created_at DESC, id DESC LIMIT \$1`).
+		WithArgs(page.Limit).
 		WillReturnError(errors.New("some error"))
 
 	// Act
-	users, err := repo.List(context.Background(), limit, offset)
+	users, err := repo.List(context.Background(), page)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, users)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUserRepositoryImpl_UpdateRole_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectExec(`UPDATE users SET role = \$1 WHERE id = \$2 AND is_deleted = false`).
+		WithArgs(domain.RoleModerator, int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	err := repo.UpdateRole(context.Background(), 1, domain.RoleModerator)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_ConfirmPendingEmail_Success(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(`UPDATE users SET email = pending_email, pending_email = NULL, email_verified_at = NOW\(\) WHERE id = \$1 AND pending_email IS NOT NULL AND is_deleted = false RETURNING id, first_name, last_name, email, username, COALESCE\(password, ''\) AS password, created_at, updated_at, last_login, email_verified_at, failed_login_attempts, locked_until, role, COALESCE\(bio, ''\) AS bio, COALESCE\(profile_picture_url, ''\) AS profile_picture_url`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "email", "username", "password", "created_at", "updated_at", "last_login", "email_verified_at", "failed_login_attempts", "locked_until", "role", "bio", "profile_picture_url"}).
+			AddRow(1, "John", "Doe", "john.new@example.com", "johndoe", "hashedpassword", now, now, nil, now, 0, nil, "user", "", ""))
+
+	// Act
+	user, err := repo.ConfirmPendingEmail(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, user) {
+		assert.Equal(t, "john.new@example.com", user.Email)
+		assert.NotNil(t, user.EmailVerifiedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_ConfirmPendingEmail_NothingPending(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`UPDATE users SET email = pending_email`).
+		WithArgs(int64(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	user, err := repo.ConfirmPendingEmail(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, user)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_ConfirmPendingEmail_AddressTaken(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`UPDATE users SET email = pending_email`).
+		WithArgs(int64(1)).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	// Act
+	user, err := repo.ConfirmPendingEmail(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDuplicateEmailOrUsername)
+	assert.Nil(t, user)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_CancelDeletion_NothingScheduled(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectExec(`UPDATE users SET deletion_scheduled_at = NULL WHERE id = \$1 AND deletion_scheduled_at IS NOT NULL AND is_deleted = false`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Act
+	cancelled, err := repo.CancelDeletion(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, cancelled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_Purge_Success(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE users SET first_name = 'Deleted'`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM reactions WHERE user_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(`DELETE FROM comments WHERE user_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM posts WHERE user_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM refresh_tokens`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM sessions`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM user_tokens`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM recovery_codes`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM user_totp`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM personal_access_tokens`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM user_identities`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM follows WHERE follower_id = \$1 OR followee_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM user_blocks WHERE blocker_id = \$1 OR blocked_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM user_mutes WHERE muter_id = \$1 OR muted_id = \$1`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE moderation_actions SET previous_content = ''`).WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Act
+	err := repo.Purge(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_Purge_NotDue(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE users SET first_name = 'Deleted'`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	// Act
+	err := repo.Purge(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_CreateExternal_UsernameTaken(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO users \(first_name, last_name, email, username, password, email_verified_at\) VALUES \(\$1, \$2, \$3, \$4, NULL, NOW\(\)\)`).
+		WithArgs("Jane", "Doe", "jane@example.com", "jane").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	// Act
+	user, err := repo.CreateExternal(context.Background(), &domain.EditableUserField{FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", Username: "jane"})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDuplicateEmailOrUsername)
+	assert.Nil(t, user)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_GetProfileByUsername_Success(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	joinedAt := time.Now()
+	mock.ExpectQuery(`SELECT u.id, u.username, u.first_name, u.last_name, COALESCE\(u.bio, ''\) AS bio, COALESCE\(u.profile_picture_url, ''\) AS profile_picture_url, u.created_at, .* FROM users u WHERE u.username = \$1 AND u.is_deleted = false`).
+		WithArgs("jane").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "first_name", "last_name", "bio", "profile_picture_url", "created_at", "post_count", "comment_count", "follower_count", "following_count"}).
+			AddRow(int64(1), "jane", "Jane", "Doe", "Hello", "", joinedAt, int64(3), int64(5), int64(2), int64(1)))
+
+	// Act
+	profile, err := repo.GetProfileByUsername(context.Background(), "jane")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.PublicProfile{
+		ID:             1,
+		Username:       "jane",
+		FirstName:      "Jane",
+		LastName:       "Doe",
+		Bio:            "Hello",
+		JoinedAt:       joinedAt,
+		PostCount:      3,
+		CommentCount:   5,
+		FollowerCount:  2,
+		FollowingCount: 1,
+	}, profile)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_GetProfileByUsername_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`SELECT u.id, u.username`).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	profile, err := repo.GetProfileByUsername(context.Background(), "missing")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, profile)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_UpdateProfilePicture_ReturnsPrevious(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`WITH previous AS \( SELECT id, profile_picture_url FROM users WHERE id = \$2 AND is_deleted = false FOR UPDATE \) UPDATE users u SET profile_picture_url = NULLIF\(\$1, ''\)`).
+		WithArgs("http://localhost/new.jpg", int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_picture_url"}).AddRow("http://localhost/old.jpg"))
+
+	// Act
+	previousURL, err := repo.UpdateProfilePicture(context.Background(), 1, "http://localhost/new.jpg")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/old.jpg", previousURL)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepositoryImpl_UpdateProfilePicture_NotFound(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserRepository(db)
+
+	mock.ExpectQuery(`WITH previous AS`).
+		WithArgs("", int64(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	// Act
+	_, err := repo.UpdateProfilePicture(context.Background(), 1, "")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
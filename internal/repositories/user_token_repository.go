@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+)
+
+type UserTokenRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewUserTokenRepository(db *sql.DB) interfaces.UserTokenRepository {
+	return &UserTokenRepositoryImpl{db: db}
+}
+
+func (r *UserTokenRepositoryImpl) Create(ctx context.Context, userId int64, purpose domain.TokenPurpose, tokenHash string, expiresAt time.Time) (*domain.UserToken, error) {
+	query := `
+		INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, purpose, token_hash, expires_at, created_at, used_at
+		`
+
+	token := domain.UserToken{}
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		userId,
+		purpose,
+		tokenHash,
+		expiresAt,
+	).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.UsedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Consume marks an unused, unexpired token as used and returns it. The check and the
+// update happen in a single statement, so a token can only ever be consumed once.
+// It returns domain.ErrNotFound for unknown, expired or already used tokens.
+func (r *UserTokenRepositoryImpl) Consume(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	query := `
+		UPDATE user_tokens
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+		RETURNING id, user_id, purpose, token_hash, expires_at, created_at, used_at
+		`
+
+	token := domain.UserToken{}
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash, purpose).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.UsedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// GetActive returns an unused, unexpired token without consuming it, for flows that check
+// something else before spending the token. It returns domain.ErrNotFound otherwise.
+func (r *UserTokenRepositoryImpl) GetActive(ctx context.Context, purpose domain.TokenPurpose, tokenHash string) (*domain.UserToken, error) {
+	query := `
+		SELECT id, user_id, purpose, token_hash, expires_at, created_at, used_at
+		FROM user_tokens
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+		`
+
+	token := domain.UserToken{}
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash, purpose).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.UsedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// InvalidateAll marks every outstanding token of the user for the given purpose as used.
+func (r *UserTokenRepositoryImpl) InvalidateAll(ctx context.Context, userId int64, purpose domain.TokenPurpose) error {
+	query := `
+		UPDATE user_tokens
+		SET used_at = NOW()
+		WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL
+		`
+
+	_, err := r.db.ExecContext(ctx, query, userId, purpose)
+
+	return err
+}
+
+// CountCreatedSince counts the tokens issued to the user for the given purpose since the given time.
+func (r *UserTokenRepositoryImpl) CountCreatedSince(ctx context.Context, userId int64, purpose domain.TokenPurpose, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM user_tokens
+		WHERE user_id = $1 AND purpose = $2 AND created_at > $3
+		`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userId, purpose, since).Scan(&count)
+
+	return count, err
+}
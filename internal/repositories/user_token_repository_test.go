@@ -0,0 +1,87 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+var userTokenColumns = []string{"id", "user_id", "purpose", "token_hash", "expires_at", "created_at", "used_at"}
+
+func TestUserTokenRepositoryImpl_Create_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserTokenRepository(db)
+
+	expiresAt := time.Now().Add(time.Hour)
+	expected := &domain.UserToken{
+		ID:        1,
+		UserID:    2,
+		Purpose:   domain.TokenPurposePasswordReset,
+		TokenHash: "hash",
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectQuery(`INSERT INTO user_tokens`).
+		WithArgs(expected.UserID, "password_reset", expected.TokenHash, expiresAt).
+		WillReturnRows(sqlmock.NewRows(userTokenColumns).
+			AddRow(expected.ID, expected.UserID, "password_reset", expected.TokenHash, expected.ExpiresAt, expected.CreatedAt, nil))
+
+	// Act
+	token, err := repo.Create(context.Background(), expected.UserID, expected.Purpose, expected.TokenHash, expiresAt)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, expected, token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepositoryImpl_Consume_Success(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserTokenRepository(db)
+
+	usedAt := time.Now()
+	mock.ExpectQuery(`UPDATE user_tokens SET used_at = NOW\(\) WHERE token_hash = \$1 AND purpose = \$2 AND used_at IS NULL AND expires_at > NOW\(\)`).
+		WithArgs("hash", "password_reset").
+		WillReturnRows(sqlmock.NewRows(userTokenColumns).
+			AddRow(1, 2, "password_reset", "hash", usedAt.Add(time.Hour), usedAt, usedAt))
+
+	// Act
+	token, err := repo.Consume(context.Background(), domain.TokenPurposePasswordReset, "hash")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), token.UserID)
+	assert.NotNil(t, token.UsedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepositoryImpl_Consume_NotFound(t *testing.T) {
+	db, mock, cleanup := mocks.SetupMockDB(t)
+	defer cleanup()
+
+	repo := repositories.NewUserTokenRepository(db)
+
+	mock.ExpectQuery(`UPDATE user_tokens SET used_at = NOW\(\)`).
+		WithArgs("used", "password_reset").
+		WillReturnRows(sqlmock.NewRows(userTokenColumns))
+
+	// Act
+	token, err := repo.Consume(context.Background(), domain.TokenPurposePasswordReset, "used")
+
+	// Assert
+	assert.Nil(t, token)
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
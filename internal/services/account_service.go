@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const emailChangeTokenTTL = 24 * time.Hour
+
+// errNoPassword rejects a password confirmation from a user who only signs in with an
+// external provider.
+var errNoPassword = domain.NewBadRequestError("the account has no password; set one with a password reset")
+
+type accountService struct {
+	userRepo       interfaces.UserRepository
+	userTokenRepo  interfaces.UserTokenRepository
+	sessionRepo    interfaces.SessionRepository
+	patRepo        interfaces.PersonalAccessTokenRepository
+	mailer         interfaces.Mailer
+	avatarService  interfaces.AvatarService
+	throttlePolicy *domain.LoginThrottlePolicy
+	deletionPolicy *domain.AccountDeletionPolicy
+}
+
+func NewAccountService(
+	userRepo interfaces.UserRepository,
+	userTokenRepo interfaces.UserTokenRepository,
+	sessionRepo interfaces.SessionRepository,
+	patRepo interfaces.PersonalAccessTokenRepository,
+	mailer interfaces.Mailer,
+	avatarService interfaces.AvatarService,
+	throttlePolicy *domain.LoginThrottlePolicy,
+	deletionPolicy *domain.AccountDeletionPolicy,
+) interfaces.AccountService {
+	return &accountService{
+		userRepo:       userRepo,
+		userTokenRepo:  userTokenRepo,
+		sessionRepo:    sessionRepo,
+		patRepo:        patRepo,
+		mailer:         mailer,
+		avatarService:  avatarService,
+		throttlePolicy: throttlePolicy,
+		deletionPolicy: deletionPolicy,
+	}
+}
+
+// ChangePassword sets a new password after checking the current one, and logs the user out
+// of every session except the current one.
+func (s *accountService) ChangePassword(ctx context.Context, userId int64, currentSessionId string, changePassword *domain.ChangePasswordDTO) error {
+	if err := validation.Validate.Struct(changePassword); err != nil {
+		return err
+	}
+
+	user, err := s.reauthenticate(ctx, userId, changePassword.CurrentPassword)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(changePassword.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to hash password")
+		return domain.NewInternalServerError("failed to change password")
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, userId, string(hashedPassword)); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to update password")
+		return domain.NewInternalServerError("failed to change password")
+	}
+
+	// Reset links requested before the change would otherwise still override it.
+	if err := s.userTokenRepo.InvalidateAll(ctx, userId, domain.TokenPurposePasswordReset); err != nil {
+		log.Error().Err(err).Msg("failed to invalidate password reset tokens")
+	}
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, userId, currentSessionId); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return domain.NewInternalServerError("failed to change password")
+	}
+
+	s.notify(ctx, &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Your password was changed",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nThe password of your account was changed and your other sessions were logged out.\n\nIf you did not make this change, reset your password right away.\n",
+			user.FirstName,
+		),
+	})
+
+	return nil
+}
+
+// RequestEmailChange emails a confirmation link to the new address. The email of the user
+// only changes once the link is followed, see ConfirmEmailChange.
+func (s *accountService) RequestEmailChange(ctx context.Context, userId int64, changeEmail *domain.ChangeEmailDTO) error {
+	if err := validation.Validate.Struct(changeEmail); err != nil {
+		return err
+	}
+
+	user, err := s.reauthenticate(ctx, userId, changeEmail.Password)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(user.Email, changeEmail.Email) {
+		return domain.NewBadRequestError("the new email address is the current one")
+	}
+
+	if existingUser, err := s.userRepo.GetByEmail(ctx, changeEmail.Email); existingUser != nil {
+		return domain.ErrDuplicateEmailOrUsername
+	} else if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		log.Error().Err(err).Msg("failed to get user by email")
+		return domain.NewInternalServerError("failed to change email")
+	}
+
+	if err := s.userRepo.SetPendingEmail(ctx, userId, changeEmail.Email); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to set pending email")
+		return domain.NewInternalServerError("failed to change email")
+	}
+
+	// Issuing a token invalidates the links sent for an earlier request, which may have been
+	// for another address.
+	rawToken, err := issueUserToken(ctx, s.userTokenRepo, userId, domain.TokenPurposeEmailChange, emailChangeTokenTTL)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to issue email change token")
+		return domain.NewInternalServerError("failed to change email")
+	}
+
+	message := &domain.EmailMessage{
+		To:      changeEmail.Email,
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nOpen the link below to use this address for your account. It expires in 24 hours.\n\n%s\n\nIf you did not ask for this change, you can ignore this email.\n",
+			user.FirstName,
+			appLink("/confirm-email", rawToken),
+		),
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Error().Err(err).Msg("failed to send email change confirmation")
+		return domain.NewInternalServerError("failed to send confirmation email")
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange applies the pending email change of the user the token was issued to.
+// The user must be logged in as well, so that a leaked link cannot take over an account.
+// The old address is notified and every other session is logged out.
+func (s *accountService) ConfirmEmailChange(ctx context.Context, userId int64, currentSessionId string, confirm *domain.ConfirmEmailChangeDTO) (*domain.User, error) {
+	if err := validation.Validate.Struct(confirm); err != nil {
+		return nil, err
+	}
+
+	tokenHash := tokens.Hash(confirm.Token)
+
+	// The token is only spent once it is known to belong to the user.
+	token, err := s.userTokenRepo.GetActive(ctx, domain.TokenPurposeEmailChange, tokenHash)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		log.Error().Err(err).Msg("failed to get email change token")
+		return nil, domain.NewInternalServerError("failed to confirm email change")
+	}
+	if err != nil || token.UserID != userId {
+		return nil, domain.NewBadRequestError("invalid or expired email change token")
+	}
+
+	previousUser, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to confirm email change")
+	}
+
+	if _, err := s.userTokenRepo.Consume(ctx, domain.TokenPurposeEmailChange, tokenHash); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewBadRequestError("invalid or expired email change token")
+		}
+		log.Error().Err(err).Msg("failed to consume email change token")
+		return nil, domain.NewInternalServerError("failed to confirm email change")
+	}
+
+	user, err := s.userRepo.ConfirmPendingEmail(ctx, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, domain.NewBadRequestError("invalid or expired email change token")
+		case errors.Is(err, domain.ErrDuplicateEmailOrUsername):
+			return nil, err
+		}
+		log.Error().Err(err).Msg("failed to confirm pending email")
+		return nil, domain.NewInternalServerError("failed to confirm email change")
+	}
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, userId, currentSessionId); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return nil, domain.NewInternalServerError("failed to confirm email change")
+	}
+
+	s.notify(ctx, &domain.EmailMessage{
+		To:      previousUser.Email,
+		Subject: "Your email address was changed",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nThe email address of your account was changed to %s and your other sessions were logged out.\n\nIf you did not make this change, contact support right away.\n",
+			previousUser.FirstName,
+			user.Email,
+		),
+	})
+
+	return user, nil
+}
+
+// ScheduleDeletion schedules the account to be purged once the grace period of the deletion
+// policy is over, and logs the user out everywhere. Logging in again before then cancels the
+// deletion.
+func (s *accountService) ScheduleDeletion(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) (*domain.AccountDeletion, error) {
+	if err := validation.Validate.Struct(confirmation); err != nil {
+		return nil, err
+	}
+
+	user, err := s.reauthenticate(ctx, userId, confirmation.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := &domain.AccountDeletion{PurgeAt: time.Now().Add(s.deletionPolicy.GracePeriod)}
+
+	if err := s.userRepo.ScheduleDeletion(ctx, userId, deletion.PurgeAt); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to schedule account deletion")
+		return nil, domain.NewInternalServerError("failed to delete account")
+	}
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, userId, ""); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return nil, domain.NewInternalServerError("failed to delete account")
+	}
+
+	if err := s.patRepo.RevokeAllForUser(ctx, userId); err != nil {
+		log.Error().Err(err).Msg("failed to revoke personal access tokens")
+		return nil, domain.NewInternalServerError("failed to delete account")
+	}
+
+	s.notify(ctx, &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Your account will be deleted",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nYour account and everything you posted will be deleted on %s, and you were logged out of every session.\n\nIf you change your mind, log in before then to keep your account.\n",
+			user.FirstName,
+			deletion.PurgeAt.UTC().Format(time.RFC1123),
+		),
+	})
+
+	return deletion, nil
+}
+
+// PurgeDueAccounts purges a batch of the accounts whose grace period is over and returns how
+// many were purged. Running it again, or concurrently, is safe: an account that was purged or
+// whose deletion was cancelled in the meantime is skipped. Profile pictures are deleted
+// first: when that fails the account is left due, so that the next run tries again rather
+// than leaving the pictures online without an account to find them by.
+func (s *accountService) PurgeDueAccounts(ctx context.Context) (int, error) {
+	userIds, err := s.userRepo.ListDueForDeletion(ctx, s.deletionPolicy.PurgeBatchSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list accounts due for deletion")
+		return 0, domain.NewInternalServerError("failed to purge accounts")
+	}
+
+	purged := 0
+	for _, userId := range userIds {
+		if err := s.avatarService.DeleteAll(ctx, userId); err != nil {
+			log.Error().Err(err).Int64("userId", userId).Msg("failed to delete profile pictures of account")
+			continue
+		}
+		if err := s.userRepo.Purge(ctx, userId); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			log.Error().Err(err).Int64("userId", userId).Msg("failed to purge account")
+			continue
+		}
+		log.Info().Int64("userId", userId).Msg("purged deleted account")
+		purged++
+	}
+
+	return purged, nil
+}
+
+// reauthenticate checks the password of a logged in user before a sensitive change. Wrong
+// passwords count towards the same lock as failed logins.
+func (s *accountService) reauthenticate(ctx context.Context, userId int64, password string) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to get user")
+	}
+
+	if user.LockedUntil != nil && time.Now().Before(*user.LockedUntil) {
+		return nil, domain.NewAccountLockedError("account temporarily locked after too many failed attempts", time.Until(*user.LockedUntil))
+	}
+
+	if !user.HasPassword() {
+		return nil, errNoPassword
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return nil, s.recordFailure(ctx, userId, domain.NewForbiddenError("invalid password"))
+	}
+
+	if user.FailedLoginAttempts > 0 {
+		if err := s.userRepo.ResetFailedLogins(ctx, userId); err != nil {
+			log.Error().Err(err).Msg("failed to reset failed login attempts")
+		}
+	}
+
+	return user, nil
+}
+
+// recordFailure counts a wrong password and locks the account once the login throttle
+// threshold is reached.
+func (s *accountService) recordFailure(ctx context.Context, userId int64, failureErr error) error {
+	attempts, err := s.userRepo.IncrementFailedLogins(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to record failed password attempt")
+		return failureErr
+	}
+
+	lockout := s.throttlePolicy.LockoutFor(attempts, s.throttlePolicy.MaxAccountFailures)
+	if lockout == 0 {
+		return failureErr
+	}
+
+	log.Warn().Int64("userId", userId).Int("attempts", attempts).Dur("lockout", lockout).Msg("locking account after failed password attempts")
+	if err := s.userRepo.LockUntil(ctx, userId, time.Now().Add(lockout)); err != nil {
+		log.Error().Err(err).Msg("failed to lock account")
+		return failureErr
+	}
+
+	return domain.NewAccountLockedError("account temporarily locked after too many failed attempts", lockout)
+}
+
+// notify sends a security notification. A delivery failure does not undo the change it reports.
+func (s *accountService) notify(ctx context.Context, message *domain.EmailMessage) {
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Error().Err(err).Msg("failed to send security notification")
+	}
+}
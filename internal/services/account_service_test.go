@@ -0,0 +1,276 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newUserWithPassword(t *testing.T, password string) *domain.User {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return &domain.User{ID: 7, FirstName: "Jane", Email: "jane@example.com", Password: string(hashedPassword)}
+}
+
+func TestChangePassword_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+
+	var newHash string
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("UpdatePassword", mock.Anything, user.ID, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { newHash = args.String(2) }).
+		Return(nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposePasswordReset).Return(nil)
+	m.sessionRepo.On("RevokeAllForUser", mock.Anything, user.ID, "current-session").Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.MatchedBy(func(message *domain.EmailMessage) bool { return message.To == user.Email })).Return(nil)
+
+	// Act
+	err := accountService.ChangePassword(context.Background(), user.ID, "current-session", &domain.ChangePasswordDTO{
+		CurrentPassword: "password123",
+		NewPassword:     "newpassword123",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("newpassword123")))
+	m.sessionRepo.AssertExpectations(t)
+	m.mailer.AssertExpectations(t)
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("IncrementFailedLogins", mock.Anything, user.ID).Return(1, nil)
+
+	// Act
+	err := accountService.ChangePassword(context.Background(), user.ID, "current-session", &domain.ChangePasswordDTO{
+		CurrentPassword: "wrongpassword",
+		NewPassword:     "newpassword123",
+	})
+
+	// Assert
+	assert.IsType(t, &domain.ForbiddenError{}, err)
+	m.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	m.sessionRepo.AssertNotCalled(t, "RevokeAllForUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRequestEmailChange_SendsConfirmationToNewAddress(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+	newEmail := "jane.new@example.com"
+
+	var storedHash string
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("GetByEmail", mock.Anything, newEmail).Return((*domain.User)(nil), domain.ErrNotFound)
+	m.userRepo.On("SetPendingEmail", mock.Anything, user.ID, newEmail).Return(nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposeEmailChange).Return(nil)
+	m.userTokenRepo.On("Create", mock.Anything, user.ID, domain.TokenPurposeEmailChange, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { storedHash = args.String(3) }).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+
+	var sent *domain.EmailMessage
+	m.mailer.On("Send", mock.Anything, mock.AnythingOfType("*domain.EmailMessage")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*domain.EmailMessage) }).
+		Return(nil)
+
+	// Act
+	err := accountService.RequestEmailChange(context.Background(), user.ID, &domain.ChangeEmailDTO{Email: newEmail, Password: "password123"})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, sent) {
+		assert.Equal(t, newEmail, sent.To)
+		_, rawToken, found := strings.Cut(sent.Body, "token=")
+		assert.True(t, found, "expected the email to contain the confirmation link")
+		rawToken = strings.Fields(rawToken)[0]
+		assert.Equal(t, storedHash, tokens.Hash(rawToken))
+	}
+	m.userRepo.AssertNotCalled(t, "ConfirmPendingEmail", mock.Anything, mock.Anything)
+}
+
+func TestRequestEmailChange_AddressTaken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("GetByEmail", mock.Anything, "taken@example.com").Return(&domain.User{ID: 8}, nil)
+
+	// Act
+	err := accountService.RequestEmailChange(context.Background(), user.ID, &domain.ChangeEmailDTO{Email: "taken@example.com", Password: "password123"})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDuplicateEmailOrUsername)
+	m.userRepo.AssertNotCalled(t, "SetPendingEmail", mock.Anything, mock.Anything, mock.Anything)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestConfirmEmailChange_NotifiesOldAddress(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := &domain.User{ID: 7, FirstName: "Jane", Email: "jane@example.com"}
+	updatedUser := &domain.User{ID: 7, FirstName: "Jane", Email: "jane.new@example.com"}
+	tokenHash := tokens.Hash("raw-token")
+
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeEmailChange, tokenHash).Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeEmailChange, tokenHash).Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+	m.userRepo.On("ConfirmPendingEmail", mock.Anything, user.ID).Return(updatedUser, nil)
+	m.sessionRepo.On("RevokeAllForUser", mock.Anything, user.ID, "current-session").Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.MatchedBy(func(message *domain.EmailMessage) bool { return message.To == user.Email })).Return(nil)
+
+	// Act
+	confirmedUser, err := accountService.ConfirmEmailChange(context.Background(), user.ID, "current-session", &domain.ConfirmEmailChangeDTO{Token: "raw-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, updatedUser, confirmedUser)
+	m.sessionRepo.AssertExpectations(t)
+	m.mailer.AssertExpectations(t)
+}
+
+func TestConfirmEmailChange_TokenOfAnotherUser(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	tokenHash := tokens.Hash("raw-token")
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeEmailChange, tokenHash).Return(&domain.UserToken{ID: 1, UserID: 8}, nil)
+
+	// Act
+	_, err := accountService.ConfirmEmailChange(context.Background(), 7, "current-session", &domain.ConfirmEmailChangeDTO{Token: "raw-token"})
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	m.userTokenRepo.AssertNotCalled(t, "Consume", mock.Anything, mock.Anything, mock.Anything)
+	m.userRepo.AssertNotCalled(t, "ConfirmPendingEmail", mock.Anything, mock.Anything)
+}
+
+func TestScheduleDeletion_LogsOutEverywhere(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+
+	var purgeAt time.Time
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("ScheduleDeletion", mock.Anything, user.ID, mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { purgeAt = args.Get(2).(time.Time) }).
+		Return(nil)
+	m.sessionRepo.On("RevokeAllForUser", mock.Anything, user.ID, "").Return(nil)
+	m.patRepo.On("RevokeAllForUser", mock.Anything, user.ID).Return(nil)
+	m.mailer.On("Send", mock.Anything, mock.MatchedBy(func(message *domain.EmailMessage) bool { return message.To == user.Email })).Return(nil)
+
+	// Act
+	deletion, err := accountService.ScheduleDeletion(context.Background(), user.ID, &domain.PasswordConfirmationDTO{Password: "password123"})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, deletion) {
+		assert.Equal(t, purgeAt, deletion.PurgeAt)
+		assert.WithinDuration(t, time.Now().Add(domain.DefaultAccountDeletionPolicy().GracePeriod), deletion.PurgeAt, time.Minute)
+	}
+	m.sessionRepo.AssertExpectations(t)
+	m.patRepo.AssertExpectations(t)
+	m.mailer.AssertExpectations(t)
+}
+
+func TestScheduleDeletion_WrongPassword(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	user := newUserWithPassword(t, "password123")
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("IncrementFailedLogins", mock.Anything, user.ID).Return(1, nil)
+
+	// Act
+	deletion, err := accountService.ScheduleDeletion(context.Background(), user.ID, &domain.PasswordConfirmationDTO{Password: "wrongpassword"})
+
+	// Assert
+	assert.IsType(t, &domain.ForbiddenError{}, err)
+	assert.Nil(t, deletion)
+	m.userRepo.AssertNotCalled(t, "ScheduleDeletion", mock.Anything, mock.Anything, mock.Anything)
+	m.sessionRepo.AssertNotCalled(t, "RevokeAllForUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPurgeDueAccounts_SkipsAccountsNoLongerDue(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	m.userRepo.On("ListDueForDeletion", mock.Anything, domain.DefaultAccountDeletionPolicy().PurgeBatchSize).Return([]int64{1, 2, 3}, nil)
+	m.blobStore.On("DeletePrefix", mock.Anything, mock.Anything).Return(nil)
+	m.userRepo.On("Purge", mock.Anything, int64(1)).Return(nil)
+	m.userRepo.On("Purge", mock.Anything, int64(2)).Return(domain.ErrNotFound)
+	m.userRepo.On("Purge", mock.Anything, int64(3)).Return(nil)
+
+	// Act
+	purged, err := accountService.PurgeDueAccounts(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purged)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestPurgeDueAccounts_DeletesProfilePictures(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	m.userRepo.On("ListDueForDeletion", mock.Anything, domain.DefaultAccountDeletionPolicy().PurgeBatchSize).Return([]int64{1}, nil)
+	m.blobStore.On("DeletePrefix", mock.Anything, "avatars/1/").Return(nil)
+	m.userRepo.On("Purge", mock.Anything, int64(1)).Return(nil)
+
+	// Act
+	purged, err := accountService.PurgeDueAccounts(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	m.blobStore.AssertExpectations(t)
+}
+
+func TestPurgeDueAccounts_KeepsAccountWhenProfilePicturesCannotBeDeleted(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	accountService := services.NewAccountService(m.userRepo, m.userTokenRepo, m.sessionRepo, m.patRepo, m.mailer, avatarService, domain.DefaultLoginThrottlePolicy(), domain.DefaultAccountDeletionPolicy())
+	m.userRepo.On("ListDueForDeletion", mock.Anything, domain.DefaultAccountDeletionPolicy().PurgeBatchSize).Return([]int64{1, 2}, nil)
+	m.blobStore.On("DeletePrefix", mock.Anything, "avatars/1/").Return(errors.New("disk error"))
+	m.blobStore.On("DeletePrefix", mock.Anything, "avatars/2/").Return(nil)
+	m.userRepo.On("Purge", mock.Anything, int64(2)).Return(nil)
+
+	// Act
+	purged, err := accountService.PurgeDueAccounts(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	m.userRepo.AssertNotCalled(t, "Purge", mock.Anything, int64(1))
+}
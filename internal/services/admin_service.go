@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+)
+
+type adminService struct {
+	userRepo          interfaces.UserRepository
+	sessionRepo       interfaces.SessionRepository
+	refreshTokenRepo  interfaces.RefreshTokenRepository
+	moderationLogRepo interfaces.ModerationLogRepository
+}
+
+func NewAdminService(
+	userRepo interfaces.UserRepository,
+	sessionRepo interfaces.SessionRepository,
+	refreshTokenRepo interfaces.RefreshTokenRepository,
+	moderationLogRepo interfaces.ModerationLogRepository,
+) interfaces.AdminService {
+	return &adminService{
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		moderationLogRepo: moderationLogRepo,
+	}
+}
+
+// UpdateUserRole assigns a role and signs the user out everywhere, so that access tokens
+// carrying the previous role stop being accepted.
+func (s *adminService) UpdateUserRole(ctx context.Context, actorId, userId int64, updateRole *domain.UpdateUserRoleDTO) (*domain.User, error) {
+	if err := validation.Validate.Struct(updateRole); err != nil {
+		return nil, err
+	}
+
+	// Prevents the last admin from locking everyone out of role management.
+	if actorId == userId {
+		return nil, domain.NewBadRequestError("cannot change your own role")
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, userId, updateRole.Role); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to update user role")
+		return nil, domain.NewInternalServerError("failed to update user role")
+	}
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, userId, ""); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return nil, domain.NewInternalServerError("failed to update user role")
+	}
+
+	if err := s.refreshTokenRepo.RevokeAllForUser(ctx, userId); err != nil {
+		log.Error().Err(err).Msg("failed to revoke refresh tokens")
+		return nil, domain.NewInternalServerError("failed to update user role")
+	}
+
+	log.Info().Int64("actorId", actorId).Int64("userId", userId).Str("role", string(updateRole.Role)).Msg("user role updated")
+
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to get user after role update")
+		return nil, domain.NewInternalServerError("failed to update user role")
+	}
+
+	return user, nil
+}
+
+func (s *adminService) ListModerationLog(ctx context.Context, limit, offset int) ([]domain.ModerationLogEntry, error) {
+	if limit > 100 || limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	entries, err := s.moderationLogRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list moderation log")
+		return nil, domain.NewInternalServerError("failed to list moderation log")
+	}
+
+	return entries, nil
+}
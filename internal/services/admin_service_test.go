@@ -0,0 +1,59 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateUserRole_SignsTheUserOut(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	adminService := services.NewAdminService(m.userRepo, m.sessionRepo, m.refreshTokenRepo, m.moderationLogRepo)
+	m.userRepo.On("UpdateRole", mock.Anything, int64(7), domain.RoleModerator).Return(nil)
+	m.sessionRepo.On("RevokeAllForUser", mock.Anything, int64(7), "").Return(nil)
+	m.refreshTokenRepo.On("RevokeAllForUser", mock.Anything, int64(7)).Return(nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Role: domain.RoleModerator}, nil)
+
+	// Act
+	user, err := adminService.UpdateUserRole(context.Background(), 1, 7, &domain.UpdateUserRoleDTO{Role: domain.RoleModerator})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.RoleModerator, user.Role)
+	m.sessionRepo.AssertExpectations(t)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestUpdateUserRole_RejectsInvalidRoleAndSelfChange(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	adminService := services.NewAdminService(m.userRepo, m.sessionRepo, m.refreshTokenRepo, m.moderationLogRepo)
+
+	// Act
+	_, invalidErr := adminService.UpdateUserRole(context.Background(), 1, 7, &domain.UpdateUserRoleDTO{Role: "superuser"})
+	_, selfErr := adminService.UpdateUserRole(context.Background(), 1, 1, &domain.UpdateUserRoleDTO{Role: domain.RoleUser})
+
+	// Assert
+	assert.Error(t, invalidErr)
+	assert.IsType(t, &domain.BadRequestError{}, selfErr)
+	m.userRepo.AssertNotCalled(t, "UpdateRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateUserRole_UnknownUser(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	adminService := services.NewAdminService(m.userRepo, m.sessionRepo, m.refreshTokenRepo, m.moderationLogRepo)
+	m.userRepo.On("UpdateRole", mock.Anything, int64(7), domain.RoleAdmin).Return(domain.ErrNotFound)
+
+	// Act
+	_, err := adminService.UpdateUserRole(context.Background(), 1, 7, &domain.UpdateUserRoleDTO{Role: domain.RoleAdmin})
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+	m.sessionRepo.AssertNotCalled(t, "RevokeAllForUser", mock.Anything, mock.Anything, mock.Anything)
+}
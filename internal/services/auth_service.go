@@ -2,36 +2,71 @@ package services
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/floroz/go-social/internal/domain"
-	"github.com/floroz/go-social/internal/env"
 	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/tokens"
 	"github.com/floroz/go-social/internal/validation"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	refreshTokenSize = 32
+	// sessionTouchInterval throttles last_used_at writes to one per session per interval.
+	sessionTouchInterval = time.Minute
+)
+
 type authService struct {
-	userRepo interfaces.UserRepository
+	userRepo           interfaces.UserRepository
+	refreshTokenRepo   interfaces.RefreshTokenRepository
+	sessionRepo        interfaces.SessionRepository
+	impersonationRepo  interfaces.ImpersonationSessionRepository
+	ipLoginFailureRepo interfaces.IPLoginFailureRepository
+	throttlePolicy     *domain.LoginThrottlePolicy
+	keyring            *jwtkeys.Keyring
 }
 
-func NewAuthService(userRepo interfaces.UserRepository) *authService {
+func NewAuthService(
+	userRepo interfaces.UserRepository,
+	refreshTokenRepo interfaces.RefreshTokenRepository,
+	sessionRepo interfaces.SessionRepository,
+	impersonationRepo interfaces.ImpersonationSessionRepository,
+	ipLoginFailureRepo interfaces.IPLoginFailureRepository,
+	throttlePolicy *domain.LoginThrottlePolicy,
+	keyring *jwtkeys.Keyring,
+) *authService {
 	return &authService{
-		userRepo: userRepo,
+		userRepo:           userRepo,
+		refreshTokenRepo:   refreshTokenRepo,
+		sessionRepo:        sessionRepo,
+		impersonationRepo:  impersonationRepo,
+		ipLoginFailureRepo: ipLoginFailureRepo,
+		throttlePolicy:     throttlePolicy,
+		keyring:            keyring,
 	}
 }
 
-func (s *authService) GenerateJWTToken(user *domain.User, expiration time.Duration) (string, error) {
-	jwtSecret := env.GetJWTSecret()
+// GenerateJWTToken signs an access token with the active key of the keyring.
+func (s *authService) GenerateJWTToken(user *domain.User, sessionId string, expiration time.Duration) (string, error) {
+	return s.signAccessToken(newUserClaims(user, sessionId, expiration))
+}
 
-	if jwtSecret == "" {
-		log.Error().Msg("jwt secret is not set")
-		return "", domain.NewInternalServerError("jwt secret is not set")
-	}
+// GenerateImpersonationToken signs an access token for the subject of an impersonation
+// session, naming the admin acting on their behalf in the "act" claim.
+func (s *authService) GenerateImpersonationToken(subject *domain.User, actor *domain.Actor, impersonationId string, expiration time.Duration) (string, error) {
+	claims := newUserClaims(subject, impersonationId, expiration)
+	claims.Actor = actor
+	return s.signAccessToken(claims)
+}
 
-	claims := domain.UserClaims{
+func newUserClaims(user *domain.User, sessionId string, expiration time.Duration) domain.UserClaims {
+	return domain.UserClaims{
 		ID:        user.ID,
 		Username:  user.Username,
 		FirstName: user.FirstName,
@@ -39,14 +74,17 @@ func (s *authService) GenerateJWTToken(user *domain.User, expiration time.Durati
 		Email:     user.Email,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
+		SessionID: sessionId,
+		Role:      user.Role,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(expiration).Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(jwtSecret))
+func (s *authService) signAccessToken(claims domain.UserClaims) (string, error) {
+	signedToken, err := s.keyring.Sign(claims)
 
 	if err != nil {
 		log.Error().Err(err).Msg("failed to generate jwt token")
@@ -56,18 +94,48 @@ func (s *authService) GenerateJWTToken(user *domain.User, expiration time.Durati
 	return signedToken, nil
 }
 
-func (s *authService) Login(ctx context.Context, loginUser *domain.LoginUserDTO) (*domain.User, error) {
+// AuthenticateAccessToken verifies the signature of an access token with the keyring and
+// checks that its session is still live.
+func (s *authService) AuthenticateAccessToken(ctx context.Context, rawToken string) (*domain.UserClaims, error) {
+	claims := &domain.UserClaims{}
+
+	token, err := s.keyring.Parse(rawToken, claims)
+	if err != nil || !token.Valid {
+		return nil, domain.NewUnauthorizedError("invalid token")
+	}
+
+	if err := s.ValidateSession(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// JWKS returns the public keys that verify access tokens.
+func (s *authService) JWKS() jwtkeys.JSONWebKeySet {
+	return s.keyring.JWKS()
+}
+
+// Login checks the user's credentials. Failed attempts are counted per account and per
+// client IP; past the policy thresholds further attempts are rejected until the lock expires.
+// A locked IP gets an AccountLockedError, while a locked account gets the same error as a
+// wrong password, so that locks do not reveal which email addresses have an account.
+func (s *authService) Login(ctx context.Context, loginUser *domain.LoginUserDTO, ipAddress string) (*domain.User, error) {
 	err := validation.Validate.Struct(loginUser)
 
 	if err != nil {
 		return nil, domain.NewBadRequestError(err.Error())
 	}
 
+	if err := s.checkIPLock(ctx, ipAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByEmail(ctx, loginUser.Email)
 
 	if err != nil && err == domain.ErrNotFound {
 		log.Error().Err(err).Msg("attempting to login a user that doesn't exist")
-		return nil, domain.NewUnauthorizedError("invalid email or password")
+		return nil, s.recordIPFailure(ctx, ipAddress, domain.NewUnauthorizedError("invalid email or password"))
 	}
 
 	if err != nil {
@@ -75,11 +143,332 @@ func (s *authService) Login(ctx context.Context, loginUser *domain.LoginUserDTO)
 		return nil, domain.NewInternalServerError("failed to get user")
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(loginUser.Password))
+	// Attempts on a locked account are rejected without checking the password, and do not extend the lock.
+	if user.LockedUntil != nil && time.Now().Before(*user.LockedUntil) {
+		log.Info().Int64("userId", user.ID).Msg("rejected login attempt on locked account")
+		return nil, s.recordIPFailure(ctx, ipAddress, domain.NewUnauthorizedError("invalid email or password"))
+	}
+
+	// Users who only sign in with an external provider have no password: any attempt is wrong.
+	if !user.HasPassword() {
+		err = bcrypt.ErrMismatchedHashAndPassword
+	} else {
+		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(loginUser.Password))
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("failed to compare password")
-		return nil, domain.NewUnauthorizedError("invalid email or password")
+		s.recordAccountFailure(ctx, user.ID)
+		return nil, s.recordIPFailure(ctx, ipAddress, domain.NewUnauthorizedError("invalid email or password"))
+	}
+
+	if user.FailedLoginAttempts > 0 || user.LockedUntil != nil {
+		if err := s.userRepo.ResetFailedLogins(ctx, user.ID); err != nil {
+			log.Error().Err(err).Msg("failed to reset failed login attempts")
+		}
 	}
 
 	return user, nil
 }
+
+// StartSession creates a session for the user and issues the first refresh token of its family.
+// Logging in during the grace period of an account deletion cancels the deletion.
+func (s *authService) StartSession(ctx context.Context, userId int64, client *domain.SessionClient, expiration time.Duration) (*domain.RefreshToken, error) {
+	cancelled, err := s.userRepo.CancelDeletion(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to cancel account deletion")
+		return nil, domain.NewInternalServerError("failed to create session")
+	}
+	if cancelled {
+		log.Info().Int64("userId", userId).Msg("account deletion cancelled by login")
+	}
+
+	session, err := s.sessionRepo.Create(ctx, uuid.NewString(), userId, client)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create session")
+		return nil, domain.NewInternalServerError("failed to create session")
+	}
+
+	return s.createRefreshToken(ctx, userId, session.ID, expiration)
+}
+
+// RotateRefreshToken exchanges a refresh token for a new one in the same family.
+// Presenting a token that was already rotated or revoked is treated as theft and
+// revokes the whole session, forcing every holder to log in again.
+func (s *authService) RotateRefreshToken(ctx context.Context, refreshToken string, expiration time.Duration) (*domain.User, *domain.RefreshToken, error) {
+	existing, err := s.refreshTokenRepo.GetByHash(ctx, tokens.Hash(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.NewUnauthorizedError("invalid refresh token")
+		}
+		log.Error().Err(err).Msg("failed to get refresh token")
+		return nil, nil, domain.NewInternalServerError("failed to refresh token")
+	}
+
+	if existing.RevokedAt != nil {
+		return nil, nil, s.handleRefreshTokenReuse(ctx, existing)
+	}
+
+	if time.Now().After(existing.ExpiresAt) {
+		return nil, nil, domain.NewUnauthorizedError("refresh token expired")
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, existing.FamilyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.NewUnauthorizedError("invalid refresh token")
+		}
+		log.Error().Err(err).Msg("failed to get session for refresh token")
+		return nil, nil, domain.NewInternalServerError("failed to refresh token")
+	}
+
+	if session.RevokedAt != nil {
+		return nil, nil, domain.NewUnauthorizedError("session has been revoked")
+	}
+
+	// Revoke only succeeds once per token, so a concurrent rotation of the same token loses here.
+	if err := s.refreshTokenRepo.Revoke(ctx, existing.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, s.handleRefreshTokenReuse(ctx, existing)
+		}
+		log.Error().Err(err).Msg("failed to revoke refresh token")
+		return nil, nil, domain.NewInternalServerError("failed to refresh token")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, existing.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.NewUnauthorizedError("invalid refresh token")
+		}
+		log.Error().Err(err).Msg("failed to get user for refresh token")
+		return nil, nil, domain.NewInternalServerError("failed to refresh token")
+	}
+
+	newToken, err := s.createRefreshToken(ctx, existing.UserID, existing.FamilyID, expiration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.sessionRepo.Touch(ctx, session.ID); err != nil {
+		log.Error().Err(err).Msg("failed to update session last used time")
+	}
+
+	return user, newToken, nil
+}
+
+// RevokeRefreshToken ends the session the given token belongs to. Unknown tokens are ignored
+// so that logging out is idempotent.
+func (s *authService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	existing, err := s.refreshTokenRepo.GetByHash(ctx, tokens.Hash(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		log.Error().Err(err).Msg("failed to get refresh token")
+		return domain.NewInternalServerError("failed to revoke refresh token")
+	}
+
+	if err := s.revokeSession(ctx, existing.UserID, existing.FamilyID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		log.Error().Err(err).Msg("failed to revoke session")
+		return domain.NewInternalServerError("failed to revoke refresh token")
+	}
+
+	return nil
+}
+
+// ValidateSession rejects access tokens whose session has been revoked, so that revocation
+// takes effect on the next request instead of when the access token expires.
+func (s *authService) ValidateSession(ctx context.Context, claims *domain.UserClaims) error {
+	if claims.SessionID == "" {
+		return domain.NewUnauthorizedError("invalid token")
+	}
+
+	if claims.IsImpersonated() {
+		return s.validateImpersonation(ctx, claims)
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewUnauthorizedError("invalid session")
+		}
+		log.Error().Err(err).Msg("failed to get session")
+		return domain.NewInternalServerError("failed to validate session")
+	}
+
+	if session.UserID != claims.ID || session.RevokedAt != nil {
+		return domain.NewUnauthorizedError("session has been revoked")
+	}
+
+	if time.Since(session.LastUsedAt) > sessionTouchInterval {
+		if err := s.sessionRepo.Touch(ctx, session.ID); err != nil {
+			log.Error().Err(err).Msg("failed to update session last used time")
+		}
+	}
+
+	return nil
+}
+
+// validateImpersonation rejects access tokens of impersonation sessions that have ended or
+// expired, or whose admin no longer holds the permission to impersonate.
+func (s *authService) validateImpersonation(ctx context.Context, claims *domain.UserClaims) error {
+	impersonation, err := s.impersonationRepo.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewUnauthorizedError("invalid session")
+		}
+		log.Error().Err(err).Msg("failed to get impersonation session")
+		return domain.NewInternalServerError("failed to validate session")
+	}
+
+	if impersonation.SubjectID != claims.ID || impersonation.ActorID != claims.Actor.ID || !impersonation.IsActive() {
+		return domain.NewUnauthorizedError("impersonation session has ended")
+	}
+
+	actor, err := s.userRepo.GetByID(ctx, impersonation.ActorID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewUnauthorizedError("impersonation session has ended")
+		}
+		log.Error().Err(err).Msg("failed to get impersonating user")
+		return domain.NewInternalServerError("failed to validate session")
+	}
+
+	if !actor.Role.HasPermission(domain.PermissionImpersonateUsers) {
+		return domain.NewUnauthorizedError("impersonation session has ended")
+	}
+
+	return nil
+}
+
+func (s *authService) ListSessions(ctx context.Context, userId int64) ([]domain.Session, error) {
+	sessions, err := s.sessionRepo.ListActiveByUserID(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list sessions")
+		return nil, domain.NewInternalServerError("failed to list sessions")
+	}
+
+	return sessions, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, userId int64, sessionId string) error {
+	err := s.revokeSession(ctx, userId, sessionId)
+
+	switch {
+	case err != nil && errors.Is(err, domain.ErrNotFound):
+		return domain.NewNotFoundError("session not found")
+	case err != nil:
+		log.Error().Err(err).Msg("failed to revoke session")
+		return domain.NewInternalServerError("failed to revoke session")
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions logs the user out everywhere except the current session.
+func (s *authService) RevokeOtherSessions(ctx context.Context, userId int64, currentSessionId string) error {
+	if err := s.sessionRepo.RevokeAllForUser(ctx, userId, currentSessionId); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return domain.NewInternalServerError("failed to revoke sessions")
+	}
+
+	return nil
+}
+
+func (s *authService) checkIPLock(ctx context.Context, ipAddress string) error {
+	failure, err := s.ipLoginFailureRepo.GetByIP(ctx, ipAddress)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		log.Error().Err(err).Msg("failed to get login failures for ip")
+		return domain.NewInternalServerError("failed to login")
+	}
+
+	if failure.LockedUntil != nil && time.Now().Before(*failure.LockedUntil) {
+		return domain.NewAccountLockedError("too many failed login attempts, please try again later", time.Until(*failure.LockedUntil))
+	}
+
+	return nil
+}
+
+// recordAccountFailure counts a failed login for the account and locks it once the policy
+// threshold is reached. The attempt is reported like any other failure.
+func (s *authService) recordAccountFailure(ctx context.Context, userId int64) {
+	attempts, err := s.userRepo.IncrementFailedLogins(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to record failed login attempt")
+		return
+	}
+
+	lockout := s.throttlePolicy.LockoutFor(attempts, s.throttlePolicy.MaxAccountFailures)
+	if lockout == 0 {
+		return
+	}
+
+	log.Warn().Int64("userId", userId).Int("attempts", attempts).Dur("lockout", lockout).Msg("locking account after failed login attempts")
+	if err := s.userRepo.LockUntil(ctx, userId, time.Now().Add(lockout)); err != nil {
+		log.Error().Err(err).Msg("failed to lock account")
+	}
+}
+
+// recordIPFailure counts a failed login for the client IP and locks it once the policy
+// threshold is reached. It returns the error to report for the attempt, which is loginErr
+// unless this attempt caused the lock.
+func (s *authService) recordIPFailure(ctx context.Context, ipAddress string, loginErr error) error {
+	failure, err := s.ipLoginFailureRepo.RecordFailure(ctx, ipAddress, s.throttlePolicy.IPFailureWindow)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to record failed login attempt for ip")
+		return loginErr
+	}
+
+	lockout := s.throttlePolicy.LockoutFor(failure.FailedAttempts, s.throttlePolicy.MaxIPFailures)
+	if lockout == 0 {
+		return loginErr
+	}
+
+	log.Warn().Str("ip", ipAddress).Int("attempts", failure.FailedAttempts).Dur("lockout", lockout).Msg("locking ip after failed login attempts")
+	if err := s.ipLoginFailureRepo.LockUntil(ctx, ipAddress, time.Now().Add(lockout)); err != nil {
+		log.Error().Err(err).Msg("failed to lock ip")
+		return loginErr
+	}
+
+	return domain.NewAccountLockedError("too many failed login attempts, please try again later", lockout)
+}
+
+func (s *authService) createRefreshToken(ctx context.Context, userId int64, familyId string, expiration time.Duration) (*domain.RefreshToken, error) {
+	rawToken, err := tokens.Generate(refreshTokenSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate refresh token")
+		return nil, domain.NewInternalServerError("failed to generate refresh token")
+	}
+
+	token, err := s.refreshTokenRepo.Create(ctx, userId, familyId, tokens.Hash(rawToken), time.Now().Add(expiration))
+	if err != nil {
+		log.Error().Err(err).Msg("failed to store refresh token")
+		return nil, domain.NewInternalServerError("failed to generate refresh token")
+	}
+
+	token.Token = rawToken
+
+	return token, nil
+}
+
+// revokeSession revokes a session together with its refresh token family.
+func (s *authService) revokeSession(ctx context.Context, userId int64, sessionId string) error {
+	if err := s.sessionRepo.Revoke(ctx, userId, sessionId); err != nil {
+		return err
+	}
+
+	return s.refreshTokenRepo.RevokeFamily(ctx, sessionId)
+}
+
+func (s *authService) handleRefreshTokenReuse(ctx context.Context, token *domain.RefreshToken) error {
+	log.Warn().Int64("userId", token.UserID).Str("familyId", token.FamilyID).Msg("refresh token reuse detected, revoking session")
+
+	if err := s.revokeSession(ctx, token.UserID, token.FamilyID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+		log.Error().Err(err).Msg("failed to revoke session")
+		return domain.NewInternalServerError("failed to refresh token")
+	}
+
+	return domain.NewUnauthorizedError("refresh token has already been used")
+}
@@ -0,0 +1,588 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthenticateAccessToken_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	user := &domain.User{ID: 7, Username: "jane"}
+	accessToken, err := authService.GenerateJWTToken(user, "session-1", time.Minute)
+	assert.NoError(t, err)
+
+	m.sessionRepo.On("GetByID", mock.Anything, "session-1").
+		Return(&domain.Session{ID: "session-1", UserID: 7, LastUsedAt: time.Now()}, nil)
+
+	// Act
+	claims, err := authService.AuthenticateAccessToken(context.Background(), accessToken)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), claims.ID)
+	assert.Equal(t, "session-1", claims.SessionID)
+}
+
+func TestAuthenticateAccessToken_SignedWithAnotherKey(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	otherAuthService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	accessToken, err := otherAuthService.GenerateJWTToken(&domain.User{ID: 7}, "session-1", time.Minute)
+	assert.NoError(t, err)
+
+	// Act
+	_, err = authService.AuthenticateAccessToken(context.Background(), accessToken)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.sessionRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticateAccessToken_Expired(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	accessToken, err := authService.GenerateJWTToken(&domain.User{ID: 7}, "session-1", -time.Minute)
+	assert.NoError(t, err)
+
+	// Act
+	_, err = authService.AuthenticateAccessToken(context.Background(), accessToken)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.sessionRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestStartSession_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	client := &domain.SessionClient{UserAgent: "test-agent", IPAddress: "127.0.0.1"}
+	session := &domain.Session{ID: "session-1", UserID: 7}
+
+	m.userRepo.On("CancelDeletion", mock.Anything, int64(7)).Return(true, nil)
+	m.sessionRepo.On("Create", mock.Anything, mock.AnythingOfType("string"), int64(7), client).Return(session, nil)
+	m.refreshTokenRepo.On("Create", mock.Anything, int64(7), session.ID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(&domain.RefreshToken{ID: 1, UserID: 7, FamilyID: session.ID}, nil)
+
+	// Act
+	token, err := authService.StartSession(context.Background(), 7, client, time.Hour)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, token.FamilyID)
+	assert.NotEmpty(t, token.Token)
+	m.userRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_Success(t *testing.T) {
+	// Arrange
+	const rawToken = "raw-refresh-token"
+	existing := &domain.RefreshToken{
+		ID:        1,
+		UserID:    7,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	user := &domain.User{ID: 7, Username: "test"}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+	m.sessionRepo.On("GetByID", mock.Anything, existing.FamilyID).Return(&domain.Session{ID: existing.FamilyID, UserID: 7}, nil)
+	m.refreshTokenRepo.On("Revoke", mock.Anything, existing.ID).Return(nil)
+	m.userRepo.On("GetByID", mock.Anything, existing.UserID).Return(user, nil)
+	m.refreshTokenRepo.On("Create", mock.Anything, existing.UserID, existing.FamilyID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(&domain.RefreshToken{ID: 2, UserID: existing.UserID, FamilyID: existing.FamilyID}, nil)
+	m.sessionRepo.On("Touch", mock.Anything, existing.FamilyID).Return(nil)
+
+	// Act
+	gotUser, newToken, err := authService.RotateRefreshToken(context.Background(), rawToken, time.Hour)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user, gotUser)
+	assert.NotEmpty(t, newToken.Token)
+	assert.NotEqual(t, rawToken, newToken.Token)
+	assert.Equal(t, existing.FamilyID, newToken.FamilyID)
+	m.refreshTokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+	m.refreshTokenRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_ReuseRevokesSession(t *testing.T) {
+	// Arrange
+	const rawToken = "already-rotated-token"
+	revokedAt := time.Now().Add(-time.Minute)
+	existing := &domain.RefreshToken{
+		ID:        1,
+		UserID:    7,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+		RevokedAt: &revokedAt,
+	}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+	m.sessionRepo.On("Revoke", mock.Anything, existing.UserID, existing.FamilyID).Return(nil)
+	m.refreshTokenRepo.On("RevokeFamily", mock.Anything, existing.FamilyID).Return(nil)
+
+	// Act
+	gotUser, newToken, err := authService.RotateRefreshToken(context.Background(), rawToken, time.Hour)
+
+	// Assert
+	assert.Nil(t, gotUser)
+	assert.Nil(t, newToken)
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.refreshTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.refreshTokenRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_ConcurrentRotationRevokesSession(t *testing.T) {
+	// Arrange
+	const rawToken = "raced-token"
+	existing := &domain.RefreshToken{
+		ID:        1,
+		UserID:    7,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+	m.sessionRepo.On("GetByID", mock.Anything, existing.FamilyID).Return(&domain.Session{ID: existing.FamilyID, UserID: 7}, nil)
+	m.refreshTokenRepo.On("Revoke", mock.Anything, existing.ID).Return(domain.ErrNotFound)
+	m.sessionRepo.On("Revoke", mock.Anything, existing.UserID, existing.FamilyID).Return(nil)
+	m.refreshTokenRepo.On("RevokeFamily", mock.Anything, existing.FamilyID).Return(nil)
+
+	// Act
+	_, _, err := authService.RotateRefreshToken(context.Background(), rawToken, time.Hour)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.refreshTokenRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_RevokedSession(t *testing.T) {
+	// Arrange
+	const rawToken = "token-of-revoked-session"
+	revokedAt := time.Now().Add(-time.Minute)
+	existing := &domain.RefreshToken{
+		ID:        1,
+		UserID:    7,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+	m.sessionRepo.On("GetByID", mock.Anything, existing.FamilyID).Return(&domain.Session{ID: existing.FamilyID, UserID: 7, RevokedAt: &revokedAt}, nil)
+
+	// Act
+	_, _, err := authService.RotateRefreshToken(context.Background(), rawToken, time.Hour)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.refreshTokenRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_Expired(t *testing.T) {
+	// Arrange
+	const rawToken = "expired-token"
+	existing := &domain.RefreshToken{
+		ID:        1,
+		UserID:    7,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+
+	// Act
+	_, _, err := authService.RotateRefreshToken(context.Background(), rawToken, time.Hour)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.refreshTokenRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestRotateRefreshToken_Unknown(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	var nullptr *domain.RefreshToken
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, mock.Anything).Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	_, _, err := authService.RotateRefreshToken(context.Background(), "unknown", time.Hour)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestRevokeRefreshToken_RevokesSession(t *testing.T) {
+	// Arrange
+	const rawToken = "logout-token"
+	existing := &domain.RefreshToken{ID: 1, UserID: 7, FamilyID: "family-1"}
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+
+	m.refreshTokenRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).Return(existing, nil)
+	m.sessionRepo.On("Revoke", mock.Anything, existing.UserID, existing.FamilyID).Return(nil)
+	m.refreshTokenRepo.On("RevokeFamily", mock.Anything, existing.FamilyID).Return(nil)
+
+	// Act
+	err := authService.RevokeRefreshToken(context.Background(), rawToken)
+
+	// Assert
+	assert.NoError(t, err)
+	m.refreshTokenRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+}
+
+func TestValidateSession(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		claims      *domain.UserClaims
+		session     *domain.Session
+		sessionErr  error
+		expectTouch bool
+		expectErr   bool
+	}{
+		{
+			name:      "missing session id",
+			claims:    &domain.UserClaims{ID: 7},
+			expectErr: true,
+		},
+		{
+			name:       "unknown session",
+			claims:     &domain.UserClaims{ID: 7, SessionID: "s1"},
+			sessionErr: domain.ErrNotFound,
+			expectErr:  true,
+		},
+		{
+			name:      "revoked session",
+			claims:    &domain.UserClaims{ID: 7, SessionID: "s1"},
+			session:   &domain.Session{ID: "s1", UserID: 7, LastUsedAt: time.Now(), RevokedAt: &revokedAt},
+			expectErr: true,
+		},
+		{
+			name:      "session of another user",
+			claims:    &domain.UserClaims{ID: 7, SessionID: "s1"},
+			session:   &domain.Session{ID: "s1", UserID: 8, LastUsedAt: time.Now()},
+			expectErr: true,
+		},
+		{
+			name:    "recently used session",
+			claims:  &domain.UserClaims{ID: 7, SessionID: "s1"},
+			session: &domain.Session{ID: "s1", UserID: 7, LastUsedAt: time.Now()},
+		},
+		{
+			name:        "stale session is touched",
+			claims:      &domain.UserClaims{ID: 7, SessionID: "s1"},
+			session:     &domain.Session{ID: "s1", UserID: 7, LastUsedAt: time.Now().Add(-time.Hour)},
+			expectTouch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+			if tt.claims.SessionID != "" {
+				m.sessionRepo.On("GetByID", mock.Anything, tt.claims.SessionID).Return(tt.session, tt.sessionErr)
+			}
+			if tt.expectTouch {
+				m.sessionRepo.On("Touch", mock.Anything, tt.claims.SessionID).Return(nil)
+			}
+
+			// Act
+			err := authService.ValidateSession(context.Background(), tt.claims)
+
+			// Assert
+			if tt.expectErr {
+				assert.IsType(t, &domain.UnauthorizedError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if !tt.expectTouch {
+				m.sessionRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything)
+			}
+			m.sessionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthenticateAccessToken_Impersonation(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	subject := &domain.User{ID: 7, Username: "jane"}
+	accessToken, err := authService.GenerateImpersonationToken(subject, &domain.Actor{ID: 1, Username: "admin"}, "impersonation-1", time.Minute)
+	assert.NoError(t, err)
+
+	m.impersonationRepo.On("GetByID", mock.Anything, "impersonation-1").
+		Return(&domain.ImpersonationSession{ID: "impersonation-1", ActorID: 1, SubjectID: 7, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.User{ID: 1, Role: domain.RoleAdmin}, nil)
+
+	// Act
+	claims, err := authService.AuthenticateAccessToken(context.Background(), accessToken)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), claims.ID)
+	assert.True(t, claims.IsImpersonated())
+	assert.Equal(t, int64(1), claims.Actor.ID)
+	m.sessionRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestValidateSession_Impersonation(t *testing.T) {
+	endedAt := time.Now().Add(-time.Minute)
+	active := func() *domain.ImpersonationSession {
+		return &domain.ImpersonationSession{ID: "i1", ActorID: 1, SubjectID: 7, ExpiresAt: time.Now().Add(time.Minute)}
+	}
+
+	tests := []struct {
+		name          string
+		impersonation *domain.ImpersonationSession
+		lookupErr     error
+		actor         *domain.User
+		expectErr     bool
+	}{
+		{
+			name:          "active session of an admin",
+			impersonation: active(),
+			actor:         &domain.User{ID: 1, Role: domain.RoleAdmin},
+		},
+		{
+			name:      "unknown session",
+			lookupErr: domain.ErrNotFound,
+			expectErr: true,
+		},
+		{
+			name:          "ended session",
+			impersonation: &domain.ImpersonationSession{ID: "i1", ActorID: 1, SubjectID: 7, ExpiresAt: time.Now().Add(time.Minute), EndedAt: &endedAt},
+			expectErr:     true,
+		},
+		{
+			name:          "expired session",
+			impersonation: &domain.ImpersonationSession{ID: "i1", ActorID: 1, SubjectID: 7, ExpiresAt: time.Now().Add(-time.Minute)},
+			expectErr:     true,
+		},
+		{
+			name:          "session of another admin",
+			impersonation: &domain.ImpersonationSession{ID: "i1", ActorID: 2, SubjectID: 7, ExpiresAt: time.Now().Add(time.Minute)},
+			expectErr:     true,
+		},
+		{
+			name:          "admin demoted since",
+			impersonation: active(),
+			actor:         &domain.User{ID: 1, Role: domain.RoleUser},
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+			claims := &domain.UserClaims{ID: 7, SessionID: "i1", Actor: &domain.Actor{ID: 1}}
+			m.impersonationRepo.On("GetByID", mock.Anything, "i1").Return(tt.impersonation, tt.lookupErr)
+			if tt.actor != nil {
+				m.userRepo.On("GetByID", mock.Anything, int64(1)).Return(tt.actor, nil)
+			}
+
+			// Act
+			err := authService.ValidateSession(context.Background(), claims)
+
+			// Assert
+			if tt.expectErr {
+				assert.IsType(t, &domain.UnauthorizedError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			m.sessionRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func newLoginUser(t *testing.T, password string) *domain.User {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return &domain.User{ID: 7, Email: "jane@example.com", Password: string(hashedPassword)}
+}
+
+func TestLogin_SuccessResetsFailedAttempts(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	user := newLoginUser(t, "password123")
+	user.FailedLoginAttempts = 3
+
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").Return((*domain.IPLoginFailure)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userRepo.On("ResetFailedLogins", mock.Anything, user.ID).Return(nil)
+
+	// Act
+	loggedIn, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: user.Email, Password: "password123"}, "203.0.113.7")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, loggedIn.ID)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestLogin_LocksAccountAtThreshold(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	user := newLoginUser(t, "password123")
+	policy := domain.DefaultLoginThrottlePolicy()
+
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").Return((*domain.IPLoginFailure)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userRepo.On("IncrementFailedLogins", mock.Anything, user.ID).Return(policy.MaxAccountFailures, nil)
+	m.userRepo.On("LockUntil", mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(nil)
+	m.ipLoginFailureRepo.On("RecordFailure", mock.Anything, "203.0.113.7", policy.IPFailureWindow).
+		Return(&domain.IPLoginFailure{IPAddress: "203.0.113.7", FailedAttempts: 1}, nil)
+
+	// Act
+	_, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: user.Email, Password: "wrongpassword"}, "203.0.113.7")
+
+	// Assert: The lock is not revealed to the client
+	assert.Equal(t, domain.NewUnauthorizedError("invalid email or password"), err)
+	m.userRepo.AssertExpectations(t)
+	m.ipLoginFailureRepo.AssertNotCalled(t, "LockUntil", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLogin_LockedAccountSkipsPasswordCheck(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	user := newLoginUser(t, "password123")
+	lockedUntil := time.Now().Add(time.Minute)
+	user.LockedUntil = &lockedUntil
+
+	policy := domain.DefaultLoginThrottlePolicy()
+
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").Return((*domain.IPLoginFailure)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.ipLoginFailureRepo.On("RecordFailure", mock.Anything, "203.0.113.7", policy.IPFailureWindow).
+		Return(&domain.IPLoginFailure{IPAddress: "203.0.113.7", FailedAttempts: 1}, nil)
+
+	// Act
+	_, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: user.Email, Password: "password123"}, "203.0.113.7")
+
+	// Assert: The locked account answers like an unknown email
+	assert.Equal(t, domain.NewUnauthorizedError("invalid email or password"), err)
+	m.ipLoginFailureRepo.AssertExpectations(t)
+	m.userRepo.AssertNotCalled(t, "IncrementFailedLogins", mock.Anything, mock.Anything)
+	m.userRepo.AssertNotCalled(t, "ResetFailedLogins", mock.Anything, mock.Anything)
+}
+
+func TestLogin_PasswordlessUser(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	user := &domain.User{ID: 7, Email: "jane@example.com"}
+	policy := domain.DefaultLoginThrottlePolicy()
+
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").Return((*domain.IPLoginFailure)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userRepo.On("IncrementFailedLogins", mock.Anything, user.ID).Return(1, nil)
+	m.ipLoginFailureRepo.On("RecordFailure", mock.Anything, "203.0.113.7", policy.IPFailureWindow).
+		Return(&domain.IPLoginFailure{IPAddress: "203.0.113.7", FailedAttempts: 1}, nil)
+
+	// Act
+	_, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: user.Email, Password: "password123"}, "203.0.113.7")
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestLogin_LockedIP(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	lockedUntil := time.Now().Add(time.Minute)
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").
+		Return(&domain.IPLoginFailure{IPAddress: "203.0.113.7", FailedAttempts: 20, LockedUntil: &lockedUntil}, nil)
+
+	// Act
+	_, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: "jane@example.com", Password: "password123"}, "203.0.113.7")
+
+	// Assert
+	var lockedErr *domain.AccountLockedError
+	assert.ErrorAs(t, err, &lockedErr)
+	m.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+}
+
+func TestLogin_UnknownEmailCountsIPFailure(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authService := services.NewAuthService(m.userRepo, m.refreshTokenRepo, m.sessionRepo, m.impersonationRepo, m.ipLoginFailureRepo, domain.DefaultLoginThrottlePolicy(), newTestKeyring(t))
+	policy := domain.DefaultLoginThrottlePolicy()
+	m.ipLoginFailureRepo.On("GetByIP", mock.Anything, "203.0.113.7").Return((*domain.IPLoginFailure)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, "nobody@example.com").Return((*domain.User)(nil), domain.ErrNotFound)
+	m.ipLoginFailureRepo.On("RecordFailure", mock.Anything, "203.0.113.7", policy.IPFailureWindow).
+		Return(&domain.IPLoginFailure{IPAddress: "203.0.113.7", FailedAttempts: policy.MaxIPFailures}, nil)
+	m.ipLoginFailureRepo.On("LockUntil", mock.Anything, "203.0.113.7", mock.AnythingOfType("time.Time")).Return(nil)
+
+	// Act
+	_, err := authService.Login(context.Background(), &domain.LoginUserDTO{Email: "nobody@example.com", Password: "password123"}, "203.0.113.7")
+
+	// Assert
+	var lockedErr *domain.AccountLockedError
+	assert.ErrorAs(t, err, &lockedErr)
+	m.ipLoginFailureRepo.AssertExpectations(t)
+}
+
+func TestLoginThrottlePolicy_LockoutFor(t *testing.T) {
+	policy := &domain.LoginThrottlePolicy{BaseLockout: time.Minute, MaxLockout: 10 * time.Minute}
+
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 4, expected: 0},
+		{failures: 5, expected: time.Minute},
+		{failures: 6, expected: 2 * time.Minute},
+		{failures: 8, expected: 8 * time.Minute},
+		{failures: 9, expected: 10 * time.Minute},
+		{failures: 50, expected: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, policy.LockoutFor(tt.failures, 5), "failures=%d", tt.failures)
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+// moderationPermissions maps the content types to the permission needed to act on content
+// owned by someone else.
+var moderationPermissions = map[domain.ModerationTargetType]domain.Permission{
+	domain.ModerationTargetPost:    domain.PermissionModeratePosts,
+	domain.ModerationTargetComment: domain.PermissionModerateComments,
+}
+
+type authorizer struct {
+	userRepo          interfaces.UserRepository
+	moderationLogRepo interfaces.ModerationLogRepository
+}
+
+func NewAuthorizer(userRepo interfaces.UserRepository, moderationLogRepo interfaces.ModerationLogRepository) interfaces.Authorizer {
+	return &authorizer{userRepo: userRepo, moderationLogRepo: moderationLogRepo}
+}
+
+// Authorize allows owners to act on their content. Anyone else needs the moderation
+// permission of the content type; the role is read from the database rather than from the
+// access token so that a demotion applies immediately. Authorize only checks: an override is
+// recorded with RecordOverride once it is carried out.
+func (a *authorizer) Authorize(ctx context.Context, actorId int64, action domain.ModerationAction, resource *domain.ModeratedResource) error {
+	if resource.OwnerID == actorId {
+		return nil
+	}
+
+	_, err := a.moderator(ctx, actorId, action, resource)
+	return err
+}
+
+// RecordOverride records in the moderation log an action that a moderator carried out on
+// content owned by someone else. Actions of owners are not recorded.
+func (a *authorizer) RecordOverride(ctx context.Context, actorId int64, action domain.ModerationAction, resource *domain.ModeratedResource) error {
+	if resource.OwnerID == actorId {
+		return nil
+	}
+
+	actor, err := a.moderator(ctx, actorId, action, resource)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.moderationLogRepo.Create(ctx, &domain.ModerationLogEntry{
+		ActorID:         actor.ID,
+		ActorRole:       actor.Role,
+		Action:          action,
+		TargetType:      resource.Type,
+		TargetID:        resource.ID,
+		TargetUserID:    resource.OwnerID,
+		PreviousContent: resource.Content,
+	})
+	if err != nil {
+		log.Error().Err(err).
+			Int64("actorId", actorId).
+			Str("action", string(action)).
+			Str("targetType", string(resource.Type)).
+			Int64("targetId", resource.ID).
+			Msg("failed to record moderation action")
+		return domain.NewInternalServerError("failed to record moderation action")
+	}
+
+	log.Info().
+		Int64("actorId", actor.ID).
+		Str("role", string(actor.Role)).
+		Str("action", string(action)).
+		Str("targetType", string(resource.Type)).
+		Int64("targetId", resource.ID).
+		Msg("moderation override")
+
+	return nil
+}
+
+// moderator returns the actor when their role grants the moderation permission needed to act
+// on the resource.
+func (a *authorizer) moderator(ctx context.Context, actorId int64, action domain.ModerationAction, resource *domain.ModeratedResource) (*domain.User, error) {
+	forbidden := domain.NewForbiddenError(fmt.Sprintf("not allowed to %s %s", action, resource.Type))
+
+	permission, ok := moderationPermissions[resource.Type]
+	if !ok {
+		return nil, forbidden
+	}
+
+	actor, err := a.userRepo.GetByID(ctx, actorId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, forbidden
+		}
+		log.Error().Err(err).Int64("actorId", actorId).Msg("failed to get user for authorization")
+		return nil, domain.NewInternalServerError("failed to authorize request")
+	}
+
+	if !actor.Role.HasPermission(permission) {
+		return nil, forbidden
+	}
+
+	return actor, nil
+}
@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthorize_OwnerIsAllowedWithoutAudit(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	post := &domain.ModeratedResource{Type: domain.ModerationTargetPost, ID: 10, OwnerID: 7, Content: "hello"}
+
+	// Act
+	err := authorizer.Authorize(context.Background(), 7, domain.ModerationActionDelete, post)
+
+	// Assert
+	assert.NoError(t, err)
+	m.userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestAuthorize_UserCannotActOnOthersContent(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	comment := &domain.ModeratedResource{Type: domain.ModerationTargetComment, ID: 11, OwnerID: 7, Content: "hello"}
+	m.userRepo.On("GetByID", mock.Anything, int64(8)).Return(&domain.User{ID: 8, Role: domain.RoleUser}, nil)
+
+	// Act
+	err := authorizer.Authorize(context.Background(), 8, domain.ModerationActionUpdate, comment)
+
+	// Assert
+	assert.IsType(t, &domain.ForbiddenError{}, err)
+	assert.EqualError(t, err, "not allowed to update comment")
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestAuthorize_ModeratorIsAllowedWithoutAudit(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	post := &domain.ModeratedResource{Type: domain.ModerationTargetPost, ID: 10, OwnerID: 7, Content: "spam"}
+	m.userRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.User{ID: 2, Role: domain.RoleModerator}, nil)
+
+	// Act
+	err := authorizer.Authorize(context.Background(), 2, domain.ModerationActionDelete, post)
+
+	// Assert
+	assert.NoError(t, err)
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRecordOverride_ModeratorOverrideIsRecorded(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	post := &domain.ModeratedResource{Type: domain.ModerationTargetPost, ID: 10, OwnerID: 7, Content: "spam"}
+	m.userRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.User{ID: 2, Role: domain.RoleModerator}, nil)
+
+	var recorded *domain.ModerationLogEntry
+	m.moderationLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.ModerationLogEntry")).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*domain.ModerationLogEntry) }).
+		Return(&domain.ModerationLogEntry{ID: 1}, nil)
+
+	// Act
+	err := authorizer.RecordOverride(context.Background(), 2, domain.ModerationActionDelete, post)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, recorded) {
+		assert.Equal(t, &domain.ModerationLogEntry{
+			ActorID:         2,
+			ActorRole:       domain.RoleModerator,
+			Action:          domain.ModerationActionDelete,
+			TargetType:      domain.ModerationTargetPost,
+			TargetID:        10,
+			TargetUserID:    7,
+			PreviousContent: "spam",
+		}, recorded)
+	}
+}
+
+func TestRecordOverride_OwnerIsNotRecorded(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	post := &domain.ModeratedResource{Type: domain.ModerationTargetPost, ID: 10, OwnerID: 7, Content: "hello"}
+
+	// Act
+	err := authorizer.RecordOverride(context.Background(), 7, domain.ModerationActionUpdate, post)
+
+	// Assert
+	assert.NoError(t, err)
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRecordOverride_AuditFails(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	authorizer := services.NewAuthorizer(m.userRepo, m.moderationLogRepo)
+	post := &domain.ModeratedResource{Type: domain.ModerationTargetPost, ID: 10, OwnerID: 7, Content: "spam"}
+	m.userRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.User{ID: 1, Role: domain.RoleAdmin}, nil)
+	m.moderationLogRepo.On("Create", mock.Anything, mock.Anything).Return((*domain.ModerationLogEntry)(nil), assert.AnError)
+
+	// Act
+	err := authorizer.RecordOverride(context.Background(), 1, domain.ModerationActionUpdate, post)
+
+	// Assert
+	assert.IsType(t, &domain.InternalServerError{}, err)
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/imaging"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	avatarKeyPrefix   = "avatars/"
+	avatarJPEGQuality = 85
+)
+
+type avatarService struct {
+	userRepo  interfaces.UserRepository
+	blobStore interfaces.BlobStore
+	policy    *domain.AvatarPolicy
+	mediaURL  string
+}
+
+// NewAvatarService stores profile pictures in the blob store. The mediaURL is the URL
+// serving the blob store, e.g. "https://api.example.com/api/v1/media/"; profile picture
+// URLs are the mediaURL followed by the key of the largest thumbnail.
+func NewAvatarService(userRepo interfaces.UserRepository, blobStore interfaces.BlobStore, policy *domain.AvatarPolicy, mediaURL string) interfaces.AvatarService {
+	return &avatarService{
+		userRepo:  userRepo,
+		blobStore: blobStore,
+		policy:    policy,
+		mediaURL:  mediaURL,
+	}
+}
+
+// Upload checks the size and the format of the image from its content, whatever the
+// client claims, then stores every thumbnail under a new key. The previous thumbnails are
+// deleted once the new profile picture is saved.
+func (s *avatarService) Upload(ctx context.Context, userId int64, upload io.Reader) ([]domain.AvatarThumbnail, error) {
+	data, err := io.ReadAll(io.LimitReader(upload, s.policy.MaxBytes+1))
+	if err != nil {
+		return nil, domain.NewBadRequestError("could not read image")
+	}
+	if int64(len(data)) > s.policy.MaxBytes {
+		return nil, domain.NewPayloadTooLargeError(fmt.Sprintf("image must not be larger than %d bytes", s.policy.MaxBytes))
+	}
+
+	if contentType := http.DetectContentType(data); !slices.Contains(domain.AvatarContentTypes, contentType) {
+		return nil, domain.NewUnsupportedMediaTypeError("image must be a JPEG, PNG or GIF file")
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.NewBadRequestError("invalid image")
+	}
+	if config.Width > s.policy.MaxDimension || config.Height > s.policy.MaxDimension {
+		return nil, domain.NewBadRequestError(fmt.Sprintf("image must not be wider or higher than %d pixels", s.policy.MaxDimension))
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.NewBadRequestError("invalid image")
+	}
+
+	dir := fmt.Sprintf("%s%d/%s/", avatarKeyPrefix, userId, uuid.NewString())
+	thumbnails := make([]domain.AvatarThumbnail, 0, len(s.policy.Sizes))
+	for _, size := range s.policy.Sizes {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, imaging.Thumbnail(img, size), &jpeg.Options{Quality: avatarJPEGQuality}); err != nil {
+			log.Error().Err(err).Msg("failed to encode avatar thumbnail")
+			return nil, domain.NewInternalServerError("failed to store image")
+		}
+
+		key := dir + thumbnailName(size)
+		if err := s.blobStore.Put(ctx, key, &buf); err != nil {
+			log.Error().Err(err).Msg("failed to store avatar thumbnail")
+			s.deleteThumbnails(ctx, dir)
+			return nil, domain.NewInternalServerError("failed to store image")
+		}
+
+		thumbnails = append(thumbnails, domain.AvatarThumbnail{Size: size, URL: s.mediaURL + key})
+	}
+
+	previousURL, err := s.userRepo.UpdateProfilePicture(ctx, userId, s.mediaURL+dir+thumbnailName(slices.Max(s.policy.Sizes)))
+	if err != nil {
+		s.deleteThumbnails(ctx, dir)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to update profile picture")
+		return nil, domain.NewInternalServerError("failed to update profile picture")
+	}
+
+	s.deletePrevious(ctx, previousURL)
+
+	return thumbnails, nil
+}
+
+func (s *avatarService) Delete(ctx context.Context, userId int64) error {
+	previousURL, err := s.userRepo.UpdateProfilePicture(ctx, userId, "")
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to remove profile picture")
+		return domain.NewInternalServerError("failed to remove profile picture")
+	}
+
+	s.deletePrevious(ctx, previousURL)
+
+	return nil
+}
+
+func (s *avatarService) DeleteAll(ctx context.Context, userId int64) error {
+	return s.blobStore.DeletePrefix(ctx, fmt.Sprintf("%s%d/", avatarKeyPrefix, userId))
+}
+
+func (s *avatarService) Open(ctx context.Context, key string) (*domain.Blob, error) {
+	blob, err := s.blobStore.Open(ctx, avatarKeyPrefix+key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("image not found")
+		}
+		log.Error().Err(err).Msg("failed to open avatar")
+		return nil, domain.NewInternalServerError("failed to open image")
+	}
+
+	return blob, nil
+}
+
+// deletePrevious deletes the thumbnails of a replaced profile picture. Failures are only
+// logged: the new picture is saved already, and leftover files are not reachable from a
+// profile anymore.
+func (s *avatarService) deletePrevious(ctx context.Context, previousURL string) {
+	key, ok := strings.CutPrefix(previousURL, s.mediaURL)
+	if !ok || !strings.HasPrefix(key, avatarKeyPrefix) {
+		return
+	}
+	s.deleteThumbnails(ctx, path.Dir(key)+"/")
+}
+
+func (s *avatarService) deleteThumbnails(ctx context.Context, dir string) {
+	for _, size := range s.policy.Sizes {
+		if err := s.blobStore.Delete(ctx, dir+thumbnailName(size)); err != nil {
+			log.Error().Err(err).Str("key", dir+thumbnailName(size)).Msg("failed to delete avatar thumbnail")
+		}
+	}
+}
+
+func thumbnailName(size int) string {
+	return strconv.Itoa(size) + ".jpg"
+}
@@ -0,0 +1,143 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testMediaURL = "http://localhost:8080/api/v1/media/"
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestUploadAvatar_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	m.blobStore.On("Put", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "avatars/1/")
+	}), mock.Anything).Return(nil).Times(3)
+	m.userRepo.On("UpdateProfilePicture", mock.Anything, int64(1), mock.MatchedBy(func(url string) bool {
+		return strings.HasPrefix(url, testMediaURL+"avatars/1/") && strings.HasSuffix(url, "/256.jpg")
+	})).Return(testMediaURL+"avatars/1/old/256.jpg", nil)
+	for _, size := range []string{"64", "128", "256"} {
+		m.blobStore.On("Delete", mock.Anything, "avatars/1/old/"+size+".jpg").Return(nil).Once()
+	}
+
+	// Act
+	thumbnails, err := avatarService.Upload(context.Background(), 1, bytes.NewReader(encodePNG(t, 300, 200)))
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, thumbnails, 3) {
+		assert.Equal(t, 64, thumbnails[0].Size)
+		assert.True(t, strings.HasSuffix(thumbnails[0].URL, "/64.jpg"))
+	}
+	m.blobStore.AssertExpectations(t)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestUploadAvatar_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected error
+	}{
+		{name: "too large", data: make([]byte, domain.DefaultAvatarPolicy().MaxBytes+1), expected: &domain.PayloadTooLargeError{}},
+		{name: "not an image", data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), expected: &domain.UnsupportedMediaTypeError{}},
+		{name: "truncated image", data: encodePNG(t, 10, 10)[:40], expected: &domain.BadRequestError{}},
+		{name: "too many pixels", data: encodePNG(t, 5000, 1), expected: &domain.BadRequestError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+
+			// Act
+			_, err := avatarService.Upload(context.Background(), 1, bytes.NewReader(tt.data))
+
+			// Assert
+			assert.IsType(t, tt.expected, err)
+			m.blobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+			m.userRepo.AssertNotCalled(t, "UpdateProfilePicture", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUploadAvatar_StoreFailureCleansUp(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	m.blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	m.blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("disk full")).Once()
+	m.blobStore.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(3)
+
+	// Act
+	_, err := avatarService.Upload(context.Background(), 1, bytes.NewReader(encodePNG(t, 10, 10)))
+
+	// Assert
+	assert.IsType(t, &domain.InternalServerError{}, err)
+	m.blobStore.AssertExpectations(t)
+	m.userRepo.AssertNotCalled(t, "UpdateProfilePicture", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteAvatar_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	m.userRepo.On("UpdateProfilePicture", mock.Anything, int64(1), "").Return(testMediaURL+"avatars/1/old/256.jpg", nil)
+	m.blobStore.On("Delete", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "avatars/1/old/")
+	})).Return(nil).Times(3)
+
+	// Act
+	err := avatarService.Delete(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	m.blobStore.AssertExpectations(t)
+}
+
+func TestDeleteAvatar_ExternalPictureIsKept(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	m.userRepo.On("UpdateProfilePicture", mock.Anything, int64(1), "").Return("https://cdn.example.com/picture.jpg", nil)
+
+	// Act
+	err := avatarService.Delete(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	m.blobStore.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestOpenAvatar_NotFound(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	avatarService := services.NewAvatarService(m.userRepo, m.blobStore, domain.DefaultAvatarPolicy(), testMediaURL)
+	var nullptr *domain.Blob
+	m.blobStore.On("Open", mock.Anything, "avatars/1/missing/64.jpg").Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	blob, err := avatarService.Open(context.Background(), "1/missing/64.jpg")
+
+	// Assert
+	assert.Nil(t, blob)
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
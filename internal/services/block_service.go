@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+type blockService struct {
+	userRepo  interfaces.UserRepository
+	blockRepo interfaces.BlockRepository
+	muteRepo  interfaces.MuteRepository
+}
+
+func NewBlockService(userRepo interfaces.UserRepository, blockRepo interfaces.BlockRepository, muteRepo interfaces.MuteRepository) interfaces.BlockService {
+	return &blockService{
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+		muteRepo:  muteRepo,
+	}
+}
+
+// Block blocks a user and removes the follows between the two users.
+func (s *blockService) Block(ctx context.Context, userId int64, username string) error {
+	blocked, err := s.getOtherUser(ctx, userId, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.blockRepo.Block(ctx, userId, blocked.ID); err != nil {
+		log.Error().Err(err).Msg("failed to block user")
+		return domain.NewInternalServerError("failed to block user")
+	}
+
+	return nil
+}
+
+func (s *blockService) Unblock(ctx context.Context, userId int64, username string) error {
+	blocked, err := s.getOtherUser(ctx, userId, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.blockRepo.Unblock(ctx, userId, blocked.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user is not blocked")
+		}
+		log.Error().Err(err).Msg("failed to unblock user")
+		return domain.NewInternalServerError("failed to unblock user")
+	}
+
+	return nil
+}
+
+// ListBlocked lists the users blocked by the user, most recent block first.
+func (s *blockService) ListBlocked(ctx context.Context, userId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	limit, offset = followPage(limit, offset)
+	users, err := s.blockRepo.ListBlocked(ctx, userId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list blocked users")
+		return nil, domain.NewInternalServerError("failed to list blocked users")
+	}
+
+	return users, nil
+}
+
+func (s *blockService) Mute(ctx context.Context, userId int64, username string) error {
+	muted, err := s.getOtherUser(ctx, userId, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.muteRepo.Mute(ctx, userId, muted.ID); err != nil {
+		log.Error().Err(err).Msg("failed to mute user")
+		return domain.NewInternalServerError("failed to mute user")
+	}
+
+	return nil
+}
+
+func (s *blockService) Unmute(ctx context.Context, userId int64, username string) error {
+	muted, err := s.getOtherUser(ctx, userId, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.muteRepo.Unmute(ctx, userId, muted.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user is not muted")
+		}
+		log.Error().Err(err).Msg("failed to unmute user")
+		return domain.NewInternalServerError("failed to unmute user")
+	}
+
+	return nil
+}
+
+// ListMuted lists the users muted by the user, most recent mute first.
+func (s *blockService) ListMuted(ctx context.Context, userId int64, limit, offset int) ([]domain.BlockedUser, error) {
+	limit, offset = followPage(limit, offset)
+	users, err := s.muteRepo.ListMuted(ctx, userId, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list muted users")
+		return nil, domain.NewInternalServerError("failed to list muted users")
+	}
+
+	return users, nil
+}
+
+// getOtherUser finds a user that is not deleted by username, and is not the user.
+func (s *blockService) getOtherUser(ctx context.Context, userId int64, username string) (*domain.User, error) {
+	user, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user by username")
+		return nil, domain.NewInternalServerError("failed to get user")
+	}
+
+	if user.ID == userId {
+		return nil, domain.ErrCannotBlockSelf
+	}
+
+	return user, nil
+}
@@ -0,0 +1,99 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlock_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.blockRepo.On("Block", mock.Anything, int64(1), int64(2)).Return(nil)
+
+	// Act
+	err := blockService.Block(context.Background(), 1, "jane")
+
+	// Assert
+	assert.NoError(t, err)
+	m.blockRepo.AssertExpectations(t)
+}
+
+func TestBlock_Self(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 1}, nil)
+
+	// Act
+	err := blockService.Block(context.Background(), 1, "jane")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrCannotBlockSelf)
+	m.blockRepo.AssertNotCalled(t, "Block", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBlock_UnknownOrDeletedUser(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	var nullptr *domain.User
+	m.userRepo.On("GetByUsername", mock.Anything, "gone").Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	err := blockService.Block(context.Background(), 1, "gone")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+	m.blockRepo.AssertNotCalled(t, "Block", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUnblock_NotBlocked(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.blockRepo.On("Unblock", mock.Anything, int64(1), int64(2)).Return(domain.ErrNotFound)
+
+	// Act
+	err := blockService.Unblock(context.Background(), 1, "jane")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestMute_Error(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.muteRepo.On("Mute", mock.Anything, int64(1), int64(2)).Return(errors.New("db error"))
+
+	// Act
+	err := blockService.Mute(context.Background(), 1, "jane")
+
+	// Assert
+	assert.IsType(t, &domain.InternalServerError{}, err)
+}
+
+func TestListMuted_ClampsPagination(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	blockService := services.NewBlockService(m.userRepo, m.blockRepo, m.muteRepo)
+	m.muteRepo.On("ListMuted", mock.Anything, int64(1), 100, 0).Return([]domain.BlockedUser{{ID: 2}}, nil)
+
+	// Act
+	users, err := blockService.ListMuted(context.Background(), 1, 1000, -5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	m.muteRepo.AssertExpectations(t)
+}
@@ -2,18 +2,27 @@ package services
 
 import (
 	"context"
+	"errors"
+	"slices"
 
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/interfaces"
 	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
 )
 
 type commentsService struct {
 	commentsRepo interfaces.CommentRepository
+	postRepo     interfaces.PostRepository
+	blockRepo    interfaces.BlockRepository
+	reactionRepo interfaces.ReactionRepository
+	authorizer   interfaces.Authorizer
+	cursors      *cursor.Codec
 }
 
-func NewCommentService(commentsRepo interfaces.CommentRepository) interfaces.CommentService {
-	return &commentsService{commentsRepo: commentsRepo}
+func NewCommentService(commentsRepo interfaces.CommentRepository, postRepo interfaces.PostRepository, blockRepo interfaces.BlockRepository, reactionRepo interfaces.ReactionRepository, authorizer interfaces.Authorizer, cursors *cursor.Codec) interfaces.CommentService {
+	return &commentsService{commentsRepo: commentsRepo, postRepo: postRepo, blockRepo: blockRepo, reactionRepo: reactionRepo, authorizer: authorizer, cursors: cursors}
 }
 
 func (s *commentsService) Create(ctx context.Context, userId, postId int64, comment *domain.CreateCommentDTO) (*domain.Comment, error) {
@@ -22,6 +31,24 @@ func (s *commentsService) Create(ctx context.Context, userId, postId int64, comm
 	if err != nil {
 		return nil, domain.NewBadRequestError(err.Error())
 	}
+
+	post, err := s.getPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users blocked either way cannot comment on each other's posts
+	if post.UserID != userId {
+		blocked, err := s.blockRepo.IsBlocked(ctx, userId, post.UserID)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to check block")
+			return nil, domain.NewInternalServerError("failed to create comment")
+		}
+		if blocked {
+			return nil, domain.ErrBlocked
+		}
+	}
+
 	return s.commentsRepo.Create(ctx, userId, postId, comment)
 }
 
@@ -32,32 +59,79 @@ func (s *commentsService) Delete(ctx context.Context, userId, commentId int64) e
 		return domain.NewNotFoundError("comment not found")
 	case err != nil:
 		return domain.NewInternalServerError("failed to delete comment")
-	case comment.UserID != userId:
-		return domain.NewForbiddenError("not allowed to delete comment")
 	}
 
-	err = s.commentsRepo.Delete(ctx, userId, commentId)
+	resource := commentResource(comment)
+	if err := s.authorizer.Authorize(ctx, userId, domain.ModerationActionDelete, resource); err != nil {
+		return err
+	}
+
+	err = s.commentsRepo.Delete(ctx, comment.UserID, commentId)
 	switch {
 	case err != nil && err == domain.ErrNotFound:
 		return domain.NewNotFoundError("comment not found")
 	case err != nil:
 		return domain.NewInternalServerError("failed to delete comment")
-	default:
-		return nil
 	}
 
+	return s.authorizer.RecordOverride(ctx, userId, domain.ModerationActionDelete, resource)
 }
 
-func (s *commentsService) GetByID(ctx context.Context, id int64) (*domain.Comment, error) {
-	// todo validate authorization to get a comment
+// GetByID gets a comment on a post. Comments on the posts of users blocked either way by the
+// viewer, and comments written by those users, are not found.
+func (s *commentsService) GetByID(ctx context.Context, viewerId, postId, id int64) (*domain.Comment, error) {
+	comment, err := s.commentsRepo.GetByID(ctx, id)
+	switch {
+	case err != nil && err == domain.ErrNotFound:
+		return nil, domain.NewNotFoundError("comment not found")
+	case err != nil:
+		return nil, domain.NewInternalServerError("failed to get comment")
+	}
+
+	if comment.PostID != postId {
+		return nil, domain.NewNotFoundError("comment not found")
+	}
+
+	post, err := s.getPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedUserIds, err := s.blockRepo.ListBlockedUserIDs(ctx, viewerId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list blocked users")
+		return nil, domain.NewInternalServerError("failed to get comment")
+	}
+	if slices.Contains(blockedUserIds, post.UserID) || slices.Contains(blockedUserIds, comment.UserID) {
+		return nil, domain.NewNotFoundError("comment not found")
+	}
 
-	return s.commentsRepo.GetByID(ctx, id)
+	return s.withReactions(ctx, viewerId, comment)
 }
 
-func (s *commentsService) ListByPostID(ctx context.Context, postId int64, limit int, offset int) ([]domain.Comment, error) {
-	// todo validate authorization to list comments for a post
+// ListByPostID lists the comments on a post. Posts of users blocked either way by the viewer
+// are not found, and comments of those users are left out.
+func (s *commentsService) ListByPostID(ctx context.Context, viewerId, postId int64, after string, limit int, offset int) (*domain.CommentPage, error) {
+	page, limit, err := newPageRequest(s.cursors, after, limit, offset, 10)
+	if err != nil {
+		return nil, err
+	}
 
-	comments, err := s.commentsRepo.ListByPostID(ctx, postId, limit, offset)
+	post, err := s.getPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedUserIds, err := s.blockRepo.ListBlockedUserIDs(ctx, viewerId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list blocked users")
+		return nil, domain.NewInternalServerError("failed to list comments")
+	}
+	if slices.Contains(blockedUserIds, post.UserID) {
+		return nil, domain.NewNotFoundError("post not found")
+	}
+
+	comments, err := s.commentsRepo.ListByPostID(ctx, postId, page)
 
 	if err != nil && err == domain.ErrNotFound {
 		return nil, domain.NewNotFoundError("post not found")
@@ -67,7 +141,15 @@ func (s *commentsService) ListByPostID(ctx context.Context, postId int64, limit
 		return nil, domain.NewInternalServerError("failed to list comments")
 	}
 
-	return comments, nil
+	// Leaving out the comments of blocked users after paging can make pages shorter than the limit
+	comments, next := trimPage(s.cursors, comments, limit, commentPosition)
+	comments = withoutBlockedComments(comments, blockedUserIds)
+	if err := withCommentReactions(ctx, s.reactionRepo, viewerId, comments); err != nil {
+		log.Error().Err(err).Msg("failed to summarize comment reactions")
+		return nil, domain.NewInternalServerError("failed to list comments")
+	}
+
+	return &domain.CommentPage{Comments: comments, NextCursor: next}, nil
 }
 
 func (s *commentsService) Update(ctx context.Context, userId, postId, commentId int64, comment *domain.UpdateCommentDTO) (*domain.Comment, error) {
@@ -76,16 +158,20 @@ func (s *commentsService) Update(ctx context.Context, userId, postId, commentId
 		return nil, domain.NewBadRequestError(err.Error())
 	}
 
-	switch existing, err := s.commentsRepo.GetByID(ctx, commentId); {
+	existing, err := s.commentsRepo.GetByID(ctx, commentId)
+	switch {
 	case err != nil && err == domain.ErrNotFound:
 		return nil, domain.NewNotFoundError("comment not found")
 	case err != nil:
 		return nil, domain.NewInternalServerError("failed to delete comment")
-	case existing.UserID != userId:
-		return nil, domain.NewForbiddenError("not allowed to delete comment")
 	}
 
-	updatedComment, err := s.commentsRepo.Update(ctx, userId, postId, comment)
+	resource := commentResource(existing)
+	if err := s.authorizer.Authorize(ctx, userId, domain.ModerationActionUpdate, resource); err != nil {
+		return nil, err
+	}
+
+	updatedComment, err := s.commentsRepo.Update(ctx, existing.UserID, postId, comment)
 
 	if err != nil && err == domain.ErrNotFound {
 		return nil, domain.NewNotFoundError("comment not found")
@@ -95,5 +181,42 @@ func (s *commentsService) Update(ctx context.Context, userId, postId, commentId
 		return nil, domain.NewInternalServerError("failed to update comment")
 	}
 
-	return updatedComment, nil
+	if err := s.authorizer.RecordOverride(ctx, userId, domain.ModerationActionUpdate, resource); err != nil {
+		return nil, err
+	}
+
+	return s.withReactions(ctx, userId, updatedComment)
+}
+
+// withReactions sets the reactions on a comment, with those of the viewer.
+func (s *commentsService) withReactions(ctx context.Context, viewerId int64, comment *domain.Comment) (*domain.Comment, error) {
+	comments := []domain.Comment{*comment}
+	if err := withCommentReactions(ctx, s.reactionRepo, viewerId, comments); err != nil {
+		log.Error().Err(err).Msg("failed to summarize comment reactions")
+		return nil, domain.NewInternalServerError("failed to get comment reactions")
+	}
+
+	return &comments[0], nil
+}
+
+func (s *commentsService) getPost(ctx context.Context, postId int64) (*domain.Post, error) {
+	post, err := s.postRepo.GetByID(ctx, postId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("post not found")
+		}
+		log.Error().Err(err).Msg("failed to get post by id")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+
+	return post, nil
+}
+
+func commentResource(comment *domain.Comment) *domain.ModeratedResource {
+	return &domain.ModeratedResource{
+		Type:    domain.ModerationTargetComment,
+		ID:      comment.ID,
+		OwnerID: comment.UserID,
+		Content: comment.Content,
+	}
 }
@@ -0,0 +1,52 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCommentByID(t *testing.T) {
+	const viewerId, postAuthorId, commentAuthorId, postId, commentId = int64(1), int64(2), int64(3), int64(10), int64(20)
+
+	tests := []struct {
+		name           string
+		postId         int64
+		blockedUserIds []int64
+		wantFound      bool
+	}{
+		{name: "visible comment", postId: postId, blockedUserIds: []int64{}, wantFound: true},
+		{name: "comment on another post", postId: postId + 1, wantFound: false},
+		{name: "post author blocked", postId: postId, blockedUserIds: []int64{postAuthorId}, wantFound: false},
+		{name: "comment author blocked", postId: postId, blockedUserIds: []int64{commentAuthorId}, wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			commentService := services.NewCommentService(m.commentRepo, m.postRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+			m.commentRepo.On("GetByID", mock.Anything, commentId).Return(&domain.Comment{ID: commentId, PostID: postId, UserID: commentAuthorId}, nil)
+			m.postRepo.On("GetByID", mock.Anything, postId).Return(&domain.Post{ID: postId, UserID: postAuthorId}, nil)
+			m.blockRepo.On("ListBlockedUserIDs", mock.Anything, viewerId).Return(tt.blockedUserIds, nil)
+			m.reactionRepo.On("Summarize", mock.Anything, viewerId, domain.ReactionTargetComment, []int64{commentId}).Return(map[int64]domain.Reactions{}, nil)
+
+			// Act
+			comment, err := commentService.GetByID(context.Background(), viewerId, tt.postId, commentId)
+
+			// Assert
+			if tt.wantFound {
+				assert.NoError(t, err)
+				assert.Equal(t, commentId, comment.ID)
+				return
+			}
+			assert.Nil(t, comment)
+			assert.IsType(t, &domain.NotFoundError{}, err)
+			m.reactionRepo.AssertNotCalled(t, "Summarize", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
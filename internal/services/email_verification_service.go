@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	emailVerificationTokenTTL = 24 * time.Hour
+	// emailVerificationResendInterval is the minimum time between two verification emails.
+	emailVerificationResendInterval = time.Minute
+	// emailVerificationHourlyLimit caps the verification emails sent to a user per hour.
+	emailVerificationHourlyLimit = 5
+)
+
+type emailVerificationService struct {
+	userRepo      interfaces.UserRepository
+	userTokenRepo interfaces.UserTokenRepository
+	mailer        interfaces.Mailer
+	policy        *domain.EmailVerificationPolicy
+}
+
+func NewEmailVerificationService(
+	userRepo interfaces.UserRepository,
+	userTokenRepo interfaces.UserTokenRepository,
+	mailer interfaces.Mailer,
+	policy *domain.EmailVerificationPolicy,
+) interfaces.EmailVerificationService {
+	return &emailVerificationService{
+		userRepo:      userRepo,
+		userTokenRepo: userTokenRepo,
+		mailer:        mailer,
+		policy:        policy,
+	}
+}
+
+func (s *emailVerificationService) SendVerificationEmail(ctx context.Context, user *domain.User) error {
+	rawToken, err := issueUserToken(ctx, s.userTokenRepo, user.ID, domain.TokenPurposeEmailVerification, emailVerificationTokenTTL)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to issue email verification token")
+		return domain.NewInternalServerError("failed to send verification email")
+	}
+
+	message := &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below. It expires in 24 hours.\n\n%s\n",
+			user.FirstName,
+			appLink("/verify-email", rawToken),
+		),
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Error().Err(err).Msg("failed to send verification email")
+		return domain.NewInternalServerError("failed to send verification email")
+	}
+
+	return nil
+}
+
+// ResendVerificationEmail sends a new verification link, throttled per user to limit abuse
+// of the mailer.
+func (s *emailVerificationService) ResendVerificationEmail(ctx context.Context, userId int64) error {
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return domain.NewInternalServerError("failed to send verification email")
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return domain.NewBadRequestError("email address is already verified")
+	}
+
+	if err := s.checkResendThrottle(ctx, userId); err != nil {
+		return err
+	}
+
+	return s.SendVerificationEmail(ctx, user)
+}
+
+func (s *emailVerificationService) VerifyEmail(ctx context.Context, verifyEmail *domain.VerifyEmailDTO) error {
+	if err := validation.Validate.Struct(verifyEmail); err != nil {
+		return err
+	}
+
+	token, err := s.userTokenRepo.Consume(ctx, domain.TokenPurposeEmailVerification, tokens.Hash(verifyEmail.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewBadRequestError("invalid or expired verification token")
+		}
+		log.Error().Err(err).Msg("failed to consume email verification token")
+		return domain.NewInternalServerError("failed to verify email")
+	}
+
+	if err := s.userRepo.MarkEmailVerified(ctx, token.UserID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewBadRequestError("invalid or expired verification token")
+		}
+		log.Error().Err(err).Msg("failed to mark email as verified")
+		return domain.NewInternalServerError("failed to verify email")
+	}
+
+	return nil
+}
+
+// RequireVerified returns domain.ErrEmailNotVerified when the policy restricts the action to
+// verified users and the user has not verified their email address yet.
+func (s *emailVerificationService) RequireVerified(ctx context.Context, userId int64, action domain.VerifiedAction) error {
+	if !s.policy.Requires(action) {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewUnauthorizedError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return domain.NewInternalServerError("failed to check email verification")
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return domain.ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+func (s *emailVerificationService) checkResendThrottle(ctx context.Context, userId int64) error {
+	now := time.Now()
+
+	recent, err := s.userTokenRepo.CountCreatedSince(ctx, userId, domain.TokenPurposeEmailVerification, now.Add(-emailVerificationResendInterval))
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count verification emails")
+		return domain.NewInternalServerError("failed to send verification email")
+	}
+	if recent > 0 {
+		return domain.NewTooManyRequestsError("a verification email was sent recently, please wait before requesting another one", emailVerificationResendInterval)
+	}
+
+	lastHour, err := s.userTokenRepo.CountCreatedSince(ctx, userId, domain.TokenPurposeEmailVerification, now.Add(-time.Hour))
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count verification emails")
+		return domain.NewInternalServerError("failed to send verification email")
+	}
+	if lastHour >= emailVerificationHourlyLimit {
+		return domain.NewTooManyRequestsError("too many verification emails requested, please try again later", time.Hour)
+	}
+
+	return nil
+}
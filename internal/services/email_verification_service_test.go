@@ -0,0 +1,145 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendVerificationEmail_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	emailVerificationService := services.NewEmailVerificationService(m.userRepo, m.userTokenRepo, m.mailer, domain.NewEmailVerificationPolicy())
+	user := &domain.User{ID: 7, FirstName: "Jane", Email: "jane@example.com"}
+
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposeEmailVerification).Return(nil)
+	m.userTokenRepo.On("Create", mock.Anything, user.ID, domain.TokenPurposeEmailVerification, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+	m.mailer.On("Send", mock.Anything, mock.MatchedBy(func(message *domain.EmailMessage) bool {
+		return message.To == user.Email
+	})).Return(nil)
+
+	// Act
+	err := emailVerificationService.SendVerificationEmail(context.Background(), user)
+
+	// Assert
+	assert.NoError(t, err)
+	m.userTokenRepo.AssertExpectations(t)
+	m.mailer.AssertExpectations(t)
+}
+
+func TestResendVerificationEmail_Throttled(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	emailVerificationService := services.NewEmailVerificationService(m.userRepo, m.userTokenRepo, m.mailer, domain.NewEmailVerificationPolicy())
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.userTokenRepo.On("CountCreatedSince", mock.Anything, int64(7), domain.TokenPurposeEmailVerification, mock.AnythingOfType("time.Time")).Return(1, nil)
+
+	// Act
+	err := emailVerificationService.ResendVerificationEmail(context.Background(), 7)
+
+	// Assert
+	var tooManyRequestsErr *domain.TooManyRequestsError
+	if assert.ErrorAs(t, err, &tooManyRequestsErr) {
+		assert.Positive(t, tooManyRequestsErr.RetryAfter)
+	}
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestResendVerificationEmail_AlreadyVerified(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	emailVerificationService := services.NewEmailVerificationService(m.userRepo, m.userTokenRepo, m.mailer, domain.NewEmailVerificationPolicy())
+	verifiedAt := time.Now()
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, EmailVerifiedAt: &verifiedAt}, nil)
+
+	// Act
+	err := emailVerificationService.ResendVerificationEmail(context.Background(), 7)
+
+	// Assert
+	var badRequestErr *domain.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestVerifyEmail_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	emailVerificationService := services.NewEmailVerificationService(m.userRepo, m.userTokenRepo, m.mailer, domain.NewEmailVerificationPolicy())
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeEmailVerification, tokens.Hash("raw-token")).
+		Return(&domain.UserToken{ID: 1, UserID: 7}, nil)
+	m.userRepo.On("MarkEmailVerified", mock.Anything, int64(7)).Return(nil)
+
+	// Act
+	err := emailVerificationService.VerifyEmail(context.Background(), &domain.VerifyEmailDTO{Token: "raw-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestRequireVerified(t *testing.T) {
+	verifiedAt := time.Now()
+
+	tests := []struct {
+		name        string
+		policy      *domain.EmailVerificationPolicy
+		user        *domain.User
+		expectedErr error
+	}{
+		{
+			name:   "action not covered by the policy",
+			policy: domain.NewEmailVerificationPolicy(domain.VerifiedActionCommenting),
+		},
+		{
+			name:   "verified user",
+			policy: domain.NewEmailVerificationPolicy(domain.VerifiedActionPosting),
+			user:   &domain.User{ID: 7, EmailVerifiedAt: &verifiedAt},
+		},
+		{
+			name:        "unverified user",
+			policy:      domain.NewEmailVerificationPolicy(domain.VerifiedActionPosting),
+			user:        &domain.User{ID: 7},
+			expectedErr: domain.ErrEmailNotVerified,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			emailVerificationService := services.NewEmailVerificationService(m.userRepo, m.userTokenRepo, m.mailer, tt.policy)
+			if tt.user != nil {
+				m.userRepo.On("GetByID", mock.Anything, tt.user.ID).Return(tt.user, nil)
+			}
+
+			// Act
+			err := emailVerificationService.RequireVerified(context.Background(), 7, domain.VerifiedActionPosting)
+
+			// Assert
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.user == nil {
+				m.userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestParseEmailVerificationPolicy(t *testing.T) {
+	policy, err := domain.ParseEmailVerificationPolicy(" posting, commenting ")
+	assert.NoError(t, err)
+	assert.True(t, policy.Requires(domain.VerifiedActionPosting))
+	assert.True(t, policy.Requires(domain.VerifiedActionCommenting))
+
+	empty, err := domain.ParseEmailVerificationPolicy("")
+	assert.NoError(t, err)
+	assert.False(t, empty.Requires(domain.VerifiedActionPosting))
+
+	_, err = domain.ParseEmailVerificationPolicy("posting,liking")
+	assert.Error(t, err)
+}
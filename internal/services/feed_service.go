@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+type feedService struct {
+	timelineRepo interfaces.TimelineRepository
+	postRepo     interfaces.PostRepository
+	blockRepo    interfaces.BlockRepository
+	reactionRepo interfaces.ReactionRepository
+	cursors      *cursor.Codec
+}
+
+func NewFeedService(timelineRepo interfaces.TimelineRepository, postRepo interfaces.PostRepository, blockRepo interfaces.BlockRepository, reactionRepo interfaces.ReactionRepository, cursors *cursor.Codec) interfaces.FeedService {
+	return &feedService{timelineRepo: timelineRepo, postRepo: postRepo, blockRepo: blockRepo, reactionRepo: reactionRepo, cursors: cursors}
+}
+
+func (s *feedService) Home(ctx context.Context, userId int64, after string, limit int) (*domain.PostPage, error) {
+	page, limit, err := newPageRequest(s.cursors, after, limit, 0, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.timelineRepo.ListHome(ctx, userId, page.After, page.Limit)
+	if err != nil {
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to list home timeline")
+		return nil, domain.NewInternalServerError("failed to get home feed")
+	}
+
+	posts, next := trimPage(s.cursors, posts, limit, postPosition)
+	if err := withPostReactions(ctx, s.reactionRepo, userId, posts); err != nil {
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to get home feed")
+	}
+	if err := withReferencedPosts(ctx, s.postRepo, s.blockRepo, userId, posts); err != nil {
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to get referenced posts")
+		return nil, domain.NewInternalServerError("failed to get home feed")
+	}
+
+	return &domain.PostPage{Posts: posts, NextCursor: next}, nil
+}
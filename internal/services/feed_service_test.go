@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/mocks"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHomeFeed_NextCursor(t *testing.T) {
+	// Arrange
+	timelineRepo := new(mocks.MockedTimelineRepository)
+	reactionRepo := new(mocks.MockedReactionRepository)
+	feedService := services.NewFeedService(timelineRepo, new(mocks.MockedPostRepository), new(mocks.MockedBlockRepository), reactionRepo, testCursors)
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	posts := []domain.Post{
+		{ID: 3, CreatedAt: createdAt.Add(2 * time.Minute)},
+		{ID: 2, CreatedAt: createdAt.Add(time.Minute)},
+		{ID: 1, CreatedAt: createdAt},
+	}
+	timelineRepo.On("ListHome", mock.Anything, int64(1), (*domain.Cursor)(nil), 3).Return(posts, nil)
+	reactions := domain.Reactions{Counts: map[string]int64{"like": 2}, Mine: []string{"like"}}
+	reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{3, 2}).Return(map[int64]domain.Reactions{3: reactions}, nil)
+
+	// Act
+	page, err := feedService.Home(context.Background(), 1, "", 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, []int64{page.Posts[0].ID, page.Posts[1].ID})
+	assert.Equal(t, reactions, page.Posts[0].Reactions)
+	assert.Empty(t, page.Posts[1].Reactions.Counts)
+	next, err := testCursors.Decode(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Cursor{CreatedAt: posts[1].CreatedAt, ID: 2}, next)
+}
+
+func TestHomeFeed_LastPage(t *testing.T) {
+	// Arrange
+	timelineRepo := new(mocks.MockedTimelineRepository)
+	reactionRepo := new(mocks.MockedReactionRepository)
+	feedService := services.NewFeedService(timelineRepo, new(mocks.MockedPostRepository), new(mocks.MockedBlockRepository), reactionRepo, testCursors)
+	after := domain.Cursor{CreatedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), ID: 2}
+	timelineRepo.On("ListHome", mock.Anything, int64(1), &after, 101).Return([]domain.Post{{ID: 1}}, nil)
+	reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{1}).Return(map[int64]domain.Reactions{}, nil)
+
+	// Act
+	page, err := feedService.Home(context.Background(), 1, testCursors.Encode(after), 1000)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, page.Posts, 1)
+	assert.Empty(t, page.NextCursor)
+	timelineRepo.AssertExpectations(t)
+}
+
+func TestHomeFeed_InvalidCursor(t *testing.T) {
+	// Arrange
+	timelineRepo := new(mocks.MockedTimelineRepository)
+	reactionRepo := new(mocks.MockedReactionRepository)
+	feedService := services.NewFeedService(timelineRepo, new(mocks.MockedPostRepository), new(mocks.MockedBlockRepository), reactionRepo, testCursors)
+
+	// Act
+	page, err := feedService.Home(context.Background(), 1, "garbage", 20)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	timelineRepo.AssertNotCalled(t, "ListHome", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
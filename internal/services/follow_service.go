@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+type followService struct {
+	userRepo   interfaces.UserRepository
+	followRepo interfaces.FollowRepository
+	blockRepo  interfaces.BlockRepository
+	cursors    *cursor.Codec
+}
+
+func NewFollowService(userRepo interfaces.UserRepository, followRepo interfaces.FollowRepository, blockRepo interfaces.BlockRepository, cursors *cursor.Codec) interfaces.FollowService {
+	return &followService{
+		userRepo:   userRepo,
+		followRepo: followRepo,
+		blockRepo:  blockRepo,
+		cursors:    cursors,
+	}
+}
+
+func (s *followService) Follow(ctx context.Context, followerId int64, username string) error {
+	followee, err := s.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if followee.ID == followerId {
+		return domain.ErrCannotFollowSelf
+	}
+
+	blocked, err := s.blockRepo.IsBlocked(ctx, followerId, followee.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check block")
+		return domain.NewInternalServerError("failed to follow user")
+	}
+	if blocked {
+		return domain.ErrBlocked
+	}
+
+	if err := s.followRepo.Create(ctx, followerId, followee.ID); err != nil {
+		if errors.Is(err, domain.ErrAlreadyFollowing) {
+			return err
+		}
+		log.Error().Err(err).Msg("failed to follow user")
+		return domain.NewInternalServerError("failed to follow user")
+	}
+
+	return nil
+}
+
+func (s *followService) Unfollow(ctx context.Context, followerId int64, username string) error {
+	followee, err := s.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.followRepo.Delete(ctx, followerId, followee.ID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("user is not followed")
+		}
+		log.Error().Err(err).Msg("failed to unfollow user")
+		return domain.NewInternalServerError("failed to unfollow user")
+	}
+
+	return nil
+}
+
+// ListFollowers lists the users following the user, most recent follow first.
+func (s *followService) ListFollowers(ctx context.Context, username string, after string, limit, offset int) (*domain.FollowPage, error) {
+	page, limit, err := newPageRequest(s.cursors, after, limit, offset, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := s.followRepo.ListFollowers(ctx, user.ID, page)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list followers")
+		return nil, domain.NewInternalServerError("failed to list followers")
+	}
+
+	followers, next := trimPage(s.cursors, followers, limit, followPosition)
+	return &domain.FollowPage{Users: followers, NextCursor: next}, nil
+}
+
+// ListFollowing lists the users the user follows, most recent follow first.
+func (s *followService) ListFollowing(ctx context.Context, username string, after string, limit, offset int) (*domain.FollowPage, error) {
+	page, limit, err := newPageRequest(s.cursors, after, limit, offset, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := s.followRepo.ListFollowing(ctx, user.ID, page)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list followed users")
+		return nil, domain.NewInternalServerError("failed to list followed users")
+	}
+
+	following, next := trimPage(s.cursors, following, limit, followPosition)
+	return &domain.FollowPage{Users: following, NextCursor: next}, nil
+}
+
+// getUser finds a user that is not deleted by username.
+func (s *followService) getUser(ctx context.Context, username string) (*domain.User, error) {
+	user, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user by username")
+		return nil, domain.NewInternalServerError("failed to get user")
+	}
+
+	return user, nil
+}
+
+func followPage(limit, offset int) (int, int) {
+	if limit > 100 {
+		limit = 100
+	} else if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
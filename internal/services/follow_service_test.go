@@ -0,0 +1,173 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollow_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.followRepo.On("Create", mock.Anything, int64(1), int64(2)).Return(nil)
+
+	// Act
+	err := followService.Follow(context.Background(), 1, "jane")
+
+	// Assert
+	assert.NoError(t, err)
+	m.followRepo.AssertExpectations(t)
+}
+
+func TestFollow_Self(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 1}, nil)
+
+	// Act
+	err := followService.Follow(context.Background(), 1, "jane")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrCannotFollowSelf)
+	m.followRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFollow_AlreadyFollowing(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.followRepo.On("Create", mock.Anything, int64(1), int64(2)).Return(domain.ErrAlreadyFollowing)
+
+	// Act
+	err := followService.Follow(context.Background(), 1, "jane")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAlreadyFollowing)
+}
+
+func TestFollow_Blocked(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(true, nil)
+
+	// Act
+	err := followService.Follow(context.Background(), 1, "jane")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrBlocked)
+	m.followRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFollow_UnknownOrDeletedUser(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	var nullptr *domain.User
+	m.userRepo.On("GetByUsername", mock.Anything, "gone").Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	err := followService.Follow(context.Background(), 1, "gone")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+	m.followRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUnfollow_NotFollowing(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.followRepo.On("Delete", mock.Anything, int64(1), int64(2)).Return(domain.ErrNotFound)
+
+	// Act
+	err := followService.Unfollow(context.Background(), 1, "jane")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestListFollowers_ClampsPagination(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.followRepo.On("ListFollowers", mock.Anything, int64(2), domain.PageRequest{Limit: 101}).Return([]domain.FollowUser{{ID: 1}}, nil)
+
+	// Act
+	page, err := followService.ListFollowers(context.Background(), "jane", "", 1000, -5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, page.Users, 1)
+	assert.Empty(t, page.NextCursor)
+	m.followRepo.AssertExpectations(t)
+}
+
+func TestListFollowers_NextPage(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	followedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	followers := []domain.FollowUser{
+		{ID: 5, FollowedAt: followedAt.Add(time.Minute)},
+		{ID: 3, FollowedAt: followedAt},
+		{ID: 1, FollowedAt: followedAt},
+	}
+	after := domain.Cursor{CreatedAt: followedAt.Add(time.Hour), ID: 7}
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.followRepo.On("ListFollowers", mock.Anything, int64(2), domain.PageRequest{After: &after, Limit: 3}).Return(followers, nil)
+
+	// Act
+	page, err := followService.ListFollowers(context.Background(), "jane", testCursors.Encode(after), 2, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, followers[:2], page.Users)
+	next, err := testCursors.Decode(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Cursor{CreatedAt: followedAt, ID: 3}, next)
+}
+
+func TestListFollowing_InvalidCursor(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+
+	// Act
+	page, err := followService.ListFollowing(context.Background(), "jane", "not-a-cursor", 10, 0)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	m.followRepo.AssertNotCalled(t, "ListFollowing", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListFollowing_Error(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	followService := services.NewFollowService(m.userRepo, m.followRepo, m.blockRepo, testCursors)
+	m.userRepo.On("GetByUsername", mock.Anything, "jane").Return(&domain.User{ID: 2}, nil)
+	m.followRepo.On("ListFollowing", mock.Anything, int64(2), domain.PageRequest{Limit: 21}).Return([]domain.FollowUser(nil), errors.New("db error"))
+
+	// Act
+	page, err := followService.ListFollowing(context.Background(), "jane", "", 0, 0)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.InternalServerError{}, err)
+}
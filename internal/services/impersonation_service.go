@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type impersonationService struct {
+	userRepo          interfaces.UserRepository
+	impersonationRepo interfaces.ImpersonationSessionRepository
+	auditLogRepo      interfaces.ImpersonationAuditLogRepository
+	policy            *domain.ImpersonationPolicy
+}
+
+func NewImpersonationService(
+	userRepo interfaces.UserRepository,
+	impersonationRepo interfaces.ImpersonationSessionRepository,
+	auditLogRepo interfaces.ImpersonationAuditLogRepository,
+	policy *domain.ImpersonationPolicy,
+) interfaces.ImpersonationService {
+	return &impersonationService{
+		userRepo:          userRepo,
+		impersonationRepo: impersonationRepo,
+		auditLogRepo:      auditLogRepo,
+		policy:            policy,
+	}
+}
+
+// Start opens an impersonation session of the subject for the actor. Users who may
+// impersonate others cannot be impersonated themselves, so that an impersonation session
+// never carries more privileges than the support use case needs.
+func (s *impersonationService) Start(ctx context.Context, actorId, subjectId int64, start *domain.StartImpersonationDTO) (*domain.ImpersonationSession, *domain.User, error) {
+	if err := validation.Validate.Struct(start); err != nil {
+		return nil, nil, err
+	}
+
+	if actorId == subjectId {
+		return nil, nil, domain.NewBadRequestError("cannot impersonate yourself")
+	}
+
+	subject, err := s.userRepo.GetByID(ctx, subjectId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Int64("userId", subjectId).Msg("failed to get user to impersonate")
+		return nil, nil, domain.NewInternalServerError("failed to start impersonation")
+	}
+
+	if subject.Role.HasPermission(domain.PermissionImpersonateUsers) {
+		return nil, nil, domain.NewForbiddenError("cannot impersonate a user who can impersonate others")
+	}
+
+	session, err := s.impersonationRepo.Create(ctx, &domain.ImpersonationSession{
+		ID:        uuid.NewString(),
+		ActorID:   actorId,
+		SubjectID: subjectId,
+		Reason:    start.Reason,
+		ExpiresAt: time.Now().Add(s.policy.TTL),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create impersonation session")
+		return nil, nil, domain.NewInternalServerError("failed to start impersonation")
+	}
+
+	log.Info().Int64("actorId", actorId).Int64("userId", subjectId).Str("impersonationId", session.ID).Msg("impersonation started")
+
+	return session, subject, nil
+}
+
+// End ends an active impersonation session. Any user allowed to impersonate may end any
+// session, e.g. one left open by a colleague.
+func (s *impersonationService) End(ctx context.Context, actorId int64, impersonationId string) error {
+	if err := s.impersonationRepo.End(ctx, impersonationId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("impersonation session not found")
+		}
+		log.Error().Err(err).Msg("failed to end impersonation session")
+		return domain.NewInternalServerError("failed to end impersonation")
+	}
+
+	log.Info().Int64("actorId", actorId).Str("impersonationId", impersonationId).Msg("impersonation ended")
+
+	return nil
+}
+
+// Record adds a request made with an impersonation session to the audit log.
+func (s *impersonationService) Record(ctx context.Context, entry *domain.ImpersonationAuditEntry) error {
+	if err := s.auditLogRepo.Create(ctx, entry); err != nil {
+		log.Error().Err(err).Str("impersonationId", entry.ImpersonationID).Msg("failed to record impersonated request")
+		return domain.NewInternalServerError("failed to record impersonated request")
+	}
+
+	return nil
+}
+
+func (s *impersonationService) ListAuditLog(ctx context.Context, limit, offset int) ([]domain.ImpersonationAuditEntry, error) {
+	if limit > 100 || limit <= 0 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	entries, err := s.auditLogRepo.List(ctx, limit, offset)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list impersonation audit log")
+		return nil, domain.NewInternalServerError("failed to list impersonation audit log")
+	}
+
+	return entries, nil
+}
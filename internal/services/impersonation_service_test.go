@@ -0,0 +1,96 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartImpersonation_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	impersonationService := services.NewImpersonationService(m.userRepo, m.impersonationRepo, m.impersonationAuditLogRepo, domain.DefaultImpersonationPolicy())
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Role: domain.RoleModerator}, nil)
+	m.impersonationRepo.On("Create", mock.Anything, mock.MatchedBy(func(session *domain.ImpersonationSession) bool {
+		return session.ID != "" && session.ActorID == 1 && session.SubjectID == 7 && session.Reason == "ticket 42" &&
+			time.Until(session.ExpiresAt) > 29*time.Minute && time.Until(session.ExpiresAt) <= 30*time.Minute
+	})).Return(&domain.ImpersonationSession{ID: "impersonation-1", ActorID: 1, SubjectID: 7}, nil)
+
+	// Act
+	session, subject, err := impersonationService.Start(context.Background(), 1, 7, &domain.StartImpersonationDTO{Reason: "ticket 42"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "impersonation-1", session.ID)
+	assert.Equal(t, int64(7), subject.ID)
+	m.impersonationRepo.AssertExpectations(t)
+}
+
+func TestStartImpersonation_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		subjectId int64
+		reason    string
+		subject   *domain.User
+		lookupErr error
+		expected  error
+	}{
+		{name: "missing reason", subjectId: 7, reason: ""},
+		{name: "yourself", subjectId: 1, reason: "ticket 42", expected: &domain.BadRequestError{}},
+		{name: "unknown user", subjectId: 7, reason: "ticket 42", lookupErr: domain.ErrNotFound, expected: &domain.NotFoundError{}},
+		{name: "another admin", subjectId: 7, reason: "ticket 42", subject: &domain.User{ID: 7, Role: domain.RoleAdmin}, expected: &domain.ForbiddenError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			impersonationService := services.NewImpersonationService(m.userRepo, m.impersonationRepo, m.impersonationAuditLogRepo, domain.DefaultImpersonationPolicy())
+			m.userRepo.On("GetByID", mock.Anything, tt.subjectId).Return(tt.subject, tt.lookupErr)
+
+			// Act
+			session, _, err := impersonationService.Start(context.Background(), 1, tt.subjectId, &domain.StartImpersonationDTO{Reason: tt.reason})
+
+			// Assert
+			assert.Error(t, err)
+			if tt.expected != nil {
+				assert.IsType(t, tt.expected, err)
+			}
+			assert.Nil(t, session)
+			m.impersonationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestEndImpersonation_NotFound(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	impersonationService := services.NewImpersonationService(m.userRepo, m.impersonationRepo, m.impersonationAuditLogRepo, domain.DefaultImpersonationPolicy())
+	m.impersonationRepo.On("End", mock.Anything, "impersonation-1").Return(domain.ErrNotFound)
+
+	// Act
+	err := impersonationService.End(context.Background(), 1, "impersonation-1")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestListImpersonationAuditLog_ClampsLimit(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	impersonationService := services.NewImpersonationService(m.userRepo, m.impersonationRepo, m.impersonationAuditLogRepo, domain.DefaultImpersonationPolicy())
+	m.impersonationAuditLogRepo.On("List", mock.Anything, 100, 0).Return([]domain.ImpersonationAuditEntry{{ID: 1}}, nil)
+
+	// Act
+	entries, err := impersonationService.ListAuditLog(context.Background(), 1000, -5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	m.impersonationAuditLogRepo.AssertExpectations(t)
+}
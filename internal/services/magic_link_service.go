@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+)
+
+type magicLinkService struct {
+	userRepo      interfaces.UserRepository
+	userTokenRepo interfaces.UserTokenRepository
+	requestRepo   interfaces.MagicLinkRequestRepository
+	mailer        interfaces.Mailer
+	policy        *domain.MagicLinkPolicy
+}
+
+func NewMagicLinkService(
+	userRepo interfaces.UserRepository,
+	userTokenRepo interfaces.UserTokenRepository,
+	requestRepo interfaces.MagicLinkRequestRepository,
+	mailer interfaces.Mailer,
+	policy *domain.MagicLinkPolicy,
+) interfaces.MagicLinkService {
+	return &magicLinkService{
+		userRepo:      userRepo,
+		userTokenRepo: userTokenRepo,
+		requestRepo:   requestRepo,
+		mailer:        mailer,
+		policy:        policy,
+	}
+}
+
+// RequestLink emails a single-use login link to the user. Like a password reset request, it
+// succeeds whether or not the email belongs to an account; only the per-IP limit is reported.
+func (s *magicLinkService) RequestLink(ctx context.Context, request *domain.MagicLinkRequestDTO, ipAddress string) error {
+	if err := validation.Validate.Struct(request); err != nil {
+		return err
+	}
+
+	windowStart := time.Now().Add(-s.policy.Window)
+
+	fromIP, err := s.requestRepo.CountByIPSince(ctx, ipAddress, windowStart)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count magic link requests by ip")
+		return domain.NewInternalServerError("failed to request login link")
+	}
+	if fromIP >= s.policy.MaxPerIP {
+		return domain.NewTooManyRequestsError("too many login links requested, please try again later", s.policy.Window)
+	}
+
+	toEmail, err := s.requestRepo.CountByEmailSince(ctx, request.Email, windowStart)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count magic link requests by email")
+		return domain.NewInternalServerError("failed to request login link")
+	}
+
+	if err := s.requestRepo.Record(ctx, request.Email, ipAddress); err != nil {
+		log.Error().Err(err).Msg("failed to record magic link request")
+		return domain.NewInternalServerError("failed to request login link")
+	}
+	if err := s.requestRepo.DeleteCreatedBefore(ctx, windowStart); err != nil {
+		log.Error().Err(err).Msg("failed to delete old magic link requests")
+	}
+
+	if toEmail >= s.policy.MaxPerEmail {
+		log.Info().Msg("magic link limit reached for email, not sending")
+		return nil
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, request.Email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			log.Info().Msg("magic link requested for unknown email")
+			return nil
+		}
+		log.Error().Err(err).Msg("failed to get user by email")
+		return domain.NewInternalServerError("failed to request login link")
+	}
+
+	rawToken, err := issueUserToken(ctx, s.userTokenRepo, user.ID, domain.TokenPurposeMagicLogin, s.policy.TokenTTL)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to issue magic link token")
+		return domain.NewInternalServerError("failed to request login link")
+	}
+
+	message := &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Your login link",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to log in. It can be used once and expires in %d minutes.\n\n%s\n\nIf you did not request this link, you can ignore this email.\n",
+			user.FirstName,
+			int(s.policy.TokenTTL.Minutes()),
+			appLink("/login/magic", rawToken),
+		),
+	}
+
+	// A delivery failure is not reported to the caller, as that would reveal the account exists.
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Error().Err(err).Msg("failed to send magic link email")
+	}
+
+	return nil
+}
+
+// Redeem consumes a login link and returns its user. Following the link proves the user
+// controls the address, which verifies it.
+func (s *magicLinkService) Redeem(ctx context.Context, login *domain.MagicLinkLoginDTO) (*domain.User, error) {
+	if err := validation.Validate.Struct(login); err != nil {
+		return nil, err
+	}
+
+	token, err := s.userTokenRepo.Consume(ctx, domain.TokenPurposeMagicLogin, tokens.Hash(login.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired login link")
+		}
+		log.Error().Err(err).Msg("failed to consume magic link token")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired login link")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	if user.EmailVerifiedAt == nil {
+		if err := s.userRepo.MarkEmailVerified(ctx, user.ID); err != nil {
+			log.Error().Err(err).Msg("failed to mark email verified")
+		}
+	}
+
+	return user, nil
+}
@@ -0,0 +1,142 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectRequests sets up the request counts of the current window.
+func (m *serviceMocks) expectRequests(email, ipAddress string, byEmail, byIP int) {
+	m.magicLinkRequestRepo.On("CountByIPSince", mock.Anything, ipAddress, mock.AnythingOfType("time.Time")).Return(byIP, nil)
+	m.magicLinkRequestRepo.On("CountByEmailSince", mock.Anything, email, mock.AnythingOfType("time.Time")).Return(byEmail, nil)
+	m.magicLinkRequestRepo.On("Record", mock.Anything, email, ipAddress).Return(nil)
+	m.magicLinkRequestRepo.On("DeleteCreatedBefore", mock.Anything, mock.AnythingOfType("time.Time")).Return(nil)
+}
+
+func TestRequestLink_SendsEmailWithToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	user := &domain.User{ID: 7, FirstName: "Jane", Email: "jane@example.com"}
+	m.expectRequests(user.Email, "203.0.113.7", 0, 0)
+
+	var storedHash string
+	var expiresAt time.Time
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposeMagicLogin).Return(nil)
+	m.userTokenRepo.On("Create", mock.Anything, user.ID, domain.TokenPurposeMagicLogin, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { storedHash, expiresAt = args.String(3), args.Get(4).(time.Time) }).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+
+	var sent *domain.EmailMessage
+	m.mailer.On("Send", mock.Anything, mock.AnythingOfType("*domain.EmailMessage")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*domain.EmailMessage) }).
+		Return(nil)
+
+	// Act
+	err := magicLinkService.RequestLink(context.Background(), &domain.MagicLinkRequestDTO{Email: user.Email}, "203.0.113.7")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(domain.DefaultMagicLinkPolicy().TokenTTL), expiresAt, time.Minute)
+	if assert.NotNil(t, sent) {
+		assert.Equal(t, user.Email, sent.To)
+		_, rawToken, found := strings.Cut(sent.Body, "token=")
+		assert.True(t, found, "expected the email to contain the login link")
+		rawToken = strings.Fields(rawToken)[0]
+		assert.Equal(t, storedHash, tokens.Hash(rawToken), "expected only the hash of the emailed token to be stored")
+	}
+	m.magicLinkRequestRepo.AssertExpectations(t)
+}
+
+func TestRequestLink_UnknownEmail(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	m.expectRequests("nobody@example.com", "203.0.113.7", 0, 0)
+	m.userRepo.On("GetByEmail", mock.Anything, "nobody@example.com").Return((*domain.User)(nil), domain.ErrNotFound)
+
+	// Act
+	err := magicLinkService.RequestLink(context.Background(), &domain.MagicLinkRequestDTO{Email: "nobody@example.com"}, "203.0.113.7")
+
+	// Assert
+	assert.NoError(t, err, "unknown emails must not be distinguishable from known ones")
+	m.magicLinkRequestRepo.AssertCalled(t, "Record", mock.Anything, "nobody@example.com", "203.0.113.7")
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestRequestLink_EmailLimitSendsNothingSilently(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	policy := domain.DefaultMagicLinkPolicy()
+	m.expectRequests("jane@example.com", "203.0.113.7", policy.MaxPerEmail, 0)
+
+	// Act
+	err := magicLinkService.RequestLink(context.Background(), &domain.MagicLinkRequestDTO{Email: "jane@example.com"}, "203.0.113.7")
+
+	// Assert
+	assert.NoError(t, err)
+	m.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestRequestLink_IPLimit(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	policy := domain.DefaultMagicLinkPolicy()
+	m.magicLinkRequestRepo.On("CountByIPSince", mock.Anything, "203.0.113.7", mock.AnythingOfType("time.Time")).Return(policy.MaxPerIP, nil)
+
+	// Act
+	err := magicLinkService.RequestLink(context.Background(), &domain.MagicLinkRequestDTO{Email: "jane@example.com"}, "203.0.113.7")
+
+	// Assert
+	var tooManyErr *domain.TooManyRequestsError
+	if assert.ErrorAs(t, err, &tooManyErr) {
+		assert.Equal(t, policy.Window, tooManyErr.RetryAfter)
+	}
+	m.magicLinkRequestRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRedeem_VerifiesEmail(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	user := &domain.User{ID: 7, Email: "jane@example.com"}
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeMagicLogin, tokens.Hash("raw-token")).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+	m.userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil)
+	m.userRepo.On("MarkEmailVerified", mock.Anything, user.ID).Return(nil)
+
+	// Act
+	loggedIn, err := magicLinkService.Redeem(context.Background(), &domain.MagicLinkLoginDTO{Token: "raw-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, loggedIn.ID)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestRedeem_InvalidToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	magicLinkService := services.NewMagicLinkService(m.userRepo, m.userTokenRepo, m.magicLinkRequestRepo, m.mailer, domain.DefaultMagicLinkPolicy())
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeMagicLogin, tokens.Hash("used-token")).
+		Return((*domain.UserToken)(nil), domain.ErrNotFound)
+
+	// Act
+	user, err := magicLinkService.Redeem(context.Background(), &domain.MagicLinkLoginDTO{Token: "used-token"})
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	assert.Nil(t, user)
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// oidcLoginTTL is how long the user has to come back from the provider.
+	oidcLoginTTL  = 10 * time.Minute
+	oidcStateSize = 32
+	// oidcCodeVerifierSize gives a 43 characters verifier, the minimum of RFC 7636.
+	oidcCodeVerifierSize = 32
+	maxUsernameLength    = 20
+	minUsernameLength    = 3
+	usernameAttempts     = 5
+)
+
+type oidcService struct {
+	providers    map[string]interfaces.OIDCProvider
+	names        []string
+	userRepo     interfaces.UserRepository
+	identityRepo interfaces.UserIdentityRepository
+	stateRepo    interfaces.OIDCLoginStateRepository
+}
+
+func NewOIDCService(
+	providers []interfaces.OIDCProvider,
+	userRepo interfaces.UserRepository,
+	identityRepo interfaces.UserIdentityRepository,
+	stateRepo interfaces.OIDCLoginStateRepository,
+) interfaces.OIDCService {
+	s := &oidcService{
+		providers:    make(map[string]interfaces.OIDCProvider, len(providers)),
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		stateRepo:    stateRepo,
+	}
+	for _, provider := range providers {
+		s.providers[provider.Name()] = provider
+		s.names = append(s.names, provider.Name())
+	}
+	return s
+}
+
+// Providers returns the names of the configured providers, in configuration order.
+func (s *oidcService) Providers() []string {
+	return append([]string{}, s.names...)
+}
+
+// StartLogin prepares the redirection to the provider. The state is returned to be bound to
+// the browser; the nonce and code verifier never leave the server.
+func (s *oidcService) StartLogin(ctx context.Context, providerName string) (*domain.OIDCLogin, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, domain.NewNotFoundError("unknown identity provider")
+	}
+
+	state, err := tokens.Generate(oidcStateSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate oidc state")
+		return nil, domain.NewInternalServerError("failed to start login")
+	}
+	nonce, err := tokens.Generate(oidcStateSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate oidc nonce")
+		return nil, domain.NewInternalServerError("failed to start login")
+	}
+	codeVerifier, err := tokens.Generate(oidcCodeVerifierSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate oidc code verifier")
+		return nil, domain.NewInternalServerError("failed to start login")
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		log.Error().Err(err).Str("provider", providerName).Msg("failed to build authorization url")
+		return nil, domain.NewInternalServerError("identity provider unavailable")
+	}
+
+	expiresAt := time.Now().Add(oidcLoginTTL)
+	err = s.stateRepo.Create(ctx, &domain.OIDCLoginState{
+		StateHash:    tokens.Hash(state),
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to store oidc login state")
+		return nil, domain.NewInternalServerError("failed to start login")
+	}
+
+	return &domain.OIDCLogin{AuthURL: authURL, State: state, ExpiresAt: expiresAt}, nil
+}
+
+// CompleteLogin redeems the code the provider sent the user back with and returns the user
+// it identifies. The caller has checked the state against the one bound to the browser.
+//
+// An identity already linked logs its user in. Otherwise the identity is linked to the user
+// with the same email address, provided both the provider and this API verified the address,
+// or a passwordless user is created for it.
+func (s *oidcService) CompleteLogin(ctx context.Context, providerName, state, code string) (*domain.User, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, domain.NewNotFoundError("unknown identity provider")
+	}
+
+	if state == "" || code == "" {
+		return nil, domain.NewBadRequestError("missing state or code")
+	}
+
+	loginState, err := s.stateRepo.Consume(ctx, tokens.Hash(state))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired login")
+		}
+		log.Error().Err(err).Msg("failed to consume oidc login state")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+	if loginState.Provider != providerName {
+		return nil, domain.NewUnauthorizedError("invalid or expired login")
+	}
+
+	idToken, err := provider.Authenticate(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidToken) {
+			log.Warn().Err(err).Str("provider", providerName).Msg("rejected oidc id token")
+			return nil, domain.NewUnauthorizedError("invalid identity token")
+		}
+		log.Error().Err(err).Str("provider", providerName).Msg("failed to redeem authorization code")
+		return nil, domain.NewUnauthorizedError("failed to authenticate with the identity provider")
+	}
+
+	identity, err := s.identityRepo.GetByProviderSubject(ctx, providerName, idToken.Subject)
+	if err == nil {
+		return s.loginLinked(ctx, identity)
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		log.Error().Err(err).Msg("failed to get user identity")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	return s.linkOrCreate(ctx, providerName, idToken)
+}
+
+func (s *oidcService) loginLinked(ctx context.Context, identity *domain.UserIdentity) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, identity.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	if err := s.identityRepo.Touch(ctx, identity.ID); err != nil {
+		log.Error().Err(err).Msg("failed to update user identity last login")
+	}
+
+	return user, nil
+}
+
+func (s *oidcService) linkOrCreate(ctx context.Context, providerName string, idToken *oidc.IDToken) (*domain.User, error) {
+	// An unverified address could belong to anybody: linking on it would hand the account over.
+	if idToken.Email == "" || !idToken.EmailVerified {
+		return nil, domain.NewForbiddenError("the identity provider did not verify the email address")
+	}
+	email := idToken.Email
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	switch {
+	case err == nil:
+		if user.EmailVerifiedAt == nil {
+			return nil, fmt.Errorf("%w: verify the email address of the existing account before signing in with %s", domain.ErrEmailNotVerified, providerName)
+		}
+	case errors.Is(err, domain.ErrNotFound):
+		user, err = s.createExternalUser(ctx, email, idToken)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		log.Error().Err(err).Msg("failed to get user by email")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	_, err = s.identityRepo.Create(ctx, &domain.UserIdentity{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  idToken.Subject,
+		Email:    email,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to link user identity")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	log.Info().Int64("userId", user.ID).Str("provider", providerName).Msg("linked external identity")
+	return user, nil
+}
+
+// createExternalUser creates a passwordless user, picking another username when the one
+// derived from the identity is taken.
+func (s *oidcService) createExternalUser(ctx context.Context, email string, idToken *oidc.IDToken) (*domain.User, error) {
+	firstName, lastName := externalNames(idToken)
+	base := externalUsername(idToken, email)
+
+	username := base
+	for attempt := 0; attempt < usernameAttempts; attempt++ {
+		user, err := s.userRepo.CreateExternal(ctx, &domain.EditableUserField{
+			FirstName: firstName,
+			LastName:  lastName,
+			Email:     email,
+			Username:  username,
+		})
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, domain.ErrDuplicateEmailOrUsername) {
+			log.Error().Err(err).Msg("failed to create external user")
+			return nil, domain.NewInternalServerError("failed to create user")
+		}
+
+		suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			log.Error().Err(err).Msg("failed to generate username suffix")
+			return nil, domain.NewInternalServerError("failed to create user")
+		}
+		username = fmt.Sprintf("%s%04d", base, suffix.Int64())
+	}
+
+	return nil, domain.ErrDuplicateEmailOrUsername
+}
+
+// externalNames returns the names of the user, which the users table requires.
+func externalNames(idToken *oidc.IDToken) (string, string) {
+	firstName := strings.TrimSpace(idToken.GivenName)
+	lastName := strings.TrimSpace(idToken.FamilyName)
+
+	if firstName == "" {
+		firstName, _, _ = strings.Cut(strings.TrimSpace(idToken.Name), " ")
+	}
+	if firstName == "" {
+		firstName = "User"
+	}
+	if lastName == "" {
+		lastName = "-"
+	}
+
+	return truncateRunes(firstName, 50), truncateRunes(lastName, 50)
+}
+
+// externalUsername derives an alphanumeric username, like the ones signups accept, from the
+// preferred username of the identity or from its email address, avoiding the reserved ones.
+func externalUsername(idToken *oidc.IDToken, email string) string {
+	candidate := idToken.PreferredUsername
+	if candidate == "" {
+		candidate, _, _ = strings.Cut(email, "@")
+	}
+
+	var b strings.Builder
+	for _, r := range candidate {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+		if b.Len() == maxUsernameLength {
+			break
+		}
+	}
+
+	username := b.String()
+	for len(username) < minUsernameLength || domain.IsReservedUsername(username) {
+		username += "user"
+	}
+	return truncateRunes(username, maxUsernameLength)
+}
+
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) > max {
+		return string(runes[:max])
+	}
+	return s
+}
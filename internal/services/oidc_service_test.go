@@ -0,0 +1,227 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectAuthentication sets up a login in progress redeemed with the given ID token.
+func (m *serviceMocks) expectAuthentication(idToken *oidc.IDToken) {
+	m.oidcStateRepo.On("Consume", mock.Anything, tokens.Hash("state")).
+		Return(&domain.OIDCLoginState{Provider: "google", Nonce: "nonce", CodeVerifier: "verifier", ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	m.oidcProvider.On("Authenticate", mock.Anything, "code", "verifier", "nonce").Return(idToken, nil)
+}
+
+func TestOIDCStartLogin_StoresHashedState(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+
+	var state, nonce, codeVerifier string
+	m.oidcProvider.On("AuthCodeURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { state, nonce, codeVerifier = args.String(1), args.String(2), args.String(3) }).
+		Return("https://accounts.example.com/authorize", nil)
+	m.oidcStateRepo.On("Create", mock.Anything, mock.MatchedBy(func(loginState *domain.OIDCLoginState) bool {
+		return loginState.StateHash == tokens.Hash(state) && loginState.Nonce == nonce &&
+			loginState.CodeVerifier == codeVerifier && loginState.Provider == "google"
+	})).Return(nil)
+
+	// Act
+	login, err := oidcService.StartLogin(context.Background(), "google")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://accounts.example.com/authorize", login.AuthURL)
+	assert.Equal(t, state, login.State)
+	assert.NotEqual(t, state, nonce)
+	assert.GreaterOrEqual(t, len(codeVerifier), 43)
+	m.oidcStateRepo.AssertExpectations(t)
+}
+
+func TestOIDCStartLogin_UnknownProvider(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+
+	// Act
+	_, err := oidcService.StartLogin(context.Background(), "github")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestOIDCCompleteLogin_LinkedIdentity(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	m.expectAuthentication(&oidc.IDToken{Subject: "sub-1"})
+	m.identityRepo.On("GetByProviderSubject", mock.Anything, "google", "sub-1").Return(&domain.UserIdentity{ID: 3, UserID: 7}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.identityRepo.On("Touch", mock.Anything, int64(3)).Return(nil)
+
+	// Act
+	user, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), user.ID)
+	m.identityRepo.AssertExpectations(t)
+}
+
+func TestOIDCCompleteLogin_UnknownState(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	m.oidcStateRepo.On("Consume", mock.Anything, tokens.Hash("state")).Return((*domain.OIDCLoginState)(nil), domain.ErrNotFound)
+
+	// Act
+	_, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.oidcProvider.AssertNotCalled(t, "Authenticate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOIDCCompleteLogin_InvalidIDToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	m.oidcStateRepo.On("Consume", mock.Anything, tokens.Hash("state")).
+		Return(&domain.OIDCLoginState{Provider: "google", Nonce: "nonce", CodeVerifier: "verifier"}, nil)
+	m.oidcProvider.On("Authenticate", mock.Anything, "code", "verifier", "nonce").
+		Return((*oidc.IDToken)(nil), fmt.Errorf("%w: nonce mismatch", oidc.ErrInvalidToken))
+
+	// Act
+	_, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+}
+
+func TestOIDCCompleteLogin_LinksVerifiedEmail(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	verifiedAt := time.Now()
+	m.expectAuthentication(&oidc.IDToken{Subject: "sub-1", Email: "jane@example.com", EmailVerified: true})
+	m.identityRepo.On("GetByProviderSubject", mock.Anything, "google", "sub-1").Return((*domain.UserIdentity)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, "jane@example.com").Return(&domain.User{ID: 7, EmailVerifiedAt: &verifiedAt}, nil)
+	m.identityRepo.On("Create", mock.Anything, &domain.UserIdentity{UserID: 7, Provider: "google", Subject: "sub-1", Email: "jane@example.com"}).
+		Return(&domain.UserIdentity{ID: 3, UserID: 7}, nil)
+
+	// Act
+	user, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), user.ID)
+	m.identityRepo.AssertExpectations(t)
+	m.userRepo.AssertNotCalled(t, "CreateExternal", mock.Anything, mock.Anything)
+}
+
+func TestOIDCCompleteLogin_RefusesToLinkUnverifiedEmail(t *testing.T) {
+	testCases := []struct {
+		name          string
+		emailVerified bool
+		localVerified bool
+		expectErr     func(t *testing.T, err error)
+	}{
+		{
+			name:          "not verified by the provider",
+			emailVerified: false,
+			localVerified: true,
+			expectErr:     func(t *testing.T, err error) { assert.IsType(t, &domain.ForbiddenError{}, err) },
+		},
+		{
+			name:          "not verified by the existing account",
+			emailVerified: true,
+			localVerified: false,
+			expectErr:     func(t *testing.T, err error) { assert.ErrorIs(t, err, domain.ErrEmailNotVerified) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			m := newServiceMocks()
+			m.oidcProvider.On("Name").Return("google")
+			oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+			existing := &domain.User{ID: 7}
+			if tc.localVerified {
+				verifiedAt := time.Now()
+				existing.EmailVerifiedAt = &verifiedAt
+			}
+			idToken := &oidc.IDToken{Subject: "sub-1", Email: "jane@example.com"}
+			if tc.emailVerified {
+				idToken.EmailVerified = true
+			}
+			m.expectAuthentication(idToken)
+			m.identityRepo.On("GetByProviderSubject", mock.Anything, "google", "sub-1").Return((*domain.UserIdentity)(nil), domain.ErrNotFound)
+			m.userRepo.On("GetByEmail", mock.Anything, "jane@example.com").Return(existing, nil)
+
+			// Act
+			user, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+			// Assert
+			assert.Nil(t, user)
+			tc.expectErr(t, err)
+			m.identityRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestOIDCCompleteLogin_CreatesUserWithFreeUsername(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	m.expectAuthentication(&oidc.IDToken{Subject: "sub-1", Email: "jane.doe@example.com", EmailVerified: true, GivenName: "Jane"})
+	m.identityRepo.On("GetByProviderSubject", mock.Anything, "google", "sub-1").Return((*domain.UserIdentity)(nil), domain.ErrNotFound)
+	m.userRepo.On("GetByEmail", mock.Anything, "jane.doe@example.com").Return((*domain.User)(nil), domain.ErrNotFound)
+	m.userRepo.On("CreateExternal", mock.Anything, mock.MatchedBy(func(u *domain.EditableUserField) bool { return u.Username == "janedoe" })).
+		Return((*domain.User)(nil), domain.ErrDuplicateEmailOrUsername).Once()
+	m.userRepo.On("CreateExternal", mock.Anything, mock.MatchedBy(func(u *domain.EditableUserField) bool {
+		return len(u.Username) == len("janedoe")+4 && u.FirstName == "Jane" && u.LastName != ""
+	})).Return(&domain.User{ID: 9}, nil).Once()
+	m.identityRepo.On("Create", mock.Anything, mock.MatchedBy(func(identity *domain.UserIdentity) bool { return identity.UserID == 9 })).
+		Return(&domain.UserIdentity{ID: 3, UserID: 9}, nil)
+
+	// Act
+	user, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), user.ID)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestOIDCCompleteLogin_ProviderMismatch(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	m.oidcProvider.On("Name").Return("google")
+	oidcService := services.NewOIDCService([]interfaces.OIDCProvider{m.oidcProvider}, m.userRepo, m.identityRepo, m.oidcStateRepo)
+	m.oidcStateRepo.On("Consume", mock.Anything, tokens.Hash("state")).Return(&domain.OIDCLoginState{Provider: "github"}, nil)
+
+	// Act
+	_, err := oidcService.CompleteLogin(context.Background(), "google", "state", "code")
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.oidcProvider.AssertNotCalled(t, "Authenticate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
+)
+
+// newPageRequest validates the pagination parameters of a list. The limit is clamped to 100
+// and defaults to defaultLimit. The request fetches one item more than the limit, which tells
+// whether there is a next page.
+func newPageRequest(codec *cursor.Codec, after string, limit, offset, defaultLimit int) (domain.PageRequest, int, error) {
+	if limit > 100 {
+		limit = 100
+	} else if limit <= 0 {
+		limit = defaultLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	page := domain.PageRequest{Offset: offset, Limit: limit + 1}
+	if after != "" {
+		if offset > 0 {
+			return domain.PageRequest{}, 0, domain.NewBadRequestError("cursor and offset cannot be combined")
+		}
+		position, err := codec.Decode(after)
+		if err != nil {
+			return domain.PageRequest{}, 0, domain.NewBadRequestError("invalid cursor")
+		}
+		page.After = &position
+	}
+
+	return page, limit, nil
+}
+
+// trimPage drops the item fetched past the limit, and returns the cursor of the next page
+// when there was one, or an empty cursor on the last page.
+func trimPage[T any](codec *cursor.Codec, items []T, limit int, position func(T) domain.Cursor) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+
+	items = items[:limit]
+	return items, codec.Encode(position(items[limit-1]))
+}
+
+func postPosition(post domain.Post) domain.Cursor {
+	return domain.Cursor{CreatedAt: post.CreatedAt, ID: post.ID}
+}
+
+func commentPosition(comment domain.Comment) domain.Cursor {
+	return domain.Cursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
+
+// followPosition is the position of a follow, by the time it was followed then user id.
+func followPosition(user domain.FollowUser) domain.Cursor {
+	return domain.Cursor{CreatedAt: user.FollowedAt, ID: user.ID}
+}
+
+func userPosition(user domain.User) domain.Cursor {
+	return domain.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}
+}
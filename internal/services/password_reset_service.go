@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordResetTokenTTL = time.Hour
+
+type passwordResetService struct {
+	userRepo         interfaces.UserRepository
+	userTokenRepo    interfaces.UserTokenRepository
+	refreshTokenRepo interfaces.RefreshTokenRepository
+	sessionRepo      interfaces.SessionRepository
+	mailer           interfaces.Mailer
+}
+
+func NewPasswordResetService(
+	userRepo interfaces.UserRepository,
+	userTokenRepo interfaces.UserTokenRepository,
+	refreshTokenRepo interfaces.RefreshTokenRepository,
+	sessionRepo interfaces.SessionRepository,
+	mailer interfaces.Mailer,
+) interfaces.PasswordResetService {
+	return &passwordResetService{
+		userRepo:         userRepo,
+		userTokenRepo:    userTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		mailer:           mailer,
+	}
+}
+
+// RequestReset emails a reset link to the user. It succeeds whether or not the email
+// belongs to an account, so that the endpoint cannot be used to enumerate users.
+func (s *passwordResetService) RequestReset(ctx context.Context, forgotPassword *domain.ForgotPasswordDTO) error {
+	if err := validation.Validate.Struct(forgotPassword); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, forgotPassword.Email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			log.Info().Msg("password reset requested for unknown email")
+			return nil
+		}
+		log.Error().Err(err).Msg("failed to get user by email")
+		return domain.NewInternalServerError("failed to request password reset")
+	}
+
+	rawToken, err := issueUserToken(ctx, s.userTokenRepo, user.ID, domain.TokenPurposePasswordReset, passwordResetTokenTTL)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to issue password reset token")
+		return domain.NewInternalServerError("failed to request password reset")
+	}
+
+	message := &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to choose a new password. It expires in one hour.\n\n%s\n\nIf you did not request a password reset, you can ignore this email.\n",
+			user.FirstName,
+			appLink("/reset-password", rawToken),
+		),
+	}
+
+	// A delivery failure is not reported to the caller, as that would reveal the account exists.
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Error().Err(err).Msg("failed to send password reset email")
+	}
+
+	return nil
+}
+
+// ResetPassword consumes a reset token, sets the new password and logs the user out
+// of every session.
+func (s *passwordResetService) ResetPassword(ctx context.Context, resetPassword *domain.ResetPasswordDTO) error {
+	if err := validation.Validate.Struct(resetPassword); err != nil {
+		return err
+	}
+
+	token, err := s.userTokenRepo.Consume(ctx, domain.TokenPurposePasswordReset, tokens.Hash(resetPassword.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewBadRequestError("invalid or expired password reset token")
+		}
+		log.Error().Err(err).Msg("failed to consume password reset token")
+		return domain.NewInternalServerError("failed to reset password")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(resetPassword.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to hash password")
+		return domain.NewInternalServerError("failed to reset password")
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, token.UserID, string(hashedPassword)); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewBadRequestError("invalid or expired password reset token")
+		}
+		log.Error().Err(err).Msg("failed to update password")
+		return domain.NewInternalServerError("failed to reset password")
+	}
+
+	if err := s.userTokenRepo.InvalidateAll(ctx, token.UserID, domain.TokenPurposePasswordReset); err != nil {
+		log.Error().Err(err).Msg("failed to invalidate password reset tokens")
+	}
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, token.UserID, ""); err != nil {
+		log.Error().Err(err).Msg("failed to revoke sessions")
+		return domain.NewInternalServerError("failed to reset password")
+	}
+
+	if err := s.refreshTokenRepo.RevokeAllForUser(ctx, token.UserID); err != nil {
+		log.Error().Err(err).Msg("failed to revoke refresh tokens")
+		return domain.NewInternalServerError("failed to reset password")
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestReset_SendsEmailWithToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	passwordResetService := services.NewPasswordResetService(m.userRepo, m.userTokenRepo, m.refreshTokenRepo, m.sessionRepo, m.mailer)
+	user := &domain.User{ID: 7, FirstName: "Jane", Email: "jane@example.com"}
+
+	var storedHash string
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposePasswordReset).Return(nil)
+	m.userTokenRepo.On("Create", mock.Anything, user.ID, domain.TokenPurposePasswordReset, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { storedHash = args.String(3) }).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+
+	var sent *domain.EmailMessage
+	m.mailer.On("Send", mock.Anything, mock.AnythingOfType("*domain.EmailMessage")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*domain.EmailMessage) }).
+		Return(nil)
+
+	// Act
+	err := passwordResetService.RequestReset(context.Background(), &domain.ForgotPasswordDTO{Email: user.Email})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, sent) {
+		assert.Equal(t, user.Email, sent.To)
+		_, rawToken, found := strings.Cut(sent.Body, "token=")
+		assert.True(t, found, "expected the email to contain the reset link")
+		rawToken = strings.Fields(rawToken)[0]
+		assert.Equal(t, storedHash, tokens.Hash(rawToken), "expected only the hash of the emailed token to be stored")
+	}
+	m.userTokenRepo.AssertExpectations(t)
+}
+
+func TestRequestReset_UnknownEmail(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	passwordResetService := services.NewPasswordResetService(m.userRepo, m.userTokenRepo, m.refreshTokenRepo, m.sessionRepo, m.mailer)
+	m.userRepo.On("GetByEmail", mock.Anything, "nobody@example.com").Return((*domain.User)(nil), domain.ErrNotFound)
+
+	// Act
+	err := passwordResetService.RequestReset(context.Background(), &domain.ForgotPasswordDTO{Email: "nobody@example.com"})
+
+	// Assert
+	assert.NoError(t, err, "unknown emails must not be distinguishable from known ones")
+	m.userTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	m.mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestRequestReset_MailerFailureIsNotReported(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	passwordResetService := services.NewPasswordResetService(m.userRepo, m.userTokenRepo, m.refreshTokenRepo, m.sessionRepo, m.mailer)
+	user := &domain.User{ID: 7, Email: "jane@example.com"}
+	m.userRepo.On("GetByEmail", mock.Anything, user.Email).Return(user, nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, user.ID, domain.TokenPurposePasswordReset).Return(nil)
+	m.userTokenRepo.On("Create", mock.Anything, user.ID, domain.TokenPurposePasswordReset, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(&domain.UserToken{ID: 1, UserID: user.ID}, nil)
+	m.mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp down"))
+
+	// Act
+	err := passwordResetService.RequestReset(context.Background(), &domain.ForgotPasswordDTO{Email: user.Email})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_Success(t *testing.T) {
+	// Arrange
+	const rawToken = "raw-reset-token"
+	m := newServiceMocks()
+	passwordResetService := services.NewPasswordResetService(m.userRepo, m.userTokenRepo, m.refreshTokenRepo, m.sessionRepo, m.mailer)
+
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposePasswordReset, tokens.Hash(rawToken)).
+		Return(&domain.UserToken{ID: 1, UserID: 7}, nil)
+	m.userRepo.On("UpdatePassword", mock.Anything, int64(7), mock.AnythingOfType("string")).Return(nil)
+	m.userTokenRepo.On("InvalidateAll", mock.Anything, int64(7), domain.TokenPurposePasswordReset).Return(nil)
+	m.sessionRepo.On("RevokeAllForUser", mock.Anything, int64(7), "").Return(nil)
+	m.refreshTokenRepo.On("RevokeAllForUser", mock.Anything, int64(7)).Return(nil)
+
+	// Act
+	err := passwordResetService.ResetPassword(context.Background(), &domain.ResetPasswordDTO{Token: rawToken, Password: "newpassword"})
+
+	// Assert
+	assert.NoError(t, err)
+	hashedPassword := m.userRepo.Calls[0].Arguments.String(2)
+	assert.NotEqual(t, "newpassword", hashedPassword, "expected the password to be hashed")
+	m.userRepo.AssertExpectations(t)
+	m.sessionRepo.AssertExpectations(t)
+	m.refreshTokenRepo.AssertExpectations(t)
+}
+
+func TestResetPassword_InvalidToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	passwordResetService := services.NewPasswordResetService(m.userRepo, m.userTokenRepo, m.refreshTokenRepo, m.sessionRepo, m.mailer)
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposePasswordReset, tokens.Hash("used")).
+		Return((*domain.UserToken)(nil), domain.ErrNotFound)
+
+	// Act
+	err := passwordResetService.ResetPassword(context.Background(), &domain.ResetPasswordDTO{Token: "used", Password: "newpassword"})
+
+	// Assert
+	var badRequestErr *domain.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+	m.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	m.sessionRepo.AssertNotCalled(t, "RevokeAllForUser", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	personalAccessTokenSize = 32
+	// personalAccessTokenTouchInterval throttles last_used_at writes to one per token per interval.
+	personalAccessTokenTouchInterval = time.Minute
+)
+
+type personalAccessTokenService struct {
+	userRepo  interfaces.UserRepository
+	tokenRepo interfaces.PersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenService(
+	userRepo interfaces.UserRepository,
+	tokenRepo interfaces.PersonalAccessTokenRepository,
+) interfaces.PersonalAccessTokenService {
+	return &personalAccessTokenService{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Create issues a new token. The raw token is only returned here; the database keeps its hash.
+func (s *personalAccessTokenService) Create(ctx context.Context, userId int64, createToken *domain.CreatePersonalAccessTokenDTO) (*domain.PersonalAccessToken, error) {
+	if err := validation.Validate.Struct(createToken); err != nil {
+		return nil, err
+	}
+
+	if createToken.ExpiresAt != nil && !createToken.ExpiresAt.After(time.Now()) {
+		return nil, domain.NewBadRequestError("expires_at must be in the future")
+	}
+
+	secret, err := tokens.Generate(personalAccessTokenSize)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate personal access token")
+		return nil, domain.NewInternalServerError("failed to create personal access token")
+	}
+	rawToken := domain.PersonalAccessTokenPrefix + secret
+
+	token, err := s.tokenRepo.Create(ctx, &domain.PersonalAccessToken{
+		UserID:    userId,
+		Name:      createToken.Name,
+		TokenHash: tokens.Hash(rawToken),
+		Scopes:    createToken.Scopes,
+		ExpiresAt: createToken.ExpiresAt,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create personal access token")
+		return nil, domain.NewInternalServerError("failed to create personal access token")
+	}
+
+	token.Token = rawToken
+
+	return token, nil
+}
+
+func (s *personalAccessTokenService) List(ctx context.Context, userId int64) ([]domain.PersonalAccessToken, error) {
+	personalAccessTokens, err := s.tokenRepo.ListActiveByUserID(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list personal access tokens")
+		return nil, domain.NewInternalServerError("failed to list personal access tokens")
+	}
+
+	return personalAccessTokens, nil
+}
+
+func (s *personalAccessTokenService) Revoke(ctx context.Context, userId int64, tokenId int64) error {
+	err := s.tokenRepo.Revoke(ctx, userId, tokenId)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return domain.NewNotFoundError("personal access token not found")
+	case err != nil:
+		log.Error().Err(err).Msg("failed to revoke personal access token")
+		return domain.NewInternalServerError("failed to revoke personal access token")
+	}
+
+	return nil
+}
+
+// Authenticate resolves a raw personal access token to the claims of its owner, restricted
+// to the scopes granted to the token.
+func (s *personalAccessTokenService) Authenticate(ctx context.Context, rawToken string) (*domain.UserClaims, error) {
+	if !strings.HasPrefix(rawToken, domain.PersonalAccessTokenPrefix) {
+		return nil, domain.NewUnauthorizedError("invalid token")
+	}
+
+	token, err := s.tokenRepo.GetByHash(ctx, tokens.Hash(rawToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid token")
+		}
+		log.Error().Err(err).Msg("failed to get personal access token")
+		return nil, domain.NewInternalServerError("failed to validate token")
+	}
+
+	if token.RevokedAt != nil {
+		return nil, domain.NewUnauthorizedError("token has been revoked")
+	}
+	if token.ExpiresAt != nil && time.Now().After(*token.ExpiresAt) {
+		return nil, domain.NewUnauthorizedError("token has expired")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid token")
+		}
+		log.Error().Err(err).Msg("failed to get personal access token owner")
+		return nil, domain.NewInternalServerError("failed to validate token")
+	}
+
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) > personalAccessTokenTouchInterval {
+		if err := s.tokenRepo.Touch(ctx, token.ID); err != nil {
+			log.Error().Err(err).Msg("failed to update personal access token last used time")
+		}
+	}
+
+	return &domain.UserClaims{
+		ID:            user.ID,
+		Username:      user.Username,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+		Role:          user.Role,
+		AccessTokenID: token.ID,
+		Scopes:        token.Scopes,
+	}, nil
+}
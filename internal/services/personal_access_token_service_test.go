@@ -0,0 +1,159 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreatePersonalAccessToken_StoresHashAndReturnsRawToken(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	createToken := &domain.CreatePersonalAccessTokenDTO{
+		Name:   "ci",
+		Scopes: []domain.Scope{domain.ScopePostsRead},
+	}
+
+	var storedHash string
+	m.patRepo.On("Create", mock.Anything, mock.MatchedBy(func(token *domain.PersonalAccessToken) bool {
+		storedHash = token.TokenHash
+		return token.UserID == 7 && token.Name == "ci"
+	})).Return(&domain.PersonalAccessToken{ID: 1, UserID: 7, Name: "ci", Scopes: createToken.Scopes}, nil)
+
+	// Act
+	token, err := personalAccessTokenService.Create(context.Background(), 7, createToken)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token.Token, domain.PersonalAccessTokenPrefix))
+	assert.Equal(t, tokens.Hash(token.Token), storedHash)
+	m.patRepo.AssertExpectations(t)
+}
+
+func TestCreatePersonalAccessToken_InvalidScope(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+
+	// Act
+	_, err := personalAccessTokenService.Create(context.Background(), 7, &domain.CreatePersonalAccessTokenDTO{
+		Name:   "ci",
+		Scopes: []domain.Scope{"admin"},
+	})
+
+	// Assert
+	assert.Error(t, err)
+	m.patRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreatePersonalAccessToken_ExpiryInThePast(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	expiresAt := time.Now().Add(-time.Hour)
+
+	// Act
+	_, err := personalAccessTokenService.Create(context.Background(), 7, &domain.CreatePersonalAccessTokenDTO{
+		Name:      "ci",
+		Scopes:    []domain.Scope{domain.ScopePostsRead},
+		ExpiresAt: &expiresAt,
+	})
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	m.patRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticatePersonalAccessToken_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	rawToken := domain.PersonalAccessTokenPrefix + "secret"
+	scopes := []domain.Scope{domain.ScopePostsRead}
+
+	m.patRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).
+		Return(&domain.PersonalAccessToken{ID: 3, UserID: 7, Scopes: scopes}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Username: "jane"}, nil)
+	m.patRepo.On("Touch", mock.Anything, int64(3)).Return(nil)
+
+	// Act
+	claims, err := personalAccessTokenService.Authenticate(context.Background(), rawToken)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), claims.ID)
+	assert.Equal(t, int64(3), claims.AccessTokenID)
+	assert.True(t, claims.HasScope(domain.ScopePostsRead))
+	assert.False(t, claims.HasScope(domain.ScopePostsWrite))
+	m.patRepo.AssertExpectations(t)
+}
+
+func TestAuthenticatePersonalAccessToken_Revoked(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	rawToken := domain.PersonalAccessTokenPrefix + "secret"
+	revokedAt := time.Now().Add(-time.Minute)
+
+	m.patRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).
+		Return(&domain.PersonalAccessToken{ID: 3, UserID: 7, RevokedAt: &revokedAt}, nil)
+
+	// Act
+	_, err := personalAccessTokenService.Authenticate(context.Background(), rawToken)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticatePersonalAccessToken_Expired(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	rawToken := domain.PersonalAccessTokenPrefix + "secret"
+	expiresAt := time.Now().Add(-time.Minute)
+
+	m.patRepo.On("GetByHash", mock.Anything, tokens.Hash(rawToken)).
+		Return(&domain.PersonalAccessToken{ID: 3, UserID: 7, ExpiresAt: &expiresAt}, nil)
+
+	// Act
+	_, err := personalAccessTokenService.Authenticate(context.Background(), rawToken)
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticatePersonalAccessToken_UnknownPrefix(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+
+	// Act
+	_, err := personalAccessTokenService.Authenticate(context.Background(), "not-a-token")
+
+	// Assert
+	assert.IsType(t, &domain.UnauthorizedError{}, err)
+	m.patRepo.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+}
+
+func TestRevokePersonalAccessToken_NotOwned(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	personalAccessTokenService := services.NewPersonalAccessTokenService(m.userRepo, m.patRepo)
+	m.patRepo.On("Revoke", mock.Anything, int64(7), int64(3)).Return(domain.ErrNotFound)
+
+	// Act
+	err := personalAccessTokenService.Revoke(context.Background(), 7, 3)
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
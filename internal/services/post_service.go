@@ -3,20 +3,27 @@ package services
 import (
 	"context"
 	"errors"
+	"slices"
 
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/hashtags"
 	"github.com/floroz/go-social/internal/interfaces"
 	"github.com/floroz/go-social/internal/validation"
 	"github.com/rs/zerolog/log"
 )
 
 type postService struct {
-	postRepo    interfaces.PostRepository
-	commentRepo interfaces.CommentRepository
+	postRepo     interfaces.PostRepository
+	commentRepo  interfaces.CommentRepository
+	blockRepo    interfaces.BlockRepository
+	reactionRepo interfaces.ReactionRepository
+	authorizer   interfaces.Authorizer
+	cursors      *cursor.Codec
 }
 
-func NewPostService(postRepo interfaces.PostRepository, commentRepo interfaces.CommentRepository) interfaces.PostService {
-	return &postService{postRepo: postRepo, commentRepo: commentRepo}
+func NewPostService(postRepo interfaces.PostRepository, commentRepo interfaces.CommentRepository, blockRepo interfaces.BlockRepository, reactionRepo interfaces.ReactionRepository, authorizer interfaces.Authorizer, cursors *cursor.Codec) interfaces.PostService {
+	return &postService{postRepo: postRepo, commentRepo: commentRepo, blockRepo: blockRepo, reactionRepo: reactionRepo, authorizer: authorizer, cursors: cursors}
 }
 
 func (s *postService) Create(ctx context.Context, userId int64, createPost *domain.CreatePostDTO) (*domain.Post, error) {
@@ -28,34 +35,92 @@ func (s *postService) Create(ctx context.Context, userId int64, createPost *doma
 		return nil, domain.NewValidationError("request", err.Error()) // Provide a placeholder field name
 	}
 
+	createPost.Tags = hashtags.Extract(createPost.Content)
+
+	if createPost.QuotedPostID != nil {
+		quoted, err := s.getShareable(ctx, userId, *createPost.QuotedPostID)
+		if err != nil {
+			return nil, err
+		}
+		createPost.QuotedPostID = &quoted.ID
+	}
+
 	post, err := s.postRepo.Create(ctx, userId, createPost)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return post, nil
+	return s.withReferencedPost(ctx, userId, post, "failed to create post")
 }
 
-func (r *postService) List(ctx context.Context, limit int, offset int) ([]domain.Post, error) {
-	if limit > 100 {
-		log.Warn().Msg("limit is too high, setting to 100")
-		limit = 100
-	} else if limit == 0 {
-		limit = 10
+func (r *postService) List(ctx context.Context, viewerId int64, after string, limit int, offset int) (*domain.PostPage, error) {
+	page, limit, err := newPageRequest(r.cursors, after, limit, offset, 10)
+	if err != nil {
+		return nil, err
 	}
 
-	posts, err := r.postRepo.List(ctx, limit, offset)
+	posts, err := r.postRepo.List(ctx, viewerId, page)
 
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list posts")
 		return nil, domain.NewInternalServerError("failed to list posts")
 	}
 
-	return posts, nil
+	posts, next := trimPage(r.cursors, posts, limit, postPosition)
+	if err := withPostReactions(ctx, r.reactionRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+	if err := withReferencedPosts(ctx, r.postRepo, r.blockRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to get referenced posts")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+
+	return &domain.PostPage{Posts: posts, NextCursor: next}, nil
+}
+
+// ListByUserID lists the posts of a user, newest first. Users blocked either way by the
+// viewer are not found.
+func (r *postService) ListByUserID(ctx context.Context, viewerId, userId int64, after string, limit int, offset int) (*domain.PostPage, error) {
+	page, limit, err := newPageRequest(r.cursors, after, limit, offset, 10)
+	if err != nil {
+		return nil, err
+	}
+
+	if viewerId != userId {
+		blocked, err := r.blockRepo.IsBlocked(ctx, viewerId, userId)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to check block")
+			return nil, domain.NewInternalServerError("failed to list posts")
+		}
+		if blocked {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+	}
+
+	posts, err := r.postRepo.ListByUserID(ctx, userId, page)
+	if err != nil {
+		log.Error().Err(err).Int64("userId", userId).Msg("failed to list posts of user")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+
+	posts, next := trimPage(r.cursors, posts, limit, postPosition)
+	if err := withPostReactions(ctx, r.reactionRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+	if err := withReferencedPosts(ctx, r.postRepo, r.blockRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to get referenced posts")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+
+	return &domain.PostPage{Posts: posts, NextCursor: next}, nil
 }
 
-func (r *postService) GetByID(ctx context.Context, postId int64) (*domain.Post, error) {
+// GetByID gets a post with its comments. Posts of users blocked either way by the viewer
+// are not found, and their comments are left out.
+func (r *postService) GetByID(ctx context.Context, viewerId, postId int64) (*domain.Post, error) {
 	// TODO: Who can request users posts? Are they all public, or users can decide whether to make them public or not?
 	post, err := r.postRepo.GetByID(ctx, postId)
 	if err != nil {
@@ -66,16 +131,39 @@ func (r *postService) GetByID(ctx context.Context, postId int64) (*domain.Post,
 		return nil, domain.NewInternalServerError("failed to get post by id")
 	}
 
+	blockedUserIds, err := r.blockRepo.ListBlockedUserIDs(ctx, viewerId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list blocked users")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+	if slices.Contains(blockedUserIds, post.UserID) {
+		return nil, domain.NewNotFoundError("post not found")
+	}
+
 	// For now offset and limit are hard-coded
-	comments, err := r.commentRepo.ListByPostID(ctx, postId, 100, 0)
+	comments, err := r.commentRepo.ListByPostID(ctx, postId, domain.PageRequest{Limit: 100})
 	if err != nil {
 		log.Error().Err(err).Msg("failed to get comments by post id")
 		return nil, domain.NewInternalServerError("failed to get comments by post id")
 	}
 
-	post.Comments = comments
+	post.Comments = withoutBlockedComments(comments, blockedUserIds)
 
-	return post, nil
+	posts := []domain.Post{*post}
+	if err := withPostReactions(ctx, r.reactionRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+	if err := withCommentReactions(ctx, r.reactionRepo, viewerId, post.Comments); err != nil {
+		log.Error().Err(err).Msg("failed to summarize comment reactions")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+	if err := withReferencedPosts(ctx, r.postRepo, r.blockRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to get referenced post")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+
+	return &posts[0], nil
 }
 
 func (r *postService) Update(ctx context.Context, userId, postId int64, updatedPost *domain.UpdatePostDTO) (*domain.Post, error) {
@@ -92,11 +180,19 @@ func (r *postService) Update(ctx context.Context, userId, postId int64, updatedP
 		log.Error().Err(err).Int64("postId", postId).Msg("Failed to get post for update check")
 		return nil, domain.NewInternalServerError("failed to check post existence")
 	}
-	if existingPost.UserID != userId {
-		return nil, domain.NewForbiddenError("not allowed to update post")
+	// Checked before authorizing, so that moderators are not recorded editing a repost
+	if existingPost.Kind == domain.PostKindRepost {
+		return nil, domain.NewBadRequestError("reposts cannot be edited")
+	}
+	resource := postResource(existingPost)
+	if err := r.authorizer.Authorize(ctx, userId, domain.ModerationActionUpdate, resource); err != nil {
+		return nil, err
 	}
 
-	post, err := r.postRepo.Update(ctx, userId, postId, updatedPost)
+	updatedPost.Tags = hashtags.Extract(updatedPost.Content)
+
+	// The post is updated on behalf of its owner, who may not be the caller when moderating.
+	post, err := r.postRepo.Update(ctx, existingPost.UserID, postId, updatedPost)
 
 	if err != nil && errors.Is(err, domain.ErrNotFound) {
 		return nil, domain.NewNotFoundError("post not found")
@@ -107,7 +203,17 @@ func (r *postService) Update(ctx context.Context, userId, postId int64, updatedP
 		return nil, domain.NewInternalServerError("failed to update post")
 	}
 
-	return post, nil
+	if err := r.authorizer.RecordOverride(ctx, userId, domain.ModerationActionUpdate, resource); err != nil {
+		return nil, err
+	}
+
+	posts := []domain.Post{*post}
+	if err := withPostReactions(ctx, r.reactionRepo, userId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to update post")
+	}
+
+	return r.withReferencedPost(ctx, userId, &posts[0], "failed to update post")
 }
 
 func (r *postService) Delete(ctx context.Context, userId, postId int64) error {
@@ -119,11 +225,14 @@ func (r *postService) Delete(ctx context.Context, userId, postId int64) error {
 	case err != nil:
 		log.Error().Err(err).Msg("failed to get post by id")
 		return domain.NewInternalServerError("failed to get post by id")
-	case post.UserID != userId:
-		return domain.NewForbiddenError("not allowed to delete post")
 	}
 
-	err = r.postRepo.Delete(ctx, userId, postId)
+	resource := postResource(post)
+	if err := r.authorizer.Authorize(ctx, userId, domain.ModerationActionDelete, resource); err != nil {
+		return err
+	}
+
+	err = r.postRepo.Delete(ctx, post.UserID, postId)
 
 	if err != nil && errors.Is(err, domain.ErrNotFound) {
 		return domain.NewNotFoundError("post not found")
@@ -134,5 +243,148 @@ func (r *postService) Delete(ctx context.Context, userId, postId int64) error {
 		return domain.NewInternalServerError("failed to delete post")
 	}
 
+	return r.authorizer.RecordOverride(ctx, userId, domain.ModerationActionDelete, resource)
+}
+
+// Repost shares a post. Reposting a repost shares the post it shares.
+func (r *postService) Repost(ctx context.Context, userId, postId int64) (*domain.Post, error) {
+	post, err := r.getShareable(ctx, userId, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	repost, err := r.postRepo.CreateRepost(ctx, userId, post.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAlreadyReposted) {
+			return nil, err
+		}
+		log.Error().Err(err).Msg("failed to repost post")
+		return nil, domain.NewInternalServerError("failed to repost post")
+	}
+
+	return r.withReferencedPost(ctx, userId, repost, "failed to repost post")
+}
+
+// Unrepost deletes the repost of a post by the user, which works after the post was deleted.
+func (r *postService) Unrepost(ctx context.Context, userId, postId int64) error {
+	if err := r.postRepo.DeleteRepost(ctx, userId, postId); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("post is not reposted")
+		}
+		log.Error().Err(err).Msg("failed to delete repost")
+		return domain.NewInternalServerError("failed to delete repost")
+	}
+
+	return nil
+}
+
+// getShareable gets the post shared when reposting or quoting a post: reposts share the post
+// they share. Posts of users blocked either way cannot be shared.
+func (r *postService) getShareable(ctx context.Context, userId, postId int64) (*domain.Post, error) {
+	post, err := r.getPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Kind == domain.PostKindRepost {
+		if post, err = r.getPost(ctx, *post.ReferencedPostID); err != nil {
+			return nil, err
+		}
+	}
+
+	if post.UserID != userId {
+		blocked, err := r.blockRepo.IsBlocked(ctx, userId, post.UserID)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to check block")
+			return nil, domain.NewInternalServerError("failed to share post")
+		}
+		if blocked {
+			return nil, domain.ErrBlocked
+		}
+	}
+
+	return post, nil
+}
+
+func (r *postService) getPost(ctx context.Context, postId int64) (*domain.Post, error) {
+	post, err := r.postRepo.GetByID(ctx, postId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("post not found")
+		}
+		log.Error().Err(err).Msg("failed to get post by id")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+
+	return post, nil
+}
+
+// withReferencedPost embeds the post shared by a single post, failing with message.
+func (r *postService) withReferencedPost(ctx context.Context, viewerId int64, post *domain.Post, message string) (*domain.Post, error) {
+	posts := []domain.Post{*post}
+	if err := withReferencedPosts(ctx, r.postRepo, r.blockRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to get referenced post")
+		return nil, domain.NewInternalServerError(message)
+	}
+
+	return &posts[0], nil
+}
+
+func postResource(post *domain.Post) *domain.ModeratedResource {
+	return &domain.ModeratedResource{
+		Type:    domain.ModerationTargetPost,
+		ID:      post.ID,
+		OwnerID: post.UserID,
+		Content: post.Content,
+	}
+}
+
+// withoutBlockedComments leaves out the comments written by the given users.
+func withoutBlockedComments(comments []domain.Comment, blockedUserIds []int64) []domain.Comment {
+	if len(blockedUserIds) == 0 {
+		return comments
+	}
+
+	return slices.DeleteFunc(comments, func(comment domain.Comment) bool {
+		return slices.Contains(blockedUserIds, comment.UserID)
+	})
+}
+
+// withReferencedPosts embeds the posts shared by reposts and quote posts. Shared posts that
+// were deleted, or whose author is blocked either way by the viewer, are left nil so that they
+// show as tombstones.
+func withReferencedPosts(ctx context.Context, postRepo interfaces.PostRepository, blockRepo interfaces.BlockRepository, viewerId int64, posts []domain.Post) error {
+	referencedIds := []int64{}
+	for _, post := range posts {
+		if post.ReferencedPostID != nil {
+			referencedIds = append(referencedIds, *post.ReferencedPostID)
+		}
+	}
+	if len(referencedIds) == 0 {
+		return nil
+	}
+
+	referenced, err := postRepo.ListByIDs(ctx, referencedIds)
+	if err != nil {
+		return err
+	}
+
+	blockedUserIds, err := blockRepo.ListBlockedUserIDs(ctx, viewerId)
+	if err != nil {
+		return err
+	}
+
+	visible := make(map[int64]*domain.Post, len(referenced))
+	for i := range referenced {
+		if !slices.Contains(blockedUserIds, referenced[i].UserID) {
+			visible[referenced[i].ID] = &referenced[i]
+		}
+	}
+
+	for i := range posts {
+		if posts[i].ReferencedPostID != nil {
+			posts[i].ReferencedPost = visible[*posts[i].ReferencedPostID]
+		}
+	}
+
 	return nil
 }
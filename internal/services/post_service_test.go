@@ -0,0 +1,290 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRepost_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	original := domain.Post{ID: 10, UserID: 2, Content: "Original", Kind: domain.PostKindPost}
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&original, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.postRepo.On("CreateRepost", mock.Anything, int64(1), int64(10)).Return(&domain.Post{ID: 11, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &original.ID}, nil)
+	m.postRepo.On("ListByIDs", mock.Anything, []int64{10}).Return([]domain.Post{original}, nil)
+	m.blockRepo.On("ListBlockedUserIDs", mock.Anything, int64(1)).Return([]int64{}, nil)
+
+	// Act
+	repost, err := postService.Repost(context.Background(), 1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PostKindRepost, repost.Kind)
+	assert.Equal(t, &original, repost.ReferencedPost)
+}
+
+func TestRepost_OfRepostSharesOriginal(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	originalId := int64(10)
+	m.postRepo.On("GetByID", mock.Anything, int64(12)).Return(&domain.Post{ID: 12, UserID: 3, Kind: domain.PostKindRepost, ReferencedPostID: &originalId}, nil)
+	m.postRepo.On("GetByID", mock.Anything, originalId).Return(&domain.Post{ID: originalId, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.postRepo.On("CreateRepost", mock.Anything, int64(1), originalId).Return(&domain.Post{ID: 13, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &originalId}, nil)
+	m.postRepo.On("ListByIDs", mock.Anything, []int64{originalId}).Return([]domain.Post{{ID: originalId, UserID: 2}}, nil)
+	m.blockRepo.On("ListBlockedUserIDs", mock.Anything, int64(1)).Return([]int64{}, nil)
+
+	// Act
+	_, err := postService.Repost(context.Background(), 1, 12)
+
+	// Assert
+	assert.NoError(t, err)
+	m.postRepo.AssertExpectations(t)
+}
+
+func TestRepost_Blocked(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(true, nil)
+
+	// Act
+	repost, err := postService.Repost(context.Background(), 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrBlocked)
+	assert.Nil(t, repost)
+	m.postRepo.AssertNotCalled(t, "CreateRepost", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRepost_AlreadyReposted(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	var nullptr *domain.Post
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.postRepo.On("CreateRepost", mock.Anything, int64(1), int64(10)).Return(nullptr, domain.ErrAlreadyReposted)
+
+	// Act
+	repost, err := postService.Repost(context.Background(), 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAlreadyReposted)
+	assert.Nil(t, repost)
+}
+
+func TestUnrepost_NotReposted(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("DeleteRepost", mock.Anything, int64(1), int64(10)).Return(domain.ErrNotFound)
+
+	// Act
+	err := postService.Unrepost(context.Background(), 1, 10)
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestCreatePost_QuoteOfDeletedPost(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	var nullptr *domain.Post
+	quotedId := int64(10)
+	m.postRepo.On("GetByID", mock.Anything, quotedId).Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	post, err := postService.Create(context.Background(), 1, &domain.CreatePostDTO{
+		EditablePostFields: domain.EditablePostFields{Content: "Look at this"},
+		QuotedPostID:       &quotedId,
+	})
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+	assert.Nil(t, post)
+	m.postRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdatePost_Repost(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	originalId := int64(10)
+	m.postRepo.On("GetByID", mock.Anything, int64(11)).Return(&domain.Post{ID: 11, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &originalId}, nil)
+
+	// Act
+	post, err := postService.Update(context.Background(), 1, 11, &domain.UpdatePostDTO{EditablePostFields: domain.EditablePostFields{Content: "Edited"}})
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	assert.Nil(t, post)
+	m.postRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdatePost_RepostByModerator(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	originalId := int64(10)
+	m.postRepo.On("GetByID", mock.Anything, int64(11)).Return(&domain.Post{ID: 11, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &originalId}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.User{ID: 2, Role: domain.RoleModerator}, nil)
+
+	// Act
+	post, err := postService.Update(context.Background(), 2, 11, &domain.UpdatePostDTO{EditablePostFields: domain.EditablePostFields{Content: "Edited"}})
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	assert.Nil(t, post)
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	m.postRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeletePost_ModeratorOverrideIsRecordedAfterDelete(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("GetByID", mock.Anything, int64(11)).Return(&domain.Post{ID: 11, UserID: 1, Content: "spam"}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.User{ID: 2, Role: domain.RoleModerator}, nil)
+	m.postRepo.On("Delete", mock.Anything, int64(1), int64(11)).Return(nil)
+	m.moderationLogRepo.On("Create", mock.Anything, mock.Anything).Return(&domain.ModerationLogEntry{ID: 1}, nil)
+
+	// Act
+	err := postService.Delete(context.Background(), 2, 11)
+
+	// Assert
+	assert.NoError(t, err)
+	m.moderationLogRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestDeletePost_FailedOverrideIsNotRecorded(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("GetByID", mock.Anything, int64(11)).Return(&domain.Post{ID: 11, UserID: 1, Content: "spam"}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(2)).Return(&domain.User{ID: 2, Role: domain.RoleModerator}, nil)
+	m.postRepo.On("Delete", mock.Anything, int64(1), int64(11)).Return(assert.AnError)
+
+	// Act
+	err := postService.Delete(context.Background(), 2, 11)
+
+	// Assert
+	assert.IsType(t, &domain.InternalServerError{}, err)
+	m.moderationLogRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestListPosts_ReferencedPostTombstones(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	deletedId, blockedId, visibleId := int64(7), int64(8), int64(9)
+	posts := []domain.Post{
+		{ID: 3, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &deletedId},
+		{ID: 2, UserID: 1, Kind: domain.PostKindQuote, ReferencedPostID: &blockedId},
+		{ID: 1, UserID: 1, Kind: domain.PostKindRepost, ReferencedPostID: &visibleId},
+	}
+	m.postRepo.On("List", mock.Anything, int64(1), domain.PageRequest{Limit: 11}).Return(posts, nil)
+	m.reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{3, 2, 1}).Return(map[int64]domain.Reactions{}, nil)
+	m.postRepo.On("ListByIDs", mock.Anything, []int64{deletedId, blockedId, visibleId}).Return([]domain.Post{
+		{ID: blockedId, UserID: 4},
+		{ID: visibleId, UserID: 5},
+	}, nil)
+	m.blockRepo.On("ListBlockedUserIDs", mock.Anything, int64(1)).Return([]int64{4}, nil)
+
+	// Act
+	page, err := postService.List(context.Background(), 1, "", 10, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, page.Posts[0].ReferencedPost)
+	assert.Nil(t, page.Posts[1].ReferencedPost)
+	assert.Equal(t, &domain.Post{ID: visibleId, UserID: 5}, page.Posts[2].ReferencedPost)
+}
+
+func TestCreatePost_ExtractsTags(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("Create", mock.Anything, int64(1), mock.MatchedBy(func(dto *domain.CreatePostDTO) bool {
+		return assert.ObjectsAreEqual([]string{"go", "日本語"}, dto.Tags)
+	})).Return(&domain.Post{ID: 1, UserID: 1, Kind: domain.PostKindPost}, nil)
+
+	// Act
+	_, err := postService.Create(context.Background(), 1, &domain.CreatePostDTO{
+		EditablePostFields: domain.EditablePostFields{Content: "#Go and #日本語, #go again"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	m.postRepo.AssertExpectations(t)
+}
+
+func TestUpdatePost_ReplacesTags(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	m.postRepo.On("GetByID", mock.Anything, int64(1)).Return(&domain.Post{ID: 1, UserID: 1, Content: "About #go", Kind: domain.PostKindPost}, nil)
+	m.postRepo.On("Update", mock.Anything, int64(1), int64(1), mock.MatchedBy(func(dto *domain.UpdatePostDTO) bool {
+		return assert.ObjectsAreEqual([]string{}, dto.Tags)
+	})).Return(&domain.Post{ID: 1, UserID: 1, Content: "No tags", Kind: domain.PostKindPost}, nil)
+	m.reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{1}).Return(map[int64]domain.Reactions{}, nil)
+
+	// Act
+	_, err := postService.Update(context.Background(), 1, 1, &domain.UpdatePostDTO{EditablePostFields: domain.EditablePostFields{Content: "No tags"}})
+
+	// Assert
+	assert.NoError(t, err)
+	m.postRepo.AssertExpectations(t)
+}
+
+func TestListPostsByUserID_NextPage(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	posts := []domain.Post{
+		{ID: 3, UserID: 2, CreatedAt: createdAt.Add(time.Minute)},
+		{ID: 2, UserID: 2, CreatedAt: createdAt},
+		{ID: 1, UserID: 2, CreatedAt: createdAt},
+	}
+	after := domain.Cursor{CreatedAt: createdAt.Add(time.Hour), ID: 4}
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.postRepo.On("ListByUserID", mock.Anything, int64(2), domain.PageRequest{After: &after, Limit: 3}).Return(posts, nil)
+	m.reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{3, 2}).Return(map[int64]domain.Reactions{}, nil)
+
+	// Act
+	page, err := postService.ListByUserID(context.Background(), 1, 2, testCursors.Encode(after), 2, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, []int64{page.Posts[0].ID, page.Posts[1].ID})
+	next, err := testCursors.Decode(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Cursor{CreatedAt: createdAt, ID: 2}, next)
+}
+
+func TestListPostsByUserID_CursorAndOffset(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	postService := services.NewPostService(m.postRepo, m.commentRepo, m.blockRepo, m.reactionRepo, services.NewAuthorizer(m.userRepo, m.moderationLogRepo), testCursors)
+	after := testCursors.Encode(domain.Cursor{CreatedAt: time.Now(), ID: 1})
+
+	// Act
+	page, err := postService.ListByUserID(context.Background(), 1, 2, after, 10, 5)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	m.postRepo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything, mock.Anything)
+}
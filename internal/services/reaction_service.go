@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+type reactionService struct {
+	reactionRepo interfaces.ReactionRepository
+	postRepo     interfaces.PostRepository
+	commentRepo  interfaces.CommentRepository
+	blockRepo    interfaces.BlockRepository
+	reactionSet  *domain.ReactionSet
+}
+
+func NewReactionService(reactionRepo interfaces.ReactionRepository, postRepo interfaces.PostRepository, commentRepo interfaces.CommentRepository, blockRepo interfaces.BlockRepository, reactionSet *domain.ReactionSet) interfaces.ReactionService {
+	return &reactionService{
+		reactionRepo: reactionRepo,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		blockRepo:    blockRepo,
+		reactionSet:  reactionSet,
+	}
+}
+
+func (s *reactionService) AddPostReaction(ctx context.Context, userId, postId int64, reactionType string) error {
+	if !s.reactionSet.Allows(reactionType) {
+		return domain.NewBadRequestError("unknown reaction type")
+	}
+
+	post, err := s.getPost(ctx, postId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkNotBlocked(ctx, userId, post.UserID); err != nil {
+		return err
+	}
+
+	return s.add(ctx, userId, domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: postId}, reactionType)
+}
+
+// RemovePostReaction does not check blocks, so that users can take back their reactions
+// after a block.
+func (s *reactionService) RemovePostReaction(ctx context.Context, userId, postId int64, reactionType string) error {
+	if _, err := s.getPost(ctx, postId); err != nil {
+		return err
+	}
+
+	return s.remove(ctx, userId, domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: postId}, reactionType)
+}
+
+func (s *reactionService) AddCommentReaction(ctx context.Context, userId, postId, commentId int64, reactionType string) error {
+	if !s.reactionSet.Allows(reactionType) {
+		return domain.NewBadRequestError("unknown reaction type")
+	}
+
+	comment, err := s.getComment(ctx, postId, commentId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkNotBlocked(ctx, userId, comment.UserID); err != nil {
+		return err
+	}
+
+	return s.add(ctx, userId, domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: commentId}, reactionType)
+}
+
+func (s *reactionService) RemoveCommentReaction(ctx context.Context, userId, postId, commentId int64, reactionType string) error {
+	if _, err := s.getComment(ctx, postId, commentId); err != nil {
+		return err
+	}
+
+	return s.remove(ctx, userId, domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: commentId}, reactionType)
+}
+
+func (s *reactionService) add(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	if err := s.reactionRepo.Add(ctx, userId, target, reactionType); err != nil {
+		log.Error().Err(err).Msg("failed to add reaction")
+		return domain.NewInternalServerError("failed to add reaction")
+	}
+
+	return nil
+}
+
+func (s *reactionService) remove(ctx context.Context, userId int64, target domain.ReactionTarget, reactionType string) error {
+	if err := s.reactionRepo.Remove(ctx, userId, target, reactionType); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewNotFoundError("reaction not found")
+		}
+		log.Error().Err(err).Msg("failed to remove reaction")
+		return domain.NewInternalServerError("failed to remove reaction")
+	}
+
+	return nil
+}
+
+func (s *reactionService) checkNotBlocked(ctx context.Context, userId, authorId int64) error {
+	if userId == authorId {
+		return nil
+	}
+
+	blocked, err := s.blockRepo.IsBlocked(ctx, userId, authorId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check block")
+		return domain.NewInternalServerError("failed to add reaction")
+	}
+	if blocked {
+		return domain.ErrBlocked
+	}
+
+	return nil
+}
+
+func (s *reactionService) getPost(ctx context.Context, postId int64) (*domain.Post, error) {
+	post, err := s.postRepo.GetByID(ctx, postId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("post not found")
+		}
+		log.Error().Err(err).Msg("failed to get post by id")
+		return nil, domain.NewInternalServerError("failed to get post by id")
+	}
+
+	return post, nil
+}
+
+// getComment gets a comment on the post, so that comments are only found under their own post.
+func (s *reactionService) getComment(ctx context.Context, postId, commentId int64) (*domain.Comment, error) {
+	comment, err := s.commentRepo.GetByID(ctx, commentId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("comment not found")
+		}
+		log.Error().Err(err).Msg("failed to get comment by id")
+		return nil, domain.NewInternalServerError("failed to get comment by id")
+	}
+	if comment.PostID != postId {
+		return nil, domain.NewNotFoundError("comment not found")
+	}
+
+	return comment, nil
+}
+
+// withPostReactions sets the reactions on the posts, with those of the viewer.
+func withPostReactions(ctx context.Context, reactionRepo interfaces.ReactionRepository, viewerId int64, posts []domain.Post) error {
+	postIds := make([]int64, len(posts))
+	for i, post := range posts {
+		postIds[i] = post.ID
+	}
+
+	summaries, err := reactionRepo.Summarize(ctx, viewerId, domain.ReactionTargetPost, postIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range posts {
+		posts[i].Reactions = summaries[posts[i].ID]
+	}
+
+	return nil
+}
+
+// withCommentReactions sets the reactions on the comments, with those of the viewer.
+func withCommentReactions(ctx context.Context, reactionRepo interfaces.ReactionRepository, viewerId int64, comments []domain.Comment) error {
+	commentIds := make([]int64, len(comments))
+	for i, comment := range comments {
+		commentIds[i] = comment.ID
+	}
+
+	summaries, err := reactionRepo.Summarize(ctx, viewerId, domain.ReactionTargetComment, commentIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range comments {
+		comments[i].Reactions = summaries[comments[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddPostReaction_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.reactionRepo.On("Add", mock.Anything, int64(1), domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 10}, "love").Return(nil)
+
+	// Act
+	err := reactionService.AddPostReaction(context.Background(), 1, 10, "love")
+
+	// Assert
+	assert.NoError(t, err)
+	m.reactionRepo.AssertExpectations(t)
+}
+
+func TestAddPostReaction_UnknownType(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+
+	// Act
+	err := reactionService.AddPostReaction(context.Background(), 1, 10, "meh")
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	m.reactionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddPostReaction_Blocked(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(true, nil)
+
+	// Act
+	err := reactionService.AddPostReaction(context.Background(), 1, 10, "like")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrBlocked)
+	m.reactionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddPostReaction_PostNotFound(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	var nullptr *domain.Post
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	err := reactionService.AddPostReaction(context.Background(), 1, 10, "like")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestAddPostReaction_OwnPost(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 1}, nil)
+	m.reactionRepo.On("Add", mock.Anything, int64(1), domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 10}, "like").Return(nil)
+
+	// Act
+	err := reactionService.AddPostReaction(context.Background(), 1, 10, "like")
+
+	// Assert
+	assert.NoError(t, err)
+	m.blockRepo.AssertNotCalled(t, "IsBlocked", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemovePostReaction_NotReacted(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.postRepo.On("GetByID", mock.Anything, int64(10)).Return(&domain.Post{ID: 10, UserID: 2}, nil)
+	m.reactionRepo.On("Remove", mock.Anything, int64(1), domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 10}, "like").Return(domain.ErrNotFound)
+
+	// Act
+	err := reactionService.RemovePostReaction(context.Background(), 1, 10, "like")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestAddCommentReaction_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.commentRepo.On("GetByID", mock.Anything, int64(20)).Return(&domain.Comment{ID: 20, PostID: 10, UserID: 2}, nil)
+	m.blockRepo.On("IsBlocked", mock.Anything, int64(1), int64(2)).Return(false, nil)
+	m.reactionRepo.On("Add", mock.Anything, int64(1), domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: 20}, "laugh").Return(nil)
+
+	// Act
+	err := reactionService.AddCommentReaction(context.Background(), 1, 10, 20, "laugh")
+
+	// Assert
+	assert.NoError(t, err)
+	m.reactionRepo.AssertExpectations(t)
+}
+
+func TestAddCommentReaction_CommentOfAnotherPost(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.commentRepo.On("GetByID", mock.Anything, int64(20)).Return(&domain.Comment{ID: 20, PostID: 11, UserID: 2}, nil)
+
+	// Act
+	err := reactionService.AddCommentReaction(context.Background(), 1, 10, 20, "laugh")
+
+	// Assert
+	assert.IsType(t, &domain.NotFoundError{}, err)
+	m.reactionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemoveCommentReaction_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	reactionService := services.NewReactionService(m.reactionRepo, m.postRepo, m.commentRepo, m.blockRepo, domain.DefaultReactionSet())
+	m.commentRepo.On("GetByID", mock.Anything, int64(20)).Return(&domain.Comment{ID: 20, PostID: 10, UserID: 2}, nil)
+	m.reactionRepo.On("Remove", mock.Anything, int64(1), domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: 20}, "laugh").Return(nil)
+
+	// Act
+	err := reactionService.RemoveCommentReaction(context.Background(), 1, 10, 20, "laugh")
+
+	// Assert
+	assert.NoError(t, err)
+	m.blockRepo.AssertNotCalled(t, "IsBlocked", mock.Anything, mock.Anything, mock.Anything)
+	m.reactionRepo.AssertExpectations(t)
+}
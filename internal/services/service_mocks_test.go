@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/mocks"
+)
+
+// serviceMocks holds a mock of every dependency of the services. Tests build the service
+// under test from the mocks they need and set their expectations on those.
+type serviceMocks struct {
+	userRepo                  *mocks.MockedUserRepository
+	userTokenRepo             *mocks.MockedUserTokenRepository
+	sessionRepo               *mocks.MockedSessionRepository
+	refreshTokenRepo          *mocks.MockedRefreshTokenRepository
+	patRepo                   *mocks.MockedPersonalAccessTokenRepository
+	ipLoginFailureRepo        *mocks.MockedIPLoginFailureRepository
+	impersonationRepo         *mocks.MockedImpersonationSessionRepository
+	impersonationAuditLogRepo *mocks.MockedImpersonationAuditLogRepository
+	moderationLogRepo         *mocks.MockedModerationLogRepository
+	magicLinkRequestRepo      *mocks.MockedMagicLinkRequestRepository
+	totpRepo                  *mocks.MockedTOTPRepository
+	recoveryCodeRepo          *mocks.MockedRecoveryCodeRepository
+	identityRepo              *mocks.MockedUserIdentityRepository
+	oidcStateRepo             *mocks.MockedOIDCLoginStateRepository
+	oidcProvider              *mocks.MockedOIDCProvider
+	postRepo                  *mocks.MockedPostRepository
+	commentRepo               *mocks.MockedCommentRepository
+	reactionRepo              *mocks.MockedReactionRepository
+	tagRepo                   *mocks.MockedTagRepository
+	followRepo                *mocks.MockedFollowRepository
+	blockRepo                 *mocks.MockedBlockRepository
+	muteRepo                  *mocks.MockedMuteRepository
+	mailer                    *mocks.MockedMailer
+	blobStore                 *mocks.MockedBlobStore
+}
+
+func newServiceMocks() *serviceMocks {
+	return &serviceMocks{
+		userRepo:                  new(mocks.MockedUserRepository),
+		userTokenRepo:             new(mocks.MockedUserTokenRepository),
+		sessionRepo:               new(mocks.MockedSessionRepository),
+		refreshTokenRepo:          new(mocks.MockedRefreshTokenRepository),
+		patRepo:                   new(mocks.MockedPersonalAccessTokenRepository),
+		ipLoginFailureRepo:        new(mocks.MockedIPLoginFailureRepository),
+		impersonationRepo:         new(mocks.MockedImpersonationSessionRepository),
+		impersonationAuditLogRepo: new(mocks.MockedImpersonationAuditLogRepository),
+		moderationLogRepo:         new(mocks.MockedModerationLogRepository),
+		magicLinkRequestRepo:      new(mocks.MockedMagicLinkRequestRepository),
+		totpRepo:                  new(mocks.MockedTOTPRepository),
+		recoveryCodeRepo:          new(mocks.MockedRecoveryCodeRepository),
+		identityRepo:              new(mocks.MockedUserIdentityRepository),
+		oidcStateRepo:             new(mocks.MockedOIDCLoginStateRepository),
+		oidcProvider:              new(mocks.MockedOIDCProvider),
+		postRepo:                  new(mocks.MockedPostRepository),
+		commentRepo:               new(mocks.MockedCommentRepository),
+		reactionRepo:              new(mocks.MockedReactionRepository),
+		tagRepo:                   new(mocks.MockedTagRepository),
+		followRepo:                new(mocks.MockedFollowRepository),
+		blockRepo:                 new(mocks.MockedBlockRepository),
+		muteRepo:                  new(mocks.MockedMuteRepository),
+		mailer:                    new(mocks.MockedMailer),
+		blobStore:                 new(mocks.MockedBlobStore),
+	}
+}
+
+// newTestKeyring returns a keyring with a signing key of its own.
+func newTestKeyring(t *testing.T) *jwtkeys.Keyring {
+	keyring, err := jwtkeys.NewEphemeralKeyring()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keyring
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/floroz/go-social/internal/cursor"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/hashtags"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/rs/zerolog/log"
+)
+
+type tagService struct {
+	tagRepo      interfaces.TagRepository
+	postRepo     interfaces.PostRepository
+	blockRepo    interfaces.BlockRepository
+	reactionRepo interfaces.ReactionRepository
+	cursors      *cursor.Codec
+	tagPolicy    *domain.TagPolicy
+}
+
+func NewTagService(tagRepo interfaces.TagRepository, postRepo interfaces.PostRepository, blockRepo interfaces.BlockRepository, reactionRepo interfaces.ReactionRepository, cursors *cursor.Codec, tagPolicy *domain.TagPolicy) interfaces.TagService {
+	return &tagService{tagRepo: tagRepo, postRepo: postRepo, blockRepo: blockRepo, reactionRepo: reactionRepo, cursors: cursors, tagPolicy: tagPolicy}
+}
+
+func (s *tagService) ListPosts(ctx context.Context, viewerId int64, tag string, after string, limit int) (*domain.PostPage, error) {
+	tag, ok := hashtags.Normalize(tag)
+	if !ok {
+		return nil, domain.NewBadRequestError("invalid tag")
+	}
+
+	page, limit, err := newPageRequest(s.cursors, after, limit, 0, 20)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := s.tagRepo.ListPosts(ctx, viewerId, tag, page)
+	if err != nil {
+		log.Error().Err(err).Str("tag", tag).Msg("failed to list posts by tag")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+
+	posts, next := trimPage(s.cursors, posts, limit, postPosition)
+	if err := withPostReactions(ctx, s.reactionRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to summarize post reactions")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+	if err := withReferencedPosts(ctx, s.postRepo, s.blockRepo, viewerId, posts); err != nil {
+		log.Error().Err(err).Msg("failed to get referenced posts")
+		return nil, domain.NewInternalServerError("failed to list posts")
+	}
+
+	return &domain.PostPage{Posts: posts, NextCursor: next}, nil
+}
+
+func (s *tagService) Trending(ctx context.Context, limit int) ([]domain.TrendingTag, error) {
+	if limit > 100 {
+		limit = 100
+	} else if limit <= 0 {
+		limit = 10
+	}
+
+	tags, err := s.tagRepo.ListTrending(ctx, time.Now().Add(-s.tagPolicy.TrendingWindow), limit)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list trending tags")
+		return nil, domain.NewInternalServerError("failed to list trending tags")
+	}
+
+	return tags, nil
+}
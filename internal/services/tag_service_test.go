@@ -0,0 +1,79 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListTagPosts_NormalizesTag(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	tagService := services.NewTagService(m.tagRepo, m.postRepo, m.blockRepo, m.reactionRepo, testCursors, domain.DefaultTagPolicy())
+	m.tagRepo.On("ListPosts", mock.Anything, int64(1), "golang", domain.PageRequest{Limit: 21}).Return([]domain.Post{{ID: 2}, {ID: 1}}, nil)
+	m.reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{2, 1}).Return(map[int64]domain.Reactions{}, nil)
+
+	// Act
+	page, err := tagService.ListPosts(context.Background(), 1, "#GoLang", "", 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, page.Posts, 2)
+	assert.Empty(t, page.NextCursor)
+	m.tagRepo.AssertExpectations(t)
+}
+
+func TestListTagPosts_NextCursor(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	tagService := services.NewTagService(m.tagRepo, m.postRepo, m.blockRepo, m.reactionRepo, testCursors, domain.DefaultTagPolicy())
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m.tagRepo.On("ListPosts", mock.Anything, int64(1), "go", domain.PageRequest{Limit: 2}).Return([]domain.Post{{ID: 2, CreatedAt: createdAt}, {ID: 1}}, nil)
+	m.reactionRepo.On("Summarize", mock.Anything, int64(1), domain.ReactionTargetPost, []int64{2}).Return(map[int64]domain.Reactions{}, nil)
+
+	// Act
+	page, err := tagService.ListPosts(context.Background(), 1, "go", "", 1)
+
+	// Assert
+	assert.NoError(t, err)
+	next, err := testCursors.Decode(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Cursor{CreatedAt: createdAt, ID: 2}, next)
+}
+
+func TestListTagPosts_InvalidTag(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	tagService := services.NewTagService(m.tagRepo, m.postRepo, m.blockRepo, m.reactionRepo, testCursors, domain.DefaultTagPolicy())
+
+	// Act
+	page, err := tagService.ListPosts(context.Background(), 1, "not a tag", "", 0)
+
+	// Assert
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	assert.Nil(t, page)
+	m.tagRepo.AssertNotCalled(t, "ListPosts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTrendingTags_SlidingWindow(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	tagService := services.NewTagService(m.tagRepo, m.postRepo, m.blockRepo, m.reactionRepo, testCursors, domain.DefaultTagPolicy())
+	trending := []domain.TrendingTag{{Tag: "go", PostCount: 3}, {Tag: "rust", PostCount: 1}}
+	windowStart := mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since).Round(time.Minute) == 24*time.Hour
+	})
+	m.tagRepo.On("ListTrending", mock.Anything, windowStart, 100).Return(trending, nil)
+
+	// Act
+	tags, err := tagService.Trending(context.Background(), 1000)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, trending, tags)
+}
@@ -0,0 +1,389 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/totp"
+	"github.com/floroz/go-social/internal/validation"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	mfaChallengeTTL   = 5 * time.Minute
+	recoveryCodeCount = 10
+	// recoveryCodeLength is the number of base32 characters of a recovery code (50 bits).
+	recoveryCodeLength = 10
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type twoFactorService struct {
+	userRepo         interfaces.UserRepository
+	totpRepo         interfaces.TOTPRepository
+	recoveryCodeRepo interfaces.RecoveryCodeRepository
+	userTokenRepo    interfaces.UserTokenRepository
+	throttlePolicy   *domain.LoginThrottlePolicy
+	issuer           string
+}
+
+func NewTwoFactorService(
+	userRepo interfaces.UserRepository,
+	totpRepo interfaces.TOTPRepository,
+	recoveryCodeRepo interfaces.RecoveryCodeRepository,
+	userTokenRepo interfaces.UserTokenRepository,
+	throttlePolicy *domain.LoginThrottlePolicy,
+	issuer string,
+) interfaces.TwoFactorService {
+	return &twoFactorService{
+		userRepo:         userRepo,
+		totpRepo:         totpRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		userTokenRepo:    userTokenRepo,
+		throttlePolicy:   throttlePolicy,
+		issuer:           issuer,
+	}
+}
+
+func (s *twoFactorService) GetStatus(ctx context.Context, userId int64) (*domain.TwoFactorStatus, error) {
+	enabled, err := s.IsEnabled(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &domain.TwoFactorStatus{Enabled: enabled}
+	if !enabled {
+		return status, nil
+	}
+
+	status.RecoveryCodesRemaining, err = s.recoveryCodeRepo.CountUnused(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count recovery codes")
+		return nil, domain.NewInternalServerError("failed to get two-factor status")
+	}
+
+	return status, nil
+}
+
+func (s *twoFactorService) IsEnabled(ctx context.Context, userId int64) (bool, error) {
+	userTOTP, err := s.totpRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, nil
+		}
+		log.Error().Err(err).Msg("failed to get totp")
+		return false, domain.NewInternalServerError("failed to get two-factor status")
+	}
+
+	return userTOTP.Enabled(), nil
+}
+
+// Enroll generates a new secret for the user. The second factor stays disabled until
+// ConfirmEnrollment proves that the authenticator app produces valid codes.
+func (s *twoFactorService) Enroll(ctx context.Context, userId int64) (*domain.TOTPEnrollment, error) {
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate totp secret")
+		return nil, domain.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+
+	if _, err := s.totpRepo.UpsertPending(ctx, userId, secret); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewBadRequestError("two-factor authentication is already enabled")
+		}
+		log.Error().Err(err).Msg("failed to store totp secret")
+		return nil, domain.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+
+	return &domain.TOTPEnrollment{
+		Secret:     secret,
+		OTPAuthURI: totp.KeyURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables the second factor once the user proves it works, and returns
+// the recovery codes. They are only ever shown here and on regeneration.
+func (s *twoFactorService) ConfirmEnrollment(ctx context.Context, userId int64, confirm *domain.ConfirmTOTPDTO) ([]string, error) {
+	if err := validation.Validate.Struct(confirm); err != nil {
+		return nil, err
+	}
+
+	userTOTP, err := s.totpRepo.GetByUserID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewBadRequestError("two-factor enrollment has not been started")
+		}
+		log.Error().Err(err).Msg("failed to get totp")
+		return nil, domain.NewInternalServerError("failed to confirm two-factor authentication")
+	}
+
+	if userTOTP.Enabled() {
+		return nil, domain.NewBadRequestError("two-factor authentication is already enabled")
+	}
+
+	step, ok := totp.Validate(userTOTP.Secret, confirm.Code, time.Now())
+	if !ok {
+		return nil, domain.NewBadRequestError("invalid code")
+	}
+
+	if err := s.totpRepo.Confirm(ctx, userId, step); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewBadRequestError("two-factor authentication is already enabled")
+		}
+		log.Error().Err(err).Msg("failed to confirm totp")
+		return nil, domain.NewInternalServerError("failed to confirm two-factor authentication")
+	}
+
+	return s.replaceRecoveryCodes(ctx, userId)
+}
+
+// Disable turns the second factor off after the user re-enters their password.
+func (s *twoFactorService) Disable(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) error {
+	if err := s.reauthenticate(ctx, userId, confirmation); err != nil {
+		return err
+	}
+
+	if err := s.totpRepo.Delete(ctx, userId); err != nil {
+		log.Error().Err(err).Msg("failed to delete totp")
+		return domain.NewInternalServerError("failed to disable two-factor authentication")
+	}
+
+	if err := s.recoveryCodeRepo.DeleteAll(ctx, userId); err != nil {
+		log.Error().Err(err).Msg("failed to delete recovery codes")
+		return domain.NewInternalServerError("failed to disable two-factor authentication")
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes invalidates the remaining recovery codes and issues a new set,
+// after the user re-enters their password.
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) ([]string, error) {
+	if err := s.reauthenticate(ctx, userId, confirmation); err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, userId)
+}
+
+// StartChallenge issues the short-lived token a login with valid credentials receives
+// instead of a session when the second factor is enabled.
+func (s *twoFactorService) StartChallenge(ctx context.Context, userId int64) (*domain.MFAChallenge, error) {
+	rawToken, err := issueUserToken(ctx, s.userTokenRepo, userId, domain.TokenPurposeMFAChallenge, mfaChallengeTTL)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to issue mfa challenge")
+		return nil, domain.NewInternalServerError("failed to login")
+	}
+
+	return &domain.MFAChallenge{
+		Token:     rawToken,
+		ExpiresAt: time.Now().Add(mfaChallengeTTL),
+	}, nil
+}
+
+// VerifyChallenge completes a login with a TOTP code or a recovery code. Wrong codes count
+// towards the account lockout of the login throttle policy.
+func (s *twoFactorService) VerifyChallenge(ctx context.Context, verify *domain.VerifyMFAChallengeDTO) (*domain.User, error) {
+	if err := validation.Validate.Struct(verify); err != nil {
+		return nil, err
+	}
+
+	challengeHash := tokens.Hash(verify.ChallengeToken)
+	challenge, err := s.userTokenRepo.GetActive(ctx, domain.TokenPurposeMFAChallenge, challengeHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired challenge")
+		}
+		log.Error().Err(err).Msg("failed to get mfa challenge")
+		return nil, domain.NewInternalServerError("failed to verify code")
+	}
+
+	user, err := s.getUnlockedUser(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userTOTP, err := s.totpRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired challenge")
+		}
+		log.Error().Err(err).Msg("failed to get totp")
+		return nil, domain.NewInternalServerError("failed to verify code")
+	}
+
+	if err := s.verifyCode(ctx, userTOTP, verify.Code); err != nil {
+		return nil, err
+	}
+
+	// The challenge is spent only once the code is accepted, so a typo does not force a new login.
+	if _, err := s.userTokenRepo.Consume(ctx, domain.TokenPurposeMFAChallenge, challengeHash); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("invalid or expired challenge")
+		}
+		log.Error().Err(err).Msg("failed to consume mfa challenge")
+		return nil, domain.NewInternalServerError("failed to verify code")
+	}
+
+	return user, nil
+}
+
+// verifyCode accepts a TOTP code that was not used before, or an unused recovery code.
+func (s *twoFactorService) verifyCode(ctx context.Context, userTOTP *domain.UserTOTP, code string) error {
+	if step, ok := totp.Validate(userTOTP.Secret, code, time.Now()); ok {
+		err := s.totpRepo.UseStep(ctx, userTOTP.UserID, step)
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, domain.ErrNotFound):
+			log.Warn().Int64("userId", userTOTP.UserID).Msg("totp code replayed")
+			return s.recordFailure(ctx, userTOTP.UserID, domain.NewUnauthorizedError("invalid code"))
+		default:
+			log.Error().Err(err).Msg("failed to record totp step")
+			return domain.NewInternalServerError("failed to verify code")
+		}
+	}
+
+	err := s.recoveryCodeRepo.Consume(ctx, userTOTP.UserID, tokens.Hash(normalizeRecoveryCode(code)))
+	switch {
+	case err == nil:
+		if err := s.totpRepo.ResetFailedAttempts(ctx, userTOTP.UserID); err != nil {
+			log.Error().Err(err).Msg("failed to reset failed totp attempts")
+		}
+		return nil
+	case errors.Is(err, domain.ErrNotFound):
+		return s.recordFailure(ctx, userTOTP.UserID, domain.NewUnauthorizedError("invalid code"))
+	default:
+		log.Error().Err(err).Msg("failed to consume recovery code")
+		return domain.NewInternalServerError("failed to verify code")
+	}
+}
+
+// reauthenticate checks the password of a user with the second factor enabled before a
+// sensitive change. Wrong passwords count like wrong codes.
+func (s *twoFactorService) reauthenticate(ctx context.Context, userId int64, confirmation *domain.PasswordConfirmationDTO) error {
+	if err := validation.Validate.Struct(confirmation); err != nil {
+		return err
+	}
+
+	enabled, err := s.IsEnabled(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return domain.NewBadRequestError("two-factor authentication is not enabled")
+	}
+
+	user, err := s.getUnlockedUser(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasPassword() {
+		return errNoPassword
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(confirmation.Password)); err != nil {
+		return s.recordFailure(ctx, userId, domain.NewForbiddenError("invalid password"))
+	}
+
+	return nil
+}
+
+func (s *twoFactorService) getUnlockedUser(ctx context.Context, userId int64) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewUnauthorizedError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user")
+		return nil, domain.NewInternalServerError("failed to get user")
+	}
+
+	if user.LockedUntil != nil && time.Now().Before(*user.LockedUntil) {
+		return nil, domain.NewAccountLockedError("account temporarily locked after too many failed attempts", time.Until(*user.LockedUntil))
+	}
+
+	return user, nil
+}
+
+// recordFailure counts a failed second factor attempt and locks the account once the login
+// throttle threshold is reached. The counter is kept apart from failed password attempts,
+// which a successful password check resets, so that guessing codes cannot be interleaved
+// with logins to dodge the lock.
+func (s *twoFactorService) recordFailure(ctx context.Context, userId int64, failureErr error) error {
+	attempts, err := s.totpRepo.IncrementFailedAttempts(ctx, userId)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to record failed totp attempt")
+		return failureErr
+	}
+
+	lockout := s.throttlePolicy.LockoutFor(attempts, s.throttlePolicy.MaxAccountFailures)
+	if lockout == 0 {
+		return failureErr
+	}
+
+	log.Warn().Int64("userId", userId).Int("attempts", attempts).Dur("lockout", lockout).Msg("locking account after failed second factor attempts")
+	if err := s.userRepo.LockUntil(ctx, userId, time.Now().Add(lockout)); err != nil {
+		log.Error().Err(err).Msg("failed to lock account")
+		return failureErr
+	}
+
+	return domain.NewAccountLockedError("account temporarily locked after too many failed attempts", lockout)
+}
+
+// replaceRecoveryCodes generates a new set of recovery codes and stores their hashes.
+func (s *twoFactorService) replaceRecoveryCodes(ctx context.Context, userId int64) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			log.Error().Err(err).Msg("failed to generate recovery code")
+			return nil, domain.NewInternalServerError("failed to generate recovery codes")
+		}
+		codes[i] = code
+		hashes[i] = tokens.Hash(normalizeRecoveryCode(code))
+	}
+
+	if err := s.recoveryCodeRepo.ReplaceAll(ctx, userId, hashes); err != nil {
+		log.Error().Err(err).Msg("failed to store recovery codes")
+		return nil, domain.NewInternalServerError("failed to generate recovery codes")
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode returns a code formatted for readability, e.g. "abcde-fgh23".
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength*5/8+1)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(buf))[:recoveryCodeLength]
+
+	return code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:], nil
+}
+
+// normalizeRecoveryCode accepts codes typed without the dash or in another case.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
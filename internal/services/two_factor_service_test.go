@@ -0,0 +1,237 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/services"
+	"github.com/floroz/go-social/internal/tokens"
+	"github.com/floroz/go-social/internal/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// enabledTOTP returns a confirmed second factor and its current code.
+func enabledTOTP(t *testing.T, userId int64) (*domain.UserTOTP, string) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	code, err := totp.GenerateCode(secret, totp.Step(time.Now()))
+	assert.NoError(t, err)
+	confirmedAt := time.Now()
+	return &domain.UserTOTP{UserID: userId, Secret: secret, ConfirmedAt: &confirmedAt}, code
+}
+
+func TestEnroll_AlreadyEnabled(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Email: "jane@example.com"}, nil)
+	m.totpRepo.On("UpsertPending", mock.Anything, int64(7), mock.AnythingOfType("string")).Return((*domain.UserTOTP)(nil), domain.ErrNotFound)
+
+	// Act
+	enrollment, err := twoFactorService.Enroll(context.Background(), 7)
+
+	// Assert
+	assert.Nil(t, enrollment)
+	var badRequestErr *domain.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestConfirmEnrollment_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	pending, code := enabledTOTP(t, 7)
+	pending.ConfirmedAt = nil
+
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(pending, nil)
+	m.totpRepo.On("Confirm", mock.Anything, int64(7), mock.AnythingOfType("int64")).Return(nil)
+	m.recoveryCodeRepo.On("ReplaceAll", mock.Anything, int64(7), mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == 10
+	})).Return(nil)
+
+	// Act
+	codes, err := twoFactorService.ConfirmEnrollment(context.Background(), 7, &domain.ConfirmTOTPDTO{Code: code})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+	assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+	m.totpRepo.AssertExpectations(t)
+	m.recoveryCodeRepo.AssertExpectations(t)
+}
+
+func TestConfirmEnrollment_InvalidCode(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	pending, code := enabledTOTP(t, 7)
+	pending.ConfirmedAt = nil
+	wrongCode := "000000"
+	if code == wrongCode {
+		wrongCode = "111111"
+	}
+
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(pending, nil)
+
+	// Act
+	codes, err := twoFactorService.ConfirmEnrollment(context.Background(), 7, &domain.ConfirmTOTPDTO{Code: wrongCode})
+
+	// Assert
+	assert.Nil(t, codes)
+	var badRequestErr *domain.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+	m.totpRepo.AssertNotCalled(t, "Confirm", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVerifyChallenge_TOTPCode(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, code := enabledTOTP(t, 7)
+	challengeHash := tokens.Hash("challenge")
+
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	m.totpRepo.On("UseStep", mock.Anything, int64(7), mock.AnythingOfType("int64")).Return(nil)
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+
+	// Act
+	user, err := twoFactorService.VerifyChallenge(context.Background(), &domain.VerifyMFAChallengeDTO{ChallengeToken: "challenge", Code: code})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), user.ID)
+	m.userTokenRepo.AssertExpectations(t)
+}
+
+func TestVerifyChallenge_ReplayedCode(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, code := enabledTOTP(t, 7)
+	challengeHash := tokens.Hash("challenge")
+
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	m.totpRepo.On("UseStep", mock.Anything, int64(7), mock.AnythingOfType("int64")).Return(domain.ErrNotFound)
+	m.totpRepo.On("IncrementFailedAttempts", mock.Anything, int64(7)).Return(1, nil)
+
+	// Act
+	_, err := twoFactorService.VerifyChallenge(context.Background(), &domain.VerifyMFAChallengeDTO{ChallengeToken: "challenge", Code: code})
+
+	// Assert
+	var unauthorizedErr *domain.UnauthorizedError
+	assert.ErrorAs(t, err, &unauthorizedErr)
+	m.userTokenRepo.AssertNotCalled(t, "Consume", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVerifyChallenge_RecoveryCode(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, _ := enabledTOTP(t, 7)
+	challengeHash := tokens.Hash("challenge")
+
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	// Recovery codes are accepted whatever their case and with or without the dash.
+	m.recoveryCodeRepo.On("Consume", mock.Anything, int64(7), tokens.Hash("abcdefgh23")).Return(nil)
+	m.totpRepo.On("ResetFailedAttempts", mock.Anything, int64(7)).Return(nil)
+	m.userTokenRepo.On("Consume", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+
+	// Act
+	user, err := twoFactorService.VerifyChallenge(context.Background(), &domain.VerifyMFAChallengeDTO{ChallengeToken: "challenge", Code: "ABCDE-FGH23"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), user.ID)
+	m.recoveryCodeRepo.AssertExpectations(t)
+}
+
+func TestVerifyChallenge_LocksAccountAtThreshold(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, _ := enabledTOTP(t, 7)
+	challengeHash := tokens.Hash("challenge")
+	policy := domain.DefaultLoginThrottlePolicy()
+
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeMFAChallenge, challengeHash).Return(&domain.UserToken{UserID: 7}, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7}, nil)
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	m.recoveryCodeRepo.On("Consume", mock.Anything, int64(7), mock.AnythingOfType("string")).Return(domain.ErrNotFound)
+	m.totpRepo.On("IncrementFailedAttempts", mock.Anything, int64(7)).Return(policy.MaxAccountFailures, nil)
+	m.userRepo.On("LockUntil", mock.Anything, int64(7), mock.AnythingOfType("time.Time")).Return(nil)
+
+	// Act
+	_, err := twoFactorService.VerifyChallenge(context.Background(), &domain.VerifyMFAChallengeDTO{ChallengeToken: "challenge", Code: "not-a-valid-code"})
+
+	// Assert
+	var lockedErr *domain.AccountLockedError
+	assert.ErrorAs(t, err, &lockedErr)
+	m.userRepo.AssertExpectations(t)
+}
+
+func TestVerifyChallenge_ExpiredChallenge(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	m.userTokenRepo.On("GetActive", mock.Anything, domain.TokenPurposeMFAChallenge, tokens.Hash("expired")).Return((*domain.UserToken)(nil), domain.ErrNotFound)
+
+	// Act
+	_, err := twoFactorService.VerifyChallenge(context.Background(), &domain.VerifyMFAChallengeDTO{ChallengeToken: "expired", Code: "123456"})
+
+	// Assert
+	var unauthorizedErr *domain.UnauthorizedError
+	assert.ErrorAs(t, err, &unauthorizedErr)
+}
+
+func TestDisable_WrongPassword(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, _ := enabledTOTP(t, 7)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Password: string(hashedPassword)}, nil)
+	m.totpRepo.On("IncrementFailedAttempts", mock.Anything, int64(7)).Return(1, nil)
+
+	// Act
+	err = twoFactorService.Disable(context.Background(), 7, &domain.PasswordConfirmationDTO{Password: "wrongpassword"})
+
+	// Assert
+	var forbiddenErr *domain.ForbiddenError
+	assert.ErrorAs(t, err, &forbiddenErr)
+	m.totpRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestDisable_Success(t *testing.T) {
+	// Arrange
+	m := newServiceMocks()
+	twoFactorService := services.NewTwoFactorService(m.userRepo, m.totpRepo, m.recoveryCodeRepo, m.userTokenRepo, domain.DefaultLoginThrottlePolicy(), "GoSocial")
+	userTOTP, _ := enabledTOTP(t, 7)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	m.totpRepo.On("GetByUserID", mock.Anything, int64(7)).Return(userTOTP, nil)
+	m.userRepo.On("GetByID", mock.Anything, int64(7)).Return(&domain.User{ID: 7, Password: string(hashedPassword)}, nil)
+	m.totpRepo.On("Delete", mock.Anything, int64(7)).Return(nil)
+	m.recoveryCodeRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+
+	// Act
+	err = twoFactorService.Disable(context.Background(), 7, &domain.PasswordConfirmationDTO{Password: "password123"})
+
+	// Assert
+	assert.NoError(t, err)
+	m.totpRepo.AssertExpectations(t)
+	m.recoveryCodeRepo.AssertExpectations(t)
+}
@@ -6,6 +6,7 @@ import (
 
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/interfaces"
 	"github.com/floroz/go-social/internal/validation"
@@ -13,12 +14,16 @@ import (
 )
 
 type userService struct {
-	userRepo interfaces.UserRepository
+	userRepo  interfaces.UserRepository
+	blockRepo interfaces.BlockRepository
+	cursors   *cursor.Codec
 }
 
-func NewUserService(userRepo interfaces.UserRepository) interfaces.UserService {
+func NewUserService(userRepo interfaces.UserRepository, blockRepo interfaces.BlockRepository, cursors *cursor.Codec) interfaces.UserService {
 	return &userService{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+		cursors:   cursors,
 	}
 }
 
@@ -70,6 +75,32 @@ func (s *userService) GetByID(ctx context.Context, userId int64) (*domain.User,
 	return user, nil
 }
 
+// GetProfile returns the public profile of the user with the username, as seen by the viewer.
+// Users blocked either way by the viewer are not found.
+func (s *userService) GetProfile(ctx context.Context, viewerId int64, username string) (*domain.PublicProfile, error) {
+	profile, err := s.userRepo.GetProfileByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		log.Error().Err(err).Msg("failed to get user profile")
+		return nil, domain.NewInternalServerError("failed to get user profile")
+	}
+
+	if profile.ID != viewerId {
+		blocked, err := s.blockRepo.IsBlocked(ctx, viewerId, profile.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to check block")
+			return nil, domain.NewInternalServerError("failed to get user profile")
+		}
+		if blocked {
+			return nil, domain.NewNotFoundError("user not found")
+		}
+	}
+
+	return profile, nil
+}
+
 func (s *userService) Update(ctx context.Context, userId int64, updateUser *domain.UpdateUserDTO) (*domain.User, error) {
 	if err := validation.Validate.Struct(updateUser); err != nil {
 		return nil, domain.NewBadRequestError(err.Error())
@@ -116,14 +147,20 @@ func (s *userService) Delete(ctx context.Context, userId int64) error {
 	return nil
 }
 
-func (s *userService) List(ctx context.Context, limit, offset int) ([]domain.User, error) {
-	users, err := s.userRepo.List(ctx, limit, offset)
+func (s *userService) List(ctx context.Context, after string, limit, offset int) (*domain.UserPage, error) {
+	page, limit, err := newPageRequest(s.cursors, after, limit, offset, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.List(ctx, page)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list users")
 		return nil, domain.NewInternalServerError("failed to list users")
 	}
 
-	return users, nil
+	users, next := trimPage(s.cursors, users, limit, userPosition)
+	return &domain.UserPage{Users: users, NextCursor: next}, nil
 }
 
 func (s *userService) UpdateLastLogin(ctx context.Context, userId int64) error {
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/mocks"
 	"github.com/floroz/go-social/internal/services"
@@ -14,6 +16,8 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+var testCursors = cursor.NewCodec([]byte("test-cursor-key"))
+
 func TestCreateUser_ExistingEmail(t *testing.T) {
 	// Arrange
 	createUserDTO := &domain.CreateUserDTO{
@@ -27,7 +31,7 @@ func TestCreateUser_ExistingEmail(t *testing.T) {
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
 	mockUserRepo.On("GetByEmail", context.Background(), createUserDTO.Email).Return(&domain.User{}, nil) // Simulate existing email
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	// Act
 	_, err := userService.Create(context.Background(), createUserDTO)
@@ -53,7 +57,7 @@ func TestCreateUser_ExistingUsername(t *testing.T) {
 		Password: "password",
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	var existingUserWithEmail *domain.User
 	mockUserRepo.On("GetByEmail", mock.Anything, createUserDTO.Email).Return(existingUserWithEmail, domain.ErrNotFound) // Simulate email not found
@@ -83,7 +87,7 @@ func TestCreateUser_ErrorCreating(t *testing.T) {
 		Password: "password",
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	var nullptr *domain.User
 
@@ -117,7 +121,7 @@ func TestCreateUser_Success(t *testing.T) {
 		Password: originalPassword,
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	var existingUser *domain.User
 	mockUserRepo.On("GetByEmail", mock.Anything, createUserDTO.Email).Return(existingUser, domain.ErrNotFound)
@@ -157,15 +161,12 @@ func TestCreateUser_Success(t *testing.T) {
 func TestUpdateUser_NotExisting(t *testing.T) {
 	// Arrange
 	updateUserDTO := &domain.UpdateUserDTO{
-		EditableUserField: domain.EditableUserField{
-			FirstName: "Test",
-			LastName:  "User",
-			Email:     "test@test.com",
-			Username:  "test",
-		},
+		FirstName: "Test",
+		LastName:  "User",
+		Username:  "test",
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	const userId int64 = 1
 
@@ -187,15 +188,12 @@ func TestUpdateUser_NotExisting(t *testing.T) {
 func TestUpdateUser_Success(t *testing.T) {
 	// Arrange
 	updateUserDTO := &domain.UpdateUserDTO{
-		EditableUserField: domain.EditableUserField{
-			FirstName: "UpdatedFirst",
-			LastName:  "UpdatedLast",
-			Email:     "update@test.com",
-			Username:  "updateduser",
-		},
+		FirstName: "UpdatedFirst",
+		LastName:  "UpdatedLast",
+		Username:  "updateduser",
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	const userId int64 = 1
 	existingUser := &domain.User{ // Simulate the user fetched by GetByID
@@ -213,7 +211,7 @@ func TestUpdateUser_Success(t *testing.T) {
 		ID:        userId,
 		FirstName: updateUserDTO.FirstName,
 		LastName:  updateUserDTO.LastName,
-		Email:     updateUserDTO.Email,
+		Email:     existingUser.Email,
 		Username:  updateUserDTO.Username,
 	}, nil)
 
@@ -231,7 +229,7 @@ func TestUpdateUser_Success(t *testing.T) {
 	assert.NotNil(t, user)
 	assert.Equal(t, updateUserDTO.FirstName, user.FirstName)
 	assert.Equal(t, updateUserDTO.LastName, user.LastName)
-	assert.Equal(t, updateUserDTO.Email, user.Email)
+	assert.Equal(t, existingUser.Email, user.Email, "the email is not changed by a profile update")
 	assert.Equal(t, updateUserDTO.Username, user.Username)
 }
 
@@ -292,6 +290,19 @@ func TestCreateUser_Validation(t *testing.T) {
 			},
 			expectedError: "CreateUserDTO.EditableUserField.Username",
 		},
+		{
+			name: "InvalidUsername - reserved",
+			createUserDTO: &domain.CreateUserDTO{
+				EditableUserField: domain.EditableUserField{
+					FirstName: "Test",
+					LastName:  "User",
+					Email:     "test@test.com",
+					Username:  "Tokens", // Static segment of the /v1/users routes
+				},
+				Password: "password",
+			},
+			expectedError: "CreateUserDTO.EditableUserField.Username",
+		},
 		{
 			name: "InvalidUsername - missing",
 			createUserDTO: &domain.CreateUserDTO{
@@ -424,7 +435,7 @@ func TestCreateUser_Validation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepo := new(mocks.MockedUserRepository)
-			userService := services.NewUserService(mockUserRepo)
+			userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 			// Validation happens before DB calls, so GetByEmail/GetByUsername should not be called.
 			_, err := userService.Create(context.Background(), tt.createUserDTO)
@@ -455,7 +466,7 @@ func TestGetUserByID_Success(t *testing.T) {
 		Username:  "test",
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	mockUserRepo.On("GetByID", mock.Anything, userId).Return(expectedUser, nil)
 
@@ -474,7 +485,7 @@ func TestGetUserByID_NotFound(t *testing.T) {
 	// Arrange
 	const userId int64 = 1
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	var nullptr *domain.User
 	mockUserRepo.On("GetByID", mock.Anything, userId).Return(nullptr, domain.ErrNotFound)
@@ -495,7 +506,7 @@ func TestDeleteUser_Success(t *testing.T) {
 	// Arrange
 	const userId int64 = 1
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	mockUserRepo.On("Delete", mock.Anything, userId).Return(nil)
 
@@ -512,7 +523,7 @@ func TestDeleteUser_NotFound(t *testing.T) {
 	// Arrange
 	const userId int64 = 1
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	mockUserRepo.On("Delete", mock.Anything, userId).Return(domain.ErrNotFound)
 
@@ -528,43 +539,167 @@ func TestDeleteUser_NotFound(t *testing.T) {
 
 func TestListUsers_Success(t *testing.T) {
 	// Arrange
-	const limit, offset = 10, 0
 	expectedUsers := []domain.User{
 		{ID: 1, FirstName: "Test1", LastName: "User1", Email: "test1@test.com", Username: "test1"},
 		{ID: 2, FirstName: "Test2", LastName: "User2", Email: "test2@test.com", Username: "test2"},
 	}
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
-	mockUserRepo.On("List", mock.Anything, limit, offset).Return(expectedUsers, nil)
+	mockUserRepo.On("List", mock.Anything, domain.PageRequest{Limit: 11}).Return(expectedUsers, nil)
 
 	// Act
-	users, err := userService.List(context.Background(), limit, offset)
+	page, err := userService.List(context.Background(), "", 10, 0)
 
 	// Assert
 	assert.Nil(t, err)
-	assert.NotNil(t, users)
-	assert.Equal(t, expectedUsers, users)
-	mockUserRepo.AssertCalled(t, "List", mock.Anything, limit, offset)
+	assert.NotNil(t, page)
+	assert.Equal(t, expectedUsers, page.Users)
+	assert.Empty(t, page.NextCursor)
 	mockUserRepo.AssertExpectations(t)
 }
 
+func TestListUsers_NextPage(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	users := []domain.User{
+		{ID: 3, CreatedAt: createdAt.Add(time.Minute)},
+		{ID: 2, CreatedAt: createdAt},
+		{ID: 1, CreatedAt: createdAt},
+	}
+	after := domain.Cursor{CreatedAt: createdAt.Add(time.Hour), ID: 4}
+	mockUserRepo := new(mocks.MockedUserRepository)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
+
+	mockUserRepo.On("List", mock.Anything, domain.PageRequest{After: &after, Limit: 3}).Return(users, nil)
+
+	// Act
+	page, err := userService.List(context.Background(), testCursors.Encode(after), 2, 0)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, users[:2], page.Users)
+	next, err := testCursors.Decode(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Cursor{CreatedAt: createdAt, ID: 2}, next)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestListUsers_CursorAndOffset(t *testing.T) {
+	// Arrange
+	mockUserRepo := new(mocks.MockedUserRepository)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
+	after := testCursors.Encode(domain.Cursor{CreatedAt: time.Now(), ID: 1})
+
+	// Act
+	page, err := userService.List(context.Background(), after, 10, 20)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	mockUserRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestListUsers_ForeignCursor(t *testing.T) {
+	// Arrange
+	mockUserRepo := new(mocks.MockedUserRepository)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
+	after := cursor.NewCodec([]byte("another-key")).Encode(domain.Cursor{CreatedAt: time.Now(), ID: 1})
+
+	// Act
+	page, err := userService.List(context.Background(), after, 10, 0)
+
+	// Assert
+	assert.Nil(t, page)
+	assert.IsType(t, &domain.BadRequestError{}, err)
+	mockUserRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
 func TestListUsers_Error(t *testing.T) {
 	// Arrange
-	const limit, offset = 10, 0
 	mockUserRepo := new(mocks.MockedUserRepository)
-	userService := services.NewUserService(mockUserRepo)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
 
 	var nullptr []domain.User
-	mockUserRepo.On("List", mock.Anything, limit, offset).Return(nullptr, errors.New("something went wrong"))
+	mockUserRepo.On("List", mock.Anything, domain.PageRequest{Limit: 11, Offset: 20}).Return(nullptr, errors.New("something went wrong"))
 
 	// Act
-	users, err := userService.List(context.Background(), limit, offset)
+	page, err := userService.List(context.Background(), "", 10, 20)
 
 	// Assert
-	assert.Nil(t, users)
+	assert.Nil(t, page)
 	assert.NotNil(t, err)
 	assert.IsType(t, &domain.InternalServerError{}, err)
-	mockUserRepo.AssertCalled(t, "List", mock.Anything, limit, offset)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestGetProfile_Success(t *testing.T) {
+	// Arrange
+	expectedProfile := &domain.PublicProfile{ID: 1, Username: "test", PostCount: 2}
+	mockUserRepo := new(mocks.MockedUserRepository)
+	mockBlockRepo := new(mocks.MockedBlockRepository)
+	userService := services.NewUserService(mockUserRepo, mockBlockRepo, testCursors)
+
+	mockUserRepo.On("GetProfileByUsername", mock.Anything, "test").Return(expectedProfile, nil)
+	mockBlockRepo.On("IsBlocked", mock.Anything, int64(2), int64(1)).Return(false, nil)
+
+	// Act
+	profile, err := userService.GetProfile(context.Background(), 2, "test")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProfile, profile)
+	mockUserRepo.AssertExpectations(t)
+	mockBlockRepo.AssertExpectations(t)
+}
+
+func TestGetProfile_Blocked(t *testing.T) {
+	// Arrange
+	mockUserRepo := new(mocks.MockedUserRepository)
+	mockBlockRepo := new(mocks.MockedBlockRepository)
+	userService := services.NewUserService(mockUserRepo, mockBlockRepo, testCursors)
+
+	mockUserRepo.On("GetProfileByUsername", mock.Anything, "test").Return(&domain.PublicProfile{ID: 1, Username: "test"}, nil)
+	mockBlockRepo.On("IsBlocked", mock.Anything, int64(2), int64(1)).Return(true, nil)
+
+	// Act
+	profile, err := userService.GetProfile(context.Background(), 2, "test")
+
+	// Assert
+	assert.Nil(t, profile)
+	assert.IsType(t, &domain.NotFoundError{}, err)
+}
+
+func TestGetProfile_Own(t *testing.T) {
+	// Arrange
+	mockUserRepo := new(mocks.MockedUserRepository)
+	mockBlockRepo := new(mocks.MockedBlockRepository)
+	userService := services.NewUserService(mockUserRepo, mockBlockRepo, testCursors)
+
+	mockUserRepo.On("GetProfileByUsername", mock.Anything, "test").Return(&domain.PublicProfile{ID: 1, Username: "test"}, nil)
+
+	// Act
+	profile, err := userService.GetProfile(context.Background(), 1, "test")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, profile)
+	mockBlockRepo.AssertNotCalled(t, "IsBlocked", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetProfile_NotFound(t *testing.T) {
+	// Arrange
+	mockUserRepo := new(mocks.MockedUserRepository)
+	userService := services.NewUserService(mockUserRepo, new(mocks.MockedBlockRepository), testCursors)
+
+	var nullptr *domain.PublicProfile
+	mockUserRepo.On("GetProfileByUsername", mock.Anything, "missing").Return(nullptr, domain.ErrNotFound)
+
+	// Act
+	profile, err := userService.GetProfile(context.Background(), 2, "missing")
+
+	// Assert
+	assert.Nil(t, profile)
+	assert.IsType(t, &domain.NotFoundError{}, err)
 	mockUserRepo.AssertExpectations(t)
 }
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/tokens"
+)
+
+const userTokenSize = 32
+
+// issueUserToken replaces the outstanding tokens of the user for the given purpose with a new
+// one, so that only the most recently emailed link stays valid. Only the hash is stored; the
+// raw token is returned to be sent to the user.
+func issueUserToken(ctx context.Context, userTokenRepo interfaces.UserTokenRepository, userId int64, purpose domain.TokenPurpose, ttl time.Duration) (string, error) {
+	if err := userTokenRepo.InvalidateAll(ctx, userId, purpose); err != nil {
+		return "", fmt.Errorf("invalidate %s tokens: %w", purpose, err)
+	}
+
+	rawToken, err := tokens.Generate(userTokenSize)
+	if err != nil {
+		return "", fmt.Errorf("generate %s token: %w", purpose, err)
+	}
+
+	if _, err := userTokenRepo.Create(ctx, userId, purpose, tokens.Hash(rawToken), time.Now().Add(ttl)); err != nil {
+		return "", fmt.Errorf("store %s token: %w", purpose, err)
+	}
+
+	return rawToken, nil
+}
+
+// appLink builds a link to a frontend page that receives the token as a query parameter.
+func appLink(path, rawToken string) string {
+	return fmt.Sprintf("%s%s?token=%s", env.GetAppURL(), path, url.QueryEscape(rawToken))
+}
@@ -1,9 +1,17 @@
 package validation
 
-import "github.com/go-playground/validator/v10"
+import (
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/go-playground/validator/v10"
+)
 
 var Validate *validator.Validate
 
 func init() {
 	Validate = validator.New(validator.WithRequiredStructEnabled())
+
+	// notreserved rejects the usernames that are static segments of the /v1/users routes
+	Validate.RegisterValidation("notreserved", func(fl validator.FieldLevel) bool {
+		return !domain.IsReservedUsername(fl.Field().String())
+	})
 }
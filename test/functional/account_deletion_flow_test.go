@@ -0,0 +1,159 @@
+package integration_tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func countRows(t *testing.T, query string, args ...any) int {
+	var count int
+	assert.NoError(t, db.QueryRow(query, args...).Scan(&count))
+	return count
+}
+
+func TestAccountDeletion_Purge(t *testing.T) {
+	// Arrange: A server without grace period, so that accounts are due as soon as they are deleted
+	app := newTestApplication(db, testApplicationOptions{
+		accountDeletionPolicy: &domain.AccountDeletionPolicy{GracePeriod: 0, PurgeInterval: time.Hour, PurgeBatchSize: 100},
+	})
+	server := httptest.NewServer(app.Routes())
+	defer server.Close()
+	client := server.Client()
+
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Deleted", LastName: "Soon",
+			Email:    fmt.Sprintf("deleted.soon%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("deletedsoon%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	user, cookies := signupAndGetCookies(t, client, server.URL, createUserDTO)
+	_, otherCookies := signupAndGetCookies(t, client, server.URL, &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Still", LastName: "Here",
+			Email:    fmt.Sprintf("still.here%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("stillhere%s", uniqueSuffix),
+		},
+		Password: "password123",
+	})
+
+	ownPostId := createPostForTest(t, client, cookies, "post of a deleted user")
+	createCommentForTest(t, client, otherCookies, ownPostId, "comment on a deleted post")
+	otherPostId := createPostForTest(t, client, otherCookies, "post of a remaining user")
+	createCommentForTest(t, client, cookies, otherPostId, "comment of a deleted user")
+
+	var pngImage bytes.Buffer
+	assert.NoError(t, png.Encode(&pngImage, image.NewRGBA(image.Rect(0, 0, 100, 100))))
+	thumbnails, err := app.AvatarService.Upload(context.Background(), *user.Id, &pngImage)
+	assert.NoError(t, err)
+
+	// Act & Assert: The password is required
+	wrongPasswordResp := doJSONWithCookies(t, client, http.MethodDelete, server.URL+deleteAccountEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: "wrongpassword"})
+	wrongPasswordResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, wrongPasswordResp.StatusCode)
+
+	deleteResp := doJSONWithCookies(t, client, http.MethodDelete, server.URL+deleteAccountEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: createUserDTO.Password})
+	defer deleteResp.Body.Close()
+	assert.Equal(t, http.StatusAccepted, deleteResp.StatusCode)
+	var deletion apitypes.AccountDeletionSuccessResponse
+	assert.NoError(t, json.NewDecoder(deleteResp.Body).Decode(&deletion))
+	assert.WithinDuration(t, time.Now(), deletion.Data.PurgeAt, time.Minute)
+
+	// Assert: The session is logged out
+	profileResp := doWithCookies(t, client, http.MethodGet, server.URL+"/api/v1/users", cookies)
+	profileResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, profileResp.StatusCode)
+
+	// Act: Purge the due accounts
+	purged, err := app.AccountService.PurgeDueAccounts(context.Background())
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, 1)
+
+	// Assert: The content is gone, including the comments of others on the posts of the user
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM posts WHERE user_id = $1`, *user.Id))
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM comments WHERE user_id = $1 OR post_id = $2`, *user.Id, ownPostId))
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM sessions WHERE user_id = $1`, *user.Id))
+	assert.Equal(t, 1, countRows(t, `SELECT COUNT(*) FROM posts WHERE id = $1`, otherPostId))
+
+	// Assert: The profile pictures are no longer stored nor served
+	for _, thumbnail := range thumbnails {
+		imageResp, err := client.Get(server.URL + thumbnail.URL)
+		assert.NoError(t, err)
+		imageResp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, imageResp.StatusCode, thumbnail.URL)
+	}
+
+	// Assert: The account is anonymized and no longer logs in
+	var username, email string
+	var isDeleted bool
+	assert.NoError(t, db.QueryRow(`SELECT username, email, is_deleted FROM users WHERE id = $1`, *user.Id).Scan(&username, &email, &isDeleted))
+	assert.Equal(t, fmt.Sprintf("deleted_%d", *user.Id), username)
+	assert.NotContains(t, email, createUserDTO.Email)
+	assert.True(t, isDeleted)
+
+	loginResp := postJSON(t, client, server.URL+loginEndpoint, &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password})
+	loginResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, loginResp.StatusCode)
+
+	// Assert: Purging again leaves the account as it is
+	var deletedAt time.Time
+	assert.NoError(t, db.QueryRow(`SELECT deleted_at FROM users WHERE id = $1`, *user.Id).Scan(&deletedAt))
+	_, err = app.AccountService.PurgeDueAccounts(context.Background())
+	assert.NoError(t, err)
+	assert.ErrorIs(t, repositories.NewUserRepository(db).Purge(context.Background(), *user.Id), domain.ErrNotFound)
+	var deletedAtAfter time.Time
+	assert.NoError(t, db.QueryRow(`SELECT deleted_at FROM users WHERE id = $1`, *user.Id).Scan(&deletedAtAfter))
+	assert.True(t, deletedAt.Equal(deletedAtAfter), "Expected the second purge to leave the account untouched")
+
+	// Assert: The username and email address can be used again
+	signupAndGetCookies(t, client, server.URL, createUserDTO)
+}
+
+func TestAccountDeletion_LoginCancels(t *testing.T) {
+	// Arrange: Sign up a user and delete the account
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Changed", LastName: "Mind",
+			Email:    fmt.Sprintf("changed.mind%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("changedmind%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	user, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	deleteResp := doJSONWithCookies(t, client, http.MethodDelete, testServerURL+deleteAccountEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: createUserDTO.Password})
+	deleteResp.Body.Close()
+	assert.Equal(t, http.StatusAccepted, deleteResp.StatusCode)
+	assert.Equal(t, 1, countRows(t, `SELECT COUNT(*) FROM users WHERE id = $1 AND deletion_scheduled_at IS NOT NULL`, *user.Id))
+
+	notification := lastEmailTo(t, createUserDTO.Email)
+	if assert.NotNil(t, notification, "Expected a notification email") {
+		assert.Equal(t, "Your account will be deleted", notification.Subject)
+	}
+
+	// Act: Log in during the grace period
+	newCookies := loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "test-agent")
+
+	// Assert: The deletion is cancelled
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM users WHERE id = $1 AND deletion_scheduled_at IS NOT NULL`, *user.Id))
+	profileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", newCookies)
+	profileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, profileResp.StatusCode)
+}
@@ -0,0 +1,168 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func doJSONWithCookies(t *testing.T, client *http.Client, method, url string, cookies []*http.Cookie, data any) *http.Response {
+	body, err := json.Marshal(map[string]any{"data": data})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	addAuthCookies(req, cookies)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestChangePassword(t *testing.T) {
+	// Arrange: Sign up a user and log in from a second device
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Password", LastName: "Changer",
+			Email:    fmt.Sprintf("password.changer%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("passwordchanger%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+	otherDeviceCookies := loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "other-device")
+
+	// Act & Assert: The current password is required
+	wrongPasswordResp := doJSONWithCookies(t, client, http.MethodPut, testServerURL+changePasswordEndpoint, cookies, &domain.ChangePasswordDTO{
+		CurrentPassword: "wrongpassword",
+		NewPassword:     "newpassword123",
+	})
+	wrongPasswordResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, wrongPasswordResp.StatusCode)
+
+	changeResp := doJSONWithCookies(t, client, http.MethodPut, testServerURL+changePasswordEndpoint, cookies, &domain.ChangePasswordDTO{
+		CurrentPassword: createUserDTO.Password,
+		NewPassword:     "newpassword123",
+	})
+	changeResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, changeResp.StatusCode)
+
+	// Assert: Only the session that made the change is still logged in
+	currentResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", cookies)
+	currentResp.Body.Close()
+	assert.Equal(t, http.StatusOK, currentResp.StatusCode)
+
+	otherResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", otherDeviceCookies)
+	otherResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, otherResp.StatusCode)
+
+	// Assert: The old password no longer works, the new one does
+	oldLoginResp := postJSON(t, client, testServerURL+loginEndpoint, &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password})
+	oldLoginResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, oldLoginResp.StatusCode)
+
+	loginAndGetCookies(t, client, createUserDTO.Email, "newpassword123", "test-agent")
+
+	notification := lastEmailTo(t, createUserDTO.Email)
+	if assert.NotNil(t, notification, "Expected a notification email") {
+		assert.Equal(t, "Your password was changed", notification.Subject)
+	}
+}
+
+func TestChangeEmail(t *testing.T) {
+	// Arrange: Sign up a user and log in from a second device
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Email", LastName: "Changer",
+			Email:    fmt.Sprintf("email.changer%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("emailchanger%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	newEmail := fmt.Sprintf("email.changed%s@example.com", uniqueSuffix)
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+	otherDeviceCookies := loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "other-device")
+
+	// Act & Assert: The current password is required
+	wrongPasswordResp := doJSONWithCookies(t, client, http.MethodPut, testServerURL+changeEmailEndpoint, cookies, &domain.ChangeEmailDTO{
+		Email:    newEmail,
+		Password: "wrongpassword",
+	})
+	wrongPasswordResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, wrongPasswordResp.StatusCode)
+
+	requestResp := doJSONWithCookies(t, client, http.MethodPut, testServerURL+changeEmailEndpoint, cookies, &domain.ChangeEmailDTO{
+		Email:    newEmail,
+		Password: createUserDTO.Password,
+	})
+	requestResp.Body.Close()
+	assert.Equal(t, http.StatusAccepted, requestResp.StatusCode)
+
+	// Assert: The email does not change before the confirmation
+	profileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", cookies)
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	profileResp.Body.Close()
+	assert.Equal(t, createUserDTO.Email, string(profile.Data.Email))
+
+	confirmation := lastEmailTo(t, newEmail)
+	if !assert.NotNil(t, confirmation, "Expected a confirmation email to the new address") {
+		return
+	}
+	match := emailTokenPattern.FindStringSubmatch(confirmation.Body)
+	if !assert.Len(t, match, 2, "Expected a confirmation link in the email body") {
+		return
+	}
+	confirmToken := match[1]
+
+	// Act & Assert: The token cannot be confirmed by another user
+	_, strangerCookies := signupAndGetCookies(t, client, testServerURL, &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Email", LastName: "Stranger",
+			Email:    fmt.Sprintf("email.stranger%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("emailstranger%s", uniqueSuffix),
+		},
+		Password: "password123",
+	})
+	strangerResp := postJSONWithCookies(t, client, testServerURL+confirmEmailChangeEndpoint, strangerCookies, &domain.ConfirmEmailChangeDTO{Token: confirmToken})
+	strangerResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, strangerResp.StatusCode)
+
+	confirmResp := postJSONWithCookies(t, client, testServerURL+confirmEmailChangeEndpoint, cookies, &domain.ConfirmEmailChangeDTO{Token: confirmToken})
+	defer confirmResp.Body.Close()
+	assert.Equal(t, http.StatusOK, confirmResp.StatusCode)
+
+	var confirmed apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(confirmResp.Body).Decode(&confirmed))
+	assert.Equal(t, newEmail, string(confirmed.Data.Email))
+	assert.NotNil(t, confirmed.Data.EmailVerifiedAt, "Expected the confirmed address to be verified")
+
+	// Assert: The old address is notified and the other session is logged out
+	notification := lastEmailTo(t, createUserDTO.Email)
+	if assert.NotNil(t, notification, "Expected a notification to the old address") {
+		assert.Equal(t, "Your email address was changed", notification.Subject)
+		assert.Contains(t, notification.Body, newEmail)
+	}
+
+	otherResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", otherDeviceCookies)
+	otherResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, otherResp.StatusCode)
+
+	// Assert: The token is single-use and the user logs in with the new address
+	reuseResp := postJSONWithCookies(t, client, testServerURL+confirmEmailChangeEndpoint, cookies, &domain.ConfirmEmailChangeDTO{Token: confirmToken})
+	reuseResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, reuseResp.StatusCode)
+
+	loginAndGetCookies(t, client, newEmail, createUserDTO.Password, "test-agent")
+}
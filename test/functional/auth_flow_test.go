@@ -416,10 +416,7 @@ func TestUserLogin(t *testing.T) {
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
 	// Add cookies obtained from signup
-	if len(cookies) >= 2 {
-		req.AddCookie(cookies[0])
-		req.AddCookie(cookies[1])
-	}
+	addAuthCookies(req, cookies)
 	client := testServer.Client()
 
 	// Act: Perform login request
@@ -458,9 +455,7 @@ func TestUserLogout(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPost, testServerURL+logoutEndpoint, nil) // No body needed
 	assert.NoError(t, err)
 	// Add cookies obtained from signup
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	client := testServer.Client()
 
 	// Act: Perform logout request
@@ -497,6 +492,15 @@ func TestUserLogout(t *testing.T) {
 	assert.NoError(t, err)
 	defer profileResp.Body.Close()
 	assert.Equal(t, http.StatusUnauthorized, profileResp.StatusCode, "Expected 401 Unauthorized after logout")
+
+	// Assert: The refresh token was revoked server-side and can no longer be used
+	refreshReq, err := http.NewRequest(http.MethodPost, testServerURL+refreshEndpoint, nil)
+	assert.NoError(t, err)
+	addAuthCookies(refreshReq, []*http.Cookie{cookieNamed(cookies, "refresh_token"), cookieNamed(cookies, "csrf_token")})
+	refreshResp, err := client.Do(refreshReq)
+	assert.NoError(t, err)
+	defer refreshResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, refreshResp.StatusCode, "Expected 401 Unauthorized when refreshing after logout")
 }
 
 func TestRefreshToken(t *testing.T) {
@@ -524,10 +528,11 @@ func TestRefreshToken(t *testing.T) {
 	assert.NotNil(t, refreshTokenCookie, "Refresh token cookie not found after signup")
 
 	// Prepare refresh request
-	refreshEndpoint := "/api/v1/auth/refresh"                                        // Define endpoint if not already done
 	req, err := http.NewRequest(http.MethodPost, testServerURL+refreshEndpoint, nil) // No body needed
 	assert.NoError(t, err)
-	req.AddCookie(refreshTokenCookie) // Only send the refresh token cookie
+	// Only send the refresh token cookie, with the CSRF token required for cookie sessions
+	csrfCookie := cookieNamed(cookies, "csrf_token")
+	addAuthCookies(req, []*http.Cookie{refreshTokenCookie, csrfCookie})
 	client := testServer.Client()
 
 	// Act: Perform refresh request
@@ -551,7 +556,38 @@ func TestRefreshToken(t *testing.T) {
 	}
 	assert.True(t, foundNewAccessToken, "Expected new access_token cookie in refresh response")
 
-	// --- Test Case 2: Refresh with invalid/missing token ---
+	// The refresh token must be rotated on every use
+	var rotatedRefreshCookie *http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "refresh_token" {
+			rotatedRefreshCookie = cookie
+			break
+		}
+	}
+	if assert.NotNil(t, rotatedRefreshCookie, "Expected rotated refresh_token cookie in refresh response") {
+		assert.NotEqual(t, refreshTokenCookie.Value, rotatedRefreshCookie.Value, "Rotated refresh token should differ from the old one")
+	}
+
+	// --- Test Case 2: Reusing the old refresh token is rejected and revokes the family ---
+	reqReuse, err := http.NewRequest(http.MethodPost, testServerURL+refreshEndpoint, nil)
+	assert.NoError(t, err)
+	addAuthCookies(reqReuse, []*http.Cookie{refreshTokenCookie, csrfCookie})
+	respReuse, err := client.Do(reqReuse)
+	assert.NoError(t, err)
+	defer respReuse.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, respReuse.StatusCode, "Expected 401 Unauthorized when reusing a rotated refresh token")
+
+	if rotatedRefreshCookie != nil {
+		reqRotated, err := http.NewRequest(http.MethodPost, testServerURL+refreshEndpoint, nil)
+		assert.NoError(t, err)
+		addAuthCookies(reqRotated, []*http.Cookie{rotatedRefreshCookie, csrfCookie})
+		respRotated, err := client.Do(reqRotated)
+		assert.NoError(t, err)
+		defer respRotated.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, respRotated.StatusCode, "Expected 401 Unauthorized after the token family was revoked")
+	}
+
+	// --- Test Case 3: Refresh with invalid/missing token ---
 	reqInvalid, err := http.NewRequest(http.MethodPost, testServerURL+refreshEndpoint, nil)
 	assert.NoError(t, err)
 	// Intentionally DO NOT add refresh token cookie
@@ -583,9 +619,7 @@ func TestGetUserProfile(t *testing.T) {
 	req, err := http.NewRequest(http.MethodGet, testServerURL+profileEndpoint, nil)
 	assert.NoError(t, err)
 	// Add cookies obtained from signup
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	client := testServer.Client()
 
 	// Act: Perform get profile request
@@ -642,12 +676,9 @@ func TestUpdateUserProfile(t *testing.T) {
 	updateEndpoint := "/api/v1/users" // Define endpoint
 	// Helper function to get pointer to string
 	stringPtr := func(s string) *string { return &s }
-	// Create apitypes.Email value and take its address
-	emailVal := apitypes.Email(createUserDTO.Email)
 	updateReqDTO := apitypes.UpdateUserProfileRequest{
 		FirstName: stringPtr("UpdatedFirstName"),     // Update First Name
 		LastName:  stringPtr("UpdatedLastName"),      // Update Last Name
-		Email:     &emailVal,                         // Correct type: *apitypes.Email
 		Username:  stringPtr(createUserDTO.Username), // Keep original Username
 	}
 	// Wrap the DTO in a "data" field for the request payload
@@ -659,9 +690,7 @@ func TestUpdateUserProfile(t *testing.T) {
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
 	// Add cookies obtained from signup
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	client := testServer.Client()
 
 	// Act: Perform update profile request
@@ -679,11 +708,11 @@ func TestUpdateUserProfile(t *testing.T) {
 	// Assert nested data matches the updated user
 	updatedUser := responseData.Data
 	assert.Equal(t, *signedUpUser.Id, *updatedUser.Id)
-	assert.Equal(t, *updateReqDTO.FirstName, updatedUser.FirstName)         // Check updated field (dereference)
-	assert.Equal(t, *updateReqDTO.LastName, updatedUser.LastName)           // Check updated field (dereference)
-	assert.Equal(t, *updateReqDTO.Username, updatedUser.Username)           // Check field sent (dereference)
-	assert.Equal(t, string(*updateReqDTO.Email), string(updatedUser.Email)) // Corrected: Compare string values
-	assert.NotEqual(t, *signedUpUser.UpdatedAt, *updatedUser.UpdatedAt)     // UpdatedAt should change
+	assert.Equal(t, *updateReqDTO.FirstName, updatedUser.FirstName)     // Check updated field (dereference)
+	assert.Equal(t, *updateReqDTO.LastName, updatedUser.LastName)       // Check updated field (dereference)
+	assert.Equal(t, *updateReqDTO.Username, updatedUser.Username)       // Check field sent (dereference)
+	assert.Equal(t, createUserDTO.Email, string(updatedUser.Email))     // The email is changed through its own flow
+	assert.NotEqual(t, *signedUpUser.UpdatedAt, *updatedUser.UpdatedAt) // UpdatedAt should change
 
 	// --- Test Case 2: Update profile without authentication ---
 	reqUnauth, err := http.NewRequest(http.MethodPut, testServerURL+updateEndpoint, bytes.NewBuffer(body)) // Reuse body
@@ -697,5 +726,23 @@ func TestUpdateUserProfile(t *testing.T) {
 	defer respUnauth.Body.Close()
 	assert.Equal(t, http.StatusUnauthorized, respUnauth.StatusCode, "Expected 401 Unauthorized for update profile without auth")
 
-	// TODO: Add test case for validation errors (e.g., invalid email format if updating email)
+	// --- Test Case 3: The email cannot be changed through the profile, but is still accepted ---
+	emailBody, err := json.Marshal(map[string]any{"data": map[string]any{
+		"first_name": "UpdatedFirstName",
+		"last_name":  "UpdatedLastName",
+		"username":   createUserDTO.Username,
+		"email":      fmt.Sprintf("hijacked%s@example.com", uniqueSuffix),
+	}})
+	assert.NoError(t, err)
+	reqEmail, err := http.NewRequest(http.MethodPut, testServerURL+updateEndpoint, bytes.NewBuffer(emailBody))
+	assert.NoError(t, err)
+	reqEmail.Header.Set("Content-Type", "application/json")
+	addAuthCookies(reqEmail, cookies)
+	respEmail, err := client.Do(reqEmail)
+	assert.NoError(t, err)
+	defer respEmail.Body.Close()
+	assert.Equal(t, http.StatusOK, respEmail.StatusCode, "Expected the deprecated email field to be ignored")
+	var emailResponse apitypes.UpdateUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(respEmail.Body).Decode(&emailResponse))
+	assert.Equal(t, createUserDTO.Email, string(emailResponse.Data.Email))
 }
@@ -0,0 +1,126 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	_ "image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+const avatarEndpoint = "/api/v1/users/avatar"
+
+// uploadAvatar sends the file as the "avatar" field of a multipart form.
+func uploadAvatar(t *testing.T, client *http.Client, token string, file []byte) *http.Response {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("avatar", "avatar.png")
+	assert.NoError(t, err)
+	_, err = part.Write(file)
+	assert.NoError(t, err)
+	assert.NoError(t, form.Close())
+
+	req, err := http.NewRequest(http.MethodPut, testServerURL+avatarEndpoint, &body)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestAvatarFlow(t *testing.T) {
+	// Arrange: A user and a PNG image
+	client := testServer.Client()
+	_, token := signupWithRole(t, client, "avataruser", domain.RoleUser)
+
+	var pngImage bytes.Buffer
+	assert.NoError(t, png.Encode(&pngImage, image.NewRGBA(image.Rect(0, 0, 400, 300))))
+
+	// Act & Assert: Files that are not images are rejected
+	rejectedResp := uploadAvatar(t, client, token, []byte("#!/bin/sh\necho not an image\n"))
+	rejectedResp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, rejectedResp.StatusCode)
+
+	// Act: Upload the image
+	uploadResp := uploadAvatar(t, client, token, pngImage.Bytes())
+	defer uploadResp.Body.Close()
+
+	// Assert: Thumbnails of every size are stored and the largest is the profile picture
+	assert.Equal(t, http.StatusOK, uploadResp.StatusCode)
+	var uploaded apitypes.UploadAvatarSuccessResponse
+	assert.NoError(t, json.NewDecoder(uploadResp.Body).Decode(&uploaded))
+	assert.Len(t, uploaded.Data.Thumbnails, 3)
+
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", token, nil)
+	defer profileResp.Body.Close()
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	if assert.NotNil(t, profile.Data.ProfilePictureUrl) {
+		assert.Equal(t, uploaded.Data.ProfilePictureUrl, *profile.Data.ProfilePictureUrl)
+	}
+
+	// Assert: Thumbnails are public JPEG images that can be cached forever
+	imageResp, err := client.Get(testServerURL + uploaded.Data.ProfilePictureUrl)
+	assert.NoError(t, err)
+	defer imageResp.Body.Close()
+	assert.Equal(t, http.StatusOK, imageResp.StatusCode)
+	assert.Equal(t, "image/jpeg", imageResp.Header.Get("Content-Type"))
+	assert.Equal(t, "public, max-age=31536000, immutable", imageResp.Header.Get("Cache-Control"))
+	decoded, _, err := image.Decode(imageResp.Body)
+	assert.NoError(t, err)
+	if assert.NotNil(t, decoded) {
+		assert.Equal(t, image.Rect(0, 0, 256, 256), decoded.Bounds())
+	}
+
+	// Act & Assert: Removing the profile picture deletes its thumbnails
+	deleteResp := doWithBearer(t, client, http.MethodDelete, testServerURL+avatarEndpoint, token, nil)
+	deleteResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode)
+
+	goneResp, err := client.Get(testServerURL + uploaded.Data.ProfilePictureUrl)
+	assert.NoError(t, err)
+	goneResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, goneResp.StatusCode)
+}
+
+func TestUpdateBio(t *testing.T) {
+	// Arrange
+	client := testServer.Client()
+	_, token := signupWithRole(t, client, "biouser", domain.RoleUser)
+	meResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", token, nil)
+	defer meResp.Body.Close()
+	var me apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(meResp.Body).Decode(&me))
+	bio := "Gopher and coffee drinker."
+
+	// Act
+	updateResp := doWithBearer(t, client, http.MethodPut, testServerURL+"/api/v1/users", token,
+		&domain.UpdateUserDTO{FirstName: me.Data.FirstName, LastName: me.Data.LastName, Username: me.Data.Username, Bio: &bio})
+	defer updateResp.Body.Close()
+
+	// Assert: The bio is saved, and kept by updates that omit it
+	assert.Equal(t, http.StatusOK, updateResp.StatusCode)
+	var updated apitypes.UpdateUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(updateResp.Body).Decode(&updated))
+	if assert.NotNil(t, updated.Data.Bio) {
+		assert.Equal(t, bio, *updated.Data.Bio)
+	}
+
+	keepResp := doWithBearer(t, client, http.MethodPut, testServerURL+"/api/v1/users", token,
+		&domain.UpdateUserDTO{FirstName: "Renamed", LastName: me.Data.LastName, Username: me.Data.Username})
+	defer keepResp.Body.Close()
+	var kept apitypes.UpdateUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(keepResp.Body).Decode(&kept))
+	if assert.NotNil(t, kept.Data.Bio) {
+		assert.Equal(t, bio, *kept.Data.Bio)
+	}
+}
@@ -0,0 +1,119 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// tokens are the access and refresh tokens returned in the body of a login or refresh response
+type tokens struct {
+	Token, RefreshToken string
+}
+
+// decodeTokens reads the tokens returned in the body of a login or refresh response
+func decodeTokens(t *testing.T, resp *http.Response) tokens {
+	var tokensResponse apitypes.LoginSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&tokensResponse))
+	assert.NotEmpty(t, tokensResponse.Data.Token)
+	if !assert.NotNil(t, tokensResponse.Data.RefreshToken, "Expected the refresh token in the body") {
+		return tokens{Token: tokensResponse.Data.Token}
+	}
+	return tokens{Token: tokensResponse.Data.Token, RefreshToken: *tokensResponse.Data.RefreshToken}
+}
+
+// postJSONForTokens is postJSON for clients that opt in to receive the refresh token in the body
+func postJSONForTokens(t *testing.T, client *http.Client, url string, data any) *http.Response {
+	body, err := json.Marshal(map[string]any{"data": data})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Token-Delivery", "body")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestBearerAuthentication(t *testing.T) {
+	// Arrange: Sign up a user and log in as a client that ignores cookies
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Bearer", LastName: "User",
+			Email:    fmt.Sprintf("bearer.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("beareruser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	signedUpUser, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	credentials := &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password}
+
+	// Assert: Without opting in, the refresh token stays in the HttpOnly cookie only
+	cookieLoginResp := postJSON(t, client, testServerURL+loginEndpoint, credentials)
+	defer cookieLoginResp.Body.Close()
+	assert.Equal(t, http.StatusOK, cookieLoginResp.StatusCode)
+	var cookieLogin apitypes.LoginSuccessResponse
+	assert.NoError(t, json.NewDecoder(cookieLoginResp.Body).Decode(&cookieLogin))
+	assert.NotEmpty(t, cookieLogin.Data.Token)
+	assert.Nil(t, cookieLogin.Data.RefreshToken)
+
+	loginResp := postJSONForTokens(t, client, testServerURL+loginEndpoint, credentials)
+	defer loginResp.Body.Close()
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	loginTokens := decodeTokens(t, loginResp)
+
+	// Act & Assert: The access token authenticates through the header
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", loginTokens.Token, nil)
+	defer profileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, profileResp.StatusCode)
+
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	assert.Equal(t, signedUpUser.Id, profile.Data.Id, "Expected the header to resolve to the same user as the cookie")
+
+	// Assert: The header wins over the cookie, even when it is invalid
+	req, err := http.NewRequest(http.MethodGet, testServerURL+"/api/v1/users", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer not-a-valid-token")
+	addAuthCookies(req, cookies)
+	invalidHeaderResp, err := client.Do(req)
+	assert.NoError(t, err)
+	invalidHeaderResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, invalidHeaderResp.StatusCode)
+
+	// Act: Refresh with the token in the body
+	refreshResp := postJSON(t, client, testServerURL+refreshEndpoint, &domain.RefreshTokenDTO{RefreshToken: loginTokens.RefreshToken})
+	defer refreshResp.Body.Close()
+	assert.Equal(t, http.StatusOK, refreshResp.StatusCode)
+	refreshedTokens := decodeTokens(t, refreshResp)
+	assert.NotEqual(t, loginTokens.RefreshToken, refreshedTokens.RefreshToken, "Expected the refresh token to be rotated")
+
+	refreshedProfileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", refreshedTokens.Token, nil)
+	refreshedProfileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, refreshedProfileResp.StatusCode)
+
+	// Act: Log out with the token in the body
+	logoutResp := postJSON(t, client, testServerURL+logoutEndpoint, &domain.RefreshTokenDTO{RefreshToken: refreshedTokens.RefreshToken})
+	logoutResp.Body.Close()
+	assert.Equal(t, http.StatusOK, logoutResp.StatusCode)
+
+	// Assert: The session is over for header-based clients too
+	loggedOutRefreshResp := postJSON(t, client, testServerURL+refreshEndpoint, &domain.RefreshTokenDTO{RefreshToken: refreshedTokens.RefreshToken})
+	loggedOutRefreshResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, loggedOutRefreshResp.StatusCode)
+
+	loggedOutProfileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", refreshedTokens.Token, nil)
+	loggedOutProfileResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, loggedOutProfileResp.StatusCode)
+}
@@ -0,0 +1,203 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPostWithBearer(t *testing.T, client *http.Client, token, content string) int64 {
+	resp := doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, token, &apitypes.CreatePostRequest{Content: content})
+	defer resp.Body.Close()
+	var created apitypes.CreatePostSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	return *created.Data.Id
+}
+
+func listPostUserIds(t *testing.T, client *http.Client, token string) []int64 {
+	resp := doWithBearer(t, client, http.MethodGet, testServerURL+postsEndpoint, token, nil)
+	defer resp.Body.Close()
+	var posts apitypes.ListPostsSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&posts))
+	userIds := make([]int64, 0, len(posts.Data))
+	for _, post := range posts.Data {
+		userIds = append(userIds, *post.UserId)
+	}
+	return userIds
+}
+
+func TestBlockFlow(t *testing.T) {
+	// Arrange: Alice and Bob follow each other, and each of them and Carol wrote a post
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "aliceblock", domain.RoleUser)
+	bobId, bobToken := signupWithRole(t, client, "bobblock", domain.RoleUser)
+	_, carolToken := signupWithRole(t, client, "carolblock", domain.RoleUser)
+	alice := currentUsername(t, client, aliceToken)
+	bob := currentUsername(t, client, bobToken)
+	usersURL := testServerURL + "/api/v1/users/"
+	postURL := func(postId int64) string { return fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, postId) }
+
+	for _, follow := range []struct{ token, username string }{{bobToken, alice}, {aliceToken, bob}} {
+		resp := doWithBearer(t, client, http.MethodPost, usersURL+follow.username+"/follow", follow.token, nil)
+		resp.Body.Close()
+	}
+	alicePostId := createPostWithBearer(t, client, aliceToken, "Alice's post")
+	bobPostId := createPostWithBearer(t, client, bobToken, "Bob's post")
+	carolPostId := createPostWithBearer(t, client, carolToken, "Carol's post")
+	carolCommentResp := doWithBearer(t, client, http.MethodPost, postURL(alicePostId)+"/comments", carolToken, &apitypes.CreateCommentRequest{Content: "Hi Alice"})
+	defer carolCommentResp.Body.Close()
+	var carolComment apitypes.CreateCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(carolCommentResp.Body).Decode(&carolComment))
+
+	// Act: Alice blocks Bob, twice
+	for range 2 {
+		resp := doWithBearer(t, client, http.MethodPost, usersURL+bob+"/block", aliceToken, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	// Assert: Users cannot block themselves
+	selfResp := doWithBearer(t, client, http.MethodPost, usersURL+alice+"/block", aliceToken, nil)
+	defer selfResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, selfResp.StatusCode)
+	assert.Equal(t, errorcodes.CodeCannotBlockSelf, decodeErrorCode(t, selfResp))
+
+	// Assert: The block is listed and removed the follows both ways
+	blocksResp := doWithBearer(t, client, http.MethodGet, usersURL+"blocks", aliceToken, nil)
+	defer blocksResp.Body.Close()
+	var blocks apitypes.ListBlockedUsersSuccessResponse
+	assert.NoError(t, json.NewDecoder(blocksResp.Body).Decode(&blocks))
+	if assert.Len(t, blocks.Data, 1) {
+		assert.Equal(t, bob, blocks.Data[0].Username)
+	}
+
+	profileResp := doWithBearer(t, client, http.MethodGet, usersURL+alice, carolToken, nil)
+	defer profileResp.Body.Close()
+	var profile apitypes.GetPublicUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	assert.Equal(t, int64(0), profile.Data.FollowerCount)
+	assert.Equal(t, int64(0), profile.Data.FollowingCount)
+
+	// Assert: Neither user sees the other's posts
+	for _, read := range []struct {
+		token  string
+		postId int64
+	}{{bobToken, alicePostId}, {aliceToken, bobPostId}} {
+		resp := doWithBearer(t, client, http.MethodGet, postURL(read.postId), read.token, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+
+	userPostsResp := doWithBearer(t, client, http.MethodGet, usersURL+alice+"/posts", bobToken, nil)
+	userPostsResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, userPostsResp.StatusCode)
+
+	// Assert: Neither user finds the other's profile
+	for _, read := range []struct{ token, username string }{{bobToken, alice}, {aliceToken, bob}} {
+		resp := doWithBearer(t, client, http.MethodGet, usersURL+read.username, read.token, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+
+	assert.NotContains(t, listPostUserIds(t, client, aliceToken), bobId)
+
+	// Assert: Bob can neither comment on Alice's posts nor follow her
+	commentResp := doWithBearer(t, client, http.MethodPost, postURL(alicePostId)+"/comments", bobToken, &apitypes.CreateCommentRequest{Content: "Hello"})
+	defer commentResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, commentResp.StatusCode)
+	assert.Equal(t, errorcodes.CodeBlocked, decodeErrorCode(t, commentResp))
+
+	followResp := doWithBearer(t, client, http.MethodPost, usersURL+alice+"/follow", bobToken, nil)
+	defer followResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, followResp.StatusCode)
+	assert.Equal(t, errorcodes.CodeBlocked, decodeErrorCode(t, followResp))
+
+	// Assert: Bob's comments on the posts of others are hidden from Alice
+	bobCommentResp := doWithBearer(t, client, http.MethodPost, postURL(carolPostId)+"/comments", bobToken, &apitypes.CreateCommentRequest{Content: "Hi Carol"})
+	defer bobCommentResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, bobCommentResp.StatusCode)
+	var bobComment apitypes.CreateCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(bobCommentResp.Body).Decode(&bobComment))
+
+	commentsResp := doWithBearer(t, client, http.MethodGet, postURL(carolPostId)+"/comments", aliceToken, nil)
+	defer commentsResp.Body.Close()
+	var comments apitypes.ListCommentsSuccessResponse
+	assert.NoError(t, json.NewDecoder(commentsResp.Body).Decode(&comments))
+	assert.Empty(t, comments.Data)
+
+	// Assert: Neither user reads by id a comment of the other, or a comment on the posts of the other
+	for _, read := range []struct {
+		token             string
+		postId, commentId int64
+	}{{aliceToken, carolPostId, *bobComment.Data.Id}, {bobToken, alicePostId, *carolComment.Data.Id}} {
+		resp := doWithBearer(t, client, http.MethodGet, fmt.Sprintf("%s/comments/%d", postURL(read.postId), read.commentId), read.token, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+
+	// Act & Assert: Unblocking restores access, once
+	for _, status := range []int{http.StatusNoContent, http.StatusNotFound} {
+		resp := doWithBearer(t, client, http.MethodDelete, usersURL+bob+"/block", aliceToken, nil)
+		resp.Body.Close()
+		assert.Equal(t, status, resp.StatusCode)
+	}
+
+	readResp := doWithBearer(t, client, http.MethodGet, postURL(alicePostId), bobToken, nil)
+	readResp.Body.Close()
+	assert.Equal(t, http.StatusOK, readResp.StatusCode)
+
+	unblockedProfileResp := doWithBearer(t, client, http.MethodGet, usersURL+alice, bobToken, nil)
+	unblockedProfileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, unblockedProfileResp.StatusCode)
+}
+
+func TestMuteFlow(t *testing.T) {
+	// Arrange: Dave and Erin each wrote a post
+	client := testServer.Client()
+	_, daveToken := signupWithRole(t, client, "davemute", domain.RoleUser)
+	erinId, erinToken := signupWithRole(t, client, "erinmute", domain.RoleUser)
+	erin := currentUsername(t, client, erinToken)
+	usersURL := testServerURL + "/api/v1/users/"
+
+	createPostWithBearer(t, client, daveToken, "Dave's post")
+	erinPostId := createPostWithBearer(t, client, erinToken, "Erin's post")
+
+	// Act: Dave mutes Erin
+	muteResp := doWithBearer(t, client, http.MethodPost, usersURL+erin+"/mute", daveToken, nil)
+	muteResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, muteResp.StatusCode)
+
+	// Assert: The mute is listed, and Erin's posts are left out of Dave's feed only
+	mutesResp := doWithBearer(t, client, http.MethodGet, usersURL+"mutes", daveToken, nil)
+	defer mutesResp.Body.Close()
+	var mutes apitypes.ListBlockedUsersSuccessResponse
+	assert.NoError(t, json.NewDecoder(mutesResp.Body).Decode(&mutes))
+	if assert.Len(t, mutes.Data, 1) {
+		assert.Equal(t, erin, mutes.Data[0].Username)
+	}
+
+	assert.NotContains(t, listPostUserIds(t, client, daveToken), erinId)
+
+	postResp := doWithBearer(t, client, http.MethodGet, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, erinPostId), daveToken, nil)
+	postResp.Body.Close()
+	assert.Equal(t, http.StatusOK, postResp.StatusCode)
+
+	// Assert: Muted users can still comment on the posts of the muter and follow them
+	dave := currentUsername(t, client, daveToken)
+	followResp := doWithBearer(t, client, http.MethodPost, usersURL+dave+"/follow", erinToken, nil)
+	followResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, followResp.StatusCode)
+
+	// Act & Assert: Unmuting works once
+	for _, status := range []int{http.StatusNoContent, http.StatusNotFound} {
+		resp := doWithBearer(t, client, http.MethodDelete, usersURL+erin+"/mute", daveToken, nil)
+		resp.Body.Close()
+		assert.Equal(t, status, resp.StatusCode)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,9 +25,7 @@ func createPostForTest(t *testing.T, client *http.Client, cookies []*http.Cookie
 	req, err := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(body))
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	resp, err := client.Do(req)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
@@ -48,9 +47,7 @@ func createCommentForTest(t *testing.T, client *http.Client, cookies []*http.Coo
 	req, err := http.NewRequest(http.MethodPost, commentUrl, bytes.NewBuffer(body))
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	resp, err := client.Do(req)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
@@ -92,9 +89,7 @@ func TestCreateComment(t *testing.T) {
 	req, err := http.NewRequest(http.MethodPost, commentUrl, bytes.NewBuffer(body))
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 
 	// Act: Perform create comment request
 	resp, err := client.Do(req)
@@ -156,9 +151,7 @@ func TestGetComment(t *testing.T) {
 	getCommentUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, createdCommentId)
 	getReq, err := http.NewRequest(http.MethodGet, getCommentUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getReq.AddCookie(cookie)
-	} // Add auth cookies
+	addAuthCookies(getReq, cookies) // Add auth cookies
 
 	// Act: Perform get comment request
 	getResp, err := client.Do(getReq)
@@ -184,15 +177,26 @@ func TestGetComment(t *testing.T) {
 	getNonExistentUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, nonExistentId)
 	getNonExistentReq, err := http.NewRequest(http.MethodGet, getNonExistentUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getNonExistentReq.AddCookie(cookie)
-	}
+	addAuthCookies(getNonExistentReq, cookies)
 
 	// Act & Assert
 	getNonExistentResp, err := client.Do(getNonExistentReq)
 	assert.NoError(t, err)
 	defer getNonExistentResp.Body.Close()
 	assert.Equal(t, http.StatusNotFound, getNonExistentResp.StatusCode, "Expected status 404 Not Found for non-existent comment")
+
+	// --- Test Case 3: Get comment under another post ---
+	otherPostId := createPostForTest(t, client, cookies, "Other post for GetComment test")
+	getOtherPostUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, otherPostId, createdCommentId)
+	getOtherPostReq, err := http.NewRequest(http.MethodGet, getOtherPostUrl, nil)
+	assert.NoError(t, err)
+	addAuthCookies(getOtherPostReq, cookies)
+
+	// Act & Assert
+	getOtherPostResp, err := client.Do(getOtherPostReq)
+	assert.NoError(t, err)
+	defer getOtherPostResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, getOtherPostResp.StatusCode, "Expected status 404 Not Found for comment under another post")
 }
 
 func TestListComments(t *testing.T) {
@@ -228,9 +232,7 @@ func TestListComments(t *testing.T) {
 	commentUrl := fmt.Sprintf("%s%s/%d/comments", testServerURL, postsEndpoint, postId)
 	listReq, err := http.NewRequest(http.MethodGet, commentUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		listReq.AddCookie(cookie)
-	} // Add auth cookies
+	addAuthCookies(listReq, cookies) // Add auth cookies
 
 	// Act: Perform list comments request
 	listResp, err := client.Do(listReq)
@@ -261,6 +263,50 @@ func TestListComments(t *testing.T) {
 	assert.True(t, found2, "Expected to find 'List Comment 2' in the list")
 }
 
+func TestListCommentsPagination(t *testing.T) {
+	// Arrange: A post with three comments
+	client := testServer.Client()
+	_, token := signupWithRole(t, client, "pagecmt", domain.RoleUser)
+	postId := createPostWithBearer(t, client, token, "Post for comment pagination")
+	commentUrl := fmt.Sprintf("%s%s/%d/comments", testServerURL, postsEndpoint, postId)
+	var created []int64
+	for _, content := range []string{"First", "Second", "Third"} {
+		resp := doWithBearer(t, client, http.MethodPost, commentUrl, token, &apitypes.CreateCommentRequest{Content: content})
+		var comment apitypes.CreateCommentSuccessResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&comment))
+		resp.Body.Close()
+		created = append(created, *comment.Data.Id)
+	}
+
+	// Act: Follow the next links two comments at a time
+	var commentIds []int64
+	pageUrl := commentUrl + "?limit=2"
+	for pageUrl != "" {
+		resp := doWithBearer(t, client, http.MethodGet, pageUrl, token, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var page apitypes.ListCommentsSuccessResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+		resp.Body.Close()
+
+		for _, comment := range page.Data {
+			commentIds = append(commentIds, *comment.Id)
+		}
+		pageUrl = ""
+		if link := resp.Header.Get("Link"); link != "" {
+			assert.NotNil(t, page.NextCursor)
+			pageUrl = testServerURL + strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`)
+		}
+	}
+
+	// Assert: Newest first, each comment once
+	assert.Equal(t, []int64{created[2], created[1], created[0]}, commentIds)
+
+	// Assert: A cursor cannot be combined with an offset
+	resp := doWithBearer(t, client, http.MethodGet, commentUrl+"?offset=1&cursor=abc", token, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestUpdateComment(t *testing.T) {
 	// Arrange: Sign up user, create post, create comment
 	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
@@ -295,9 +341,7 @@ func TestUpdateComment(t *testing.T) {
 	updateReq, err := http.NewRequest(http.MethodPut, updateUrl, bytes.NewBuffer(updateBody))
 	assert.NoError(t, err)
 	updateReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		updateReq.AddCookie(cookie)
-	}
+	addAuthCookies(updateReq, cookies)
 
 	// Act: Perform update comment request
 	updateResp, err := client.Do(updateReq)
@@ -326,9 +370,7 @@ func TestUpdateComment(t *testing.T) {
 	updateNonExistentUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, nonExistentId)
 	updateNonExistentHttpReq, _ := http.NewRequest(http.MethodPut, updateNonExistentUrl, bytes.NewBuffer(updateNonExistentBody))
 	updateNonExistentHttpReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		updateNonExistentHttpReq.AddCookie(cookie)
-	}
+	addAuthCookies(updateNonExistentHttpReq, cookies)
 
 	// Act & Assert
 	updateNonExistentResp, err := client.Do(updateNonExistentHttpReq)
@@ -365,9 +407,7 @@ func TestUpdateComment(t *testing.T) {
 	updateOtherBody, _ := json.Marshal(updateOtherPayload)
 	updateOtherHttpReq, _ := http.NewRequest(http.MethodPut, updateUrl, bytes.NewBuffer(updateOtherBody)) // Use original comment URL
 	updateOtherHttpReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range otherCookies {
-		updateOtherHttpReq.AddCookie(cookie)
-	} // Use OTHER user's cookies
+	addAuthCookies(updateOtherHttpReq, otherCookies) // Use OTHER user's cookies
 
 	// Assert
 	updateOtherResp, err := client.Do(updateOtherHttpReq)
@@ -413,9 +453,7 @@ func TestDeleteComment(t *testing.T) {
 	deleteUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, commentToDeleteId)
 	deleteReq, err := http.NewRequest(http.MethodDelete, deleteUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		deleteReq.AddCookie(cookie)
-	}
+	addAuthCookies(deleteReq, cookies)
 
 	// Act: Perform delete comment request
 	deleteResp, err := client.Do(deleteReq)
@@ -428,9 +466,7 @@ func TestDeleteComment(t *testing.T) {
 	// Assert: Try to GET the deleted comment, should be 404
 	getDeletedReq, err := http.NewRequest(http.MethodGet, deleteUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getDeletedReq.AddCookie(cookie)
-	}
+	addAuthCookies(getDeletedReq, cookies)
 	getDeletedResp, err := client.Do(getDeletedReq)
 	assert.NoError(t, err)
 	defer getDeletedResp.Body.Close()
@@ -440,9 +476,7 @@ func TestDeleteComment(t *testing.T) {
 	nonExistentId := int64(999999)
 	deleteNonExistentUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, nonExistentId)
 	deleteNonExistentReq, _ := http.NewRequest(http.MethodDelete, deleteNonExistentUrl, nil)
-	for _, cookie := range cookies {
-		deleteNonExistentReq.AddCookie(cookie)
-	}
+	addAuthCookies(deleteNonExistentReq, cookies)
 
 	// Act & Assert
 	deleteNonExistentResp, err := client.Do(deleteNonExistentReq)
@@ -478,9 +512,7 @@ func TestDeleteComment(t *testing.T) {
 	// Act: Try to delete the first user's comment with the second user's cookies
 	deleteOtherUrl := fmt.Sprintf("%s%s/%d/comments/%d", testServerURL, postsEndpoint, postId, commentToDeleteByOtherId)
 	deleteOtherHttpReq, _ := http.NewRequest(http.MethodDelete, deleteOtherUrl, nil)
-	for _, cookie := range otherCookies {
-		deleteOtherHttpReq.AddCookie(cookie)
-	} // Use OTHER user's cookies
+	addAuthCookies(deleteOtherHttpReq, otherCookies) // Use OTHER user's cookies
 
 	// Assert
 	deleteOtherResp, err := client.Do(deleteOtherHttpReq)
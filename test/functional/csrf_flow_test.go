@@ -0,0 +1,124 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRFProtection(t *testing.T) {
+	// Arrange: Sign up a user to get a cookie session
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Csrf", LastName: "User",
+			Email:    fmt.Sprintf("csrf.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("csrfuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	// Assert: The cookies follow the cookie policy
+	csrfCookie := cookieNamed(cookies, domain.CSRFTokenCookie)
+	if !assert.NotNil(t, csrfCookie, "Expected a csrf_token cookie after signup") {
+		return
+	}
+	assert.NotEmpty(t, csrfCookie.Value)
+	assert.False(t, csrfCookie.HttpOnly, "The CSRF token must be readable by the frontend")
+	for _, cookie := range cookies {
+		assert.Equal(t, http.SameSiteLaxMode, cookie.SameSite, "Unexpected SameSite for %s", cookie.Name)
+		assert.Equal(t, "/", cookie.Path, "Unexpected Path for %s", cookie.Name)
+		assert.True(t, cookie.Secure, "Expected %s to be Secure", cookie.Name)
+	}
+	assert.True(t, cookieNamed(cookies, domain.AccessTokenCookie).HttpOnly)
+	assert.True(t, cookieNamed(cookies, domain.RefreshTokenCookie).HttpOnly)
+
+	body, err := json.Marshal(map[string]any{"data": apitypes.CreatePostRequest{Content: "csrf post"}})
+	assert.NoError(t, err)
+	newPostRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(body))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	// Act & Assert: A cookie request without the header is rejected
+	req := newPostRequest()
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	missingHeaderResp, err := client.Do(req)
+	assert.NoError(t, err)
+	missingHeaderResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, missingHeaderResp.StatusCode)
+
+	// A header that does not match the cookie is rejected
+	req = newPostRequest()
+	addAuthCookies(req, cookies)
+	req.Header.Set("X-CSRF-Token", "forged")
+	mismatchResp, err := client.Do(req)
+	assert.NoError(t, err)
+	mismatchResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, mismatchResp.StatusCode)
+
+	// Echoing the cookie in the header is accepted
+	req = newPostRequest()
+	addAuthCookies(req, cookies)
+	validResp, err := client.Do(req)
+	assert.NoError(t, err)
+	validResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, validResp.StatusCode)
+
+	// Safe requests are not checked, and a session without a token is issued one
+	var sessionCookies []*http.Cookie
+	for _, cookie := range cookies {
+		if cookie.Name != domain.CSRFTokenCookie {
+			sessionCookies = append(sessionCookies, cookie)
+		}
+	}
+	profileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", sessionCookies)
+	profileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, profileResp.StatusCode)
+	reissuedCookie := cookieNamed(profileResp.Cookies(), domain.CSRFTokenCookie)
+	if assert.NotNil(t, reissuedCookie, "Expected a new csrf_token cookie") {
+		assert.NotEmpty(t, reissuedCookie.Value)
+	}
+
+	// Bearer requests are not checked
+	loginResp := postJSONForTokens(t, client, testServerURL+loginEndpoint, &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password})
+	defer loginResp.Body.Close()
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	loginTokens := decodeTokens(t, loginResp)
+
+	bearerResp := doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, loginTokens.Token, apitypes.CreatePostRequest{Content: "bearer post"})
+	bearerResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, bearerResp.StatusCode)
+
+	// Logout is protected as well, and clears the CSRF cookie
+	req, err = http.NewRequest(http.MethodPost, testServerURL+logoutEndpoint, nil)
+	assert.NoError(t, err)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	forgedLogoutResp, err := client.Do(req)
+	assert.NoError(t, err)
+	forgedLogoutResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forgedLogoutResp.StatusCode)
+
+	logoutResp := postJSONWithCookies(t, client, testServerURL+logoutEndpoint, cookies, nil)
+	logoutResp.Body.Close()
+	assert.Equal(t, http.StatusOK, logoutResp.StatusCode)
+	clearedCookie := cookieNamed(logoutResp.Cookies(), domain.CSRFTokenCookie)
+	if assert.NotNil(t, clearedCookie, "Expected the csrf_token cookie to be cleared") {
+		assert.Empty(t, clearedCookie.Value)
+	}
+}
@@ -0,0 +1,92 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPostRequest(t *testing.T, client *http.Client, baseURL string, cookies []*http.Cookie, content string) *http.Response {
+	body, err := json.Marshal(map[string]any{"data": apitypes.CreatePostRequest{Content: content}})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, baseURL+postsEndpoint, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	addAuthCookies(req, cookies)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestEmailVerification(t *testing.T) {
+	// Arrange: A server whose policy requires a verified email to post
+	server := httptest.NewServer(newTestApplication(db, testApplicationOptions{
+		emailVerificationPolicy: domain.NewEmailVerificationPolicy(domain.VerifiedActionPosting),
+	}).Routes())
+	defer server.Close()
+	client := server.Client()
+
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Verify", LastName: "User",
+			Email:    fmt.Sprintf("verify.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("verifyuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	user, cookies := signupAndGetCookies(t, client, server.URL, createUserDTO)
+	assert.Nil(t, user.EmailVerifiedAt, "Expected a new user to be unverified")
+
+	// Act & Assert: Posting is rejected until the email is verified
+	blockedResp := createPostRequest(t, client, server.URL, cookies, "Hello before verifying")
+	defer blockedResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, blockedResp.StatusCode)
+	var errorResponse apitypes.ApiErrorResponse
+	assert.NoError(t, json.NewDecoder(blockedResp.Body).Decode(&errorResponse))
+	if assert.Len(t, errorResponse.Errors, 1) {
+		assert.Equal(t, string(errorcodes.CodeEmailNotVerified), errorResponse.Errors[0].Code)
+	}
+
+	// Act & Assert: Resending right after signup is throttled
+	throttledResp := doWithCookies(t, client, http.MethodPost, server.URL+resendVerificationEndpoint, cookies)
+	throttledResp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, throttledResp.StatusCode)
+	assert.NotEmpty(t, throttledResp.Header.Get("Retry-After"))
+
+	// Act: Verify with the token from the signup email
+	email := lastEmailTo(t, createUserDTO.Email)
+	if !assert.NotNil(t, email, "Expected a verification email on signup") {
+		return
+	}
+	match := emailTokenPattern.FindStringSubmatch(email.Body)
+	if !assert.Len(t, match, 2, "Expected a verification link in the email body") {
+		return
+	}
+	verifyResp := postJSON(t, client, server.URL+verifyEmailEndpoint, &domain.VerifyEmailDTO{Token: match[1]})
+	verifyResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, verifyResp.StatusCode)
+
+	// Assert: Posting is now allowed and the token cannot be reused
+	allowedResp := createPostRequest(t, client, server.URL, cookies, "Hello after verifying")
+	allowedResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, allowedResp.StatusCode)
+
+	reuseResp := postJSON(t, client, server.URL+verifyEmailEndpoint, &domain.VerifyEmailDTO{Token: match[1]})
+	reuseResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, reuseResp.StatusCode)
+
+	alreadyVerifiedResp := doWithCookies(t, client, http.MethodPost, server.URL+resendVerificationEndpoint, cookies)
+	alreadyVerifiedResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, alreadyVerifiedResp.StatusCode)
+}
@@ -0,0 +1,67 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHomeFeedFlow(t *testing.T) {
+	// Arrange: Alice follows Bob and Dave but muted Dave, and does not follow Carol
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicefeed", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobfeed", domain.RoleUser)
+	_, carolToken := signupWithRole(t, client, "carolfeed", domain.RoleUser)
+	_, daveToken := signupWithRole(t, client, "davefeed", domain.RoleUser)
+	bob := currentUsername(t, client, bobToken)
+	dave := currentUsername(t, client, daveToken)
+	usersURL := testServerURL + "/api/v1/users/"
+
+	for _, action := range []string{bob + "/follow", dave + "/follow", dave + "/mute"} {
+		resp := doWithBearer(t, client, http.MethodPost, usersURL+action, aliceToken, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	bobFirst := createPostWithBearer(t, client, bobToken, "Bob's first post")
+	createPostWithBearer(t, client, carolToken, "Carol's post")
+	aliceOwn := createPostWithBearer(t, client, aliceToken, "Alice's post")
+	createPostWithBearer(t, client, daveToken, "Dave's post")
+	bobSecond := createPostWithBearer(t, client, bobToken, "Bob's second post")
+
+	// Act: Alice reads her feed two posts at a time
+	var postIds []int64
+	cursor := ""
+	for range 3 {
+		feedURL := testServerURL + "/api/v1/feed/home?limit=2"
+		if cursor != "" {
+			feedURL += "&cursor=" + url.QueryEscape(cursor)
+		}
+		resp := doWithBearer(t, client, http.MethodGet, feedURL, aliceToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var page apitypes.HomeFeedSuccessResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+		resp.Body.Close()
+
+		for _, post := range page.Data {
+			postIds = append(postIds, *post.Id)
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		cursor = *page.NextCursor
+	}
+
+	// Assert: Her own posts and Bob's, newest first, without Carol's or Dave's
+	assert.Equal(t, []int64{bobSecond, aliceOwn, bobFirst}, postIds)
+
+	// Assert: Invalid cursors are rejected
+	invalidResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/feed/home?cursor=garbage", aliceToken, nil)
+	invalidResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, invalidResp.StatusCode)
+}
@@ -0,0 +1,126 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+	"github.com/stretchr/testify/assert"
+)
+
+// currentUsername returns the username of the user authenticated by the token.
+func currentUsername(t *testing.T, client *http.Client, token string) string {
+	resp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", token, nil)
+	defer resp.Body.Close()
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&profile))
+	return profile.Data.Username
+}
+
+func decodeErrorCode(t *testing.T, resp *http.Response) errorcodes.ApiErrorCode {
+	var body apitypes.ApiErrorResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	if assert.NotEmpty(t, body.Errors) {
+		return errorcodes.ApiErrorCode(body.Errors[0].Code)
+	}
+	return ""
+}
+
+func TestFollowFlow(t *testing.T) {
+	// Arrange: Three users
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicefollow", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobfollow", domain.RoleUser)
+	carolId, carolToken := signupWithRole(t, client, "carolfollow", domain.RoleUser)
+	alice := currentUsername(t, client, aliceToken)
+	bob := currentUsername(t, client, bobToken)
+	carol := currentUsername(t, client, carolToken)
+	followURL := func(username string) string { return testServerURL + "/api/v1/users/" + username + "/follow" }
+
+	// Act: Bob and Carol follow Alice, Alice follows Bob
+	for _, follow := range []struct{ token, username string }{{bobToken, alice}, {carolToken, alice}, {aliceToken, bob}} {
+		resp := doWithBearer(t, client, http.MethodPost, followURL(follow.username), follow.token, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	// Assert: Self-follows and duplicates are rejected
+	selfResp := doWithBearer(t, client, http.MethodPost, followURL(alice), aliceToken, nil)
+	defer selfResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, selfResp.StatusCode)
+	assert.Equal(t, errorcodes.CodeCannotFollowSelf, decodeErrorCode(t, selfResp))
+
+	duplicateResp := doWithBearer(t, client, http.MethodPost, followURL(alice), bobToken, nil)
+	defer duplicateResp.Body.Close()
+	assert.Equal(t, http.StatusConflict, duplicateResp.StatusCode)
+	assert.Equal(t, errorcodes.CodeAlreadyFollowing, decodeErrorCode(t, duplicateResp))
+
+	// Assert: The lists and the profile counts reflect the follows, most recent first
+	followersResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice+"/followers", bobToken, nil)
+	defer followersResp.Body.Close()
+	var followers apitypes.ListFollowsSuccessResponse
+	assert.NoError(t, json.NewDecoder(followersResp.Body).Decode(&followers))
+	if assert.Len(t, followers.Data, 2) {
+		assert.Equal(t, carol, followers.Data[0].Username)
+		assert.Equal(t, bob, followers.Data[1].Username)
+	}
+
+	pageResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice+"/followers?limit=1", bobToken, nil)
+	defer pageResp.Body.Close()
+	var page apitypes.ListFollowsSuccessResponse
+	assert.NoError(t, json.NewDecoder(pageResp.Body).Decode(&page))
+	if assert.Len(t, page.Data, 1) && assert.NotNil(t, page.NextCursor) {
+		assert.Equal(t, carol, page.Data[0].Username)
+		assert.Contains(t, pageResp.Header.Get("Link"), `rel="next"`)
+
+		nextResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice+"/followers?limit=1&cursor="+url.QueryEscape(*page.NextCursor), bobToken, nil)
+		defer nextResp.Body.Close()
+		var next apitypes.ListFollowsSuccessResponse
+		assert.NoError(t, json.NewDecoder(nextResp.Body).Decode(&next))
+		if assert.Len(t, next.Data, 1) {
+			assert.Equal(t, bob, next.Data[0].Username)
+		}
+		assert.Nil(t, next.NextCursor)
+	}
+
+	followingResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice+"/following", bobToken, nil)
+	defer followingResp.Body.Close()
+	var following apitypes.ListFollowsSuccessResponse
+	assert.NoError(t, json.NewDecoder(followingResp.Body).Decode(&following))
+	if assert.Len(t, following.Data, 1) {
+		assert.Equal(t, bob, following.Data[0].Username)
+	}
+
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice, bobToken, nil)
+	defer profileResp.Body.Close()
+	var profile apitypes.GetPublicUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	assert.Equal(t, int64(2), profile.Data.FollowerCount)
+	assert.Equal(t, int64(1), profile.Data.FollowingCount)
+
+	// Act & Assert: Deleted users disappear from the lists and counts
+	_, err := db.Exec(`UPDATE users SET is_deleted = true WHERE id = $1`, carolId)
+	assert.NoError(t, err)
+
+	profileResp = doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+alice, bobToken, nil)
+	defer profileResp.Body.Close()
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	assert.Equal(t, int64(1), profile.Data.FollowerCount)
+
+	deletedResp := doWithBearer(t, client, http.MethodPost, followURL(carol), bobToken, nil)
+	deletedResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, deletedResp.StatusCode)
+
+	// Act & Assert: Unfollowing works once
+	unfollowResp := doWithBearer(t, client, http.MethodDelete, followURL(alice), bobToken, nil)
+	unfollowResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, unfollowResp.StatusCode)
+
+	unfollowResp = doWithBearer(t, client, http.MethodDelete, followURL(alice), bobToken, nil)
+	unfollowResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, unfollowResp.StatusCode)
+}
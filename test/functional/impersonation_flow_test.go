@@ -0,0 +1,99 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func startImpersonation(t *testing.T, client *http.Client, adminToken string, userId int64) *http.Response {
+	url := fmt.Sprintf("%s/api/v1/admin/users/%d/impersonate", testServerURL, userId)
+	return doWithBearer(t, client, http.MethodPost, url, adminToken, &domain.StartImpersonationDTO{Reason: "ticket 42"})
+}
+
+func TestImpersonationFlow(t *testing.T) {
+	// Arrange: A user, a moderator and an admin
+	client := testServer.Client()
+	userId, _ := signupWithRole(t, client, "impersonated", domain.RoleUser)
+	_, moderatorToken := signupWithRole(t, client, "impersonator", domain.RoleModerator)
+	adminId, adminToken := signupWithRole(t, client, "supportadmin", domain.RoleAdmin)
+	_, otherAdminToken := signupWithRole(t, client, "otheradmin", domain.RoleAdmin)
+
+	// Act & Assert: Only admins may impersonate, and never another admin or themselves
+	forbiddenResp := startImpersonation(t, client, moderatorToken, userId)
+	forbiddenResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+	forbiddenResp = startImpersonation(t, client, otherAdminToken, adminId)
+	forbiddenResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+	selfResp := startImpersonation(t, client, adminToken, adminId)
+	selfResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, selfResp.StatusCode)
+
+	// Act: The admin impersonates the user
+	startResp := startImpersonation(t, client, adminToken, userId)
+	defer startResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, startResp.StatusCode)
+	var started apitypes.StartImpersonationSuccessResponse
+	assert.NoError(t, json.NewDecoder(startResp.Body).Decode(&started))
+	impersonationToken := started.Data.AccessToken
+	assert.Equal(t, userId, *started.Data.User.Id)
+
+	// Assert: The token acts as the user
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", impersonationToken, nil)
+	defer profileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, profileResp.StatusCode)
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&profile))
+	assert.Equal(t, userId, *profile.Data.Id)
+
+	// Assert: Sensitive actions are rejected
+	passwordResp := doWithBearer(t, client, http.MethodPut, testServerURL+changePasswordEndpoint, impersonationToken,
+		&domain.ChangePasswordDTO{CurrentPassword: "password123", NewPassword: "password456"})
+	passwordResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, passwordResp.StatusCode)
+
+	sessionsResp := doWithBearer(t, client, http.MethodGet, testServerURL+sessionsEndpoint, impersonationToken, nil)
+	sessionsResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, sessionsResp.StatusCode)
+
+	// Assert: Every request made while impersonating is in the audit log, newest first
+	logResp := doWithBearer(t, client, http.MethodGet, testServerURL+impersonationLogEndpoint, adminToken, nil)
+	defer logResp.Body.Close()
+	assert.Equal(t, http.StatusOK, logResp.StatusCode)
+	var auditLog apitypes.ListImpersonationLogSuccessResponse
+	assert.NoError(t, json.NewDecoder(logResp.Body).Decode(&auditLog))
+
+	var entries []apitypes.ImpersonationAuditEntry
+	for _, entry := range auditLog.Data {
+		if entry.ImpersonationId == started.Data.ImpersonationId {
+			entries = append(entries, entry)
+		}
+	}
+	if assert.Len(t, entries, 3) {
+		assert.Equal(t, sessionsEndpoint, entries[0].Path)
+		assert.Equal(t, http.StatusForbidden, entries[0].Status)
+		assert.Equal(t, http.MethodPut, entries[1].Method)
+		assert.Equal(t, "/api/v1/users", entries[2].Path)
+		assert.Equal(t, http.StatusOK, entries[2].Status)
+		assert.Equal(t, adminId, entries[2].ActorId)
+		assert.Equal(t, userId, entries[2].SubjectId)
+	}
+
+	// Act: The admin ends the impersonation
+	endResp := doWithBearer(t, client, http.MethodDelete, testServerURL+impersonationsEndpoint+"/"+started.Data.ImpersonationId, adminToken, nil)
+	endResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, endResp.StatusCode)
+
+	// Assert: The token is no longer accepted
+	endedResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", impersonationToken, nil)
+	endedResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, endedResp.StatusCode)
+}
@@ -0,0 +1,69 @@
+package integration_tests
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKS(t *testing.T) {
+	client := testServer.Client()
+
+	// Act
+	resp, err := client.Get(testServerURL + jwksEndpoint)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert: The key set is public and cacheable
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Cache-Control"), "max-age")
+
+	var jwks apitypes.JSONWebKeySet
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&jwks))
+	if !assert.NotEmpty(t, jwks.Keys) {
+		return
+	}
+
+	activeKey := jwks.Keys[0]
+	assert.Equal(t, testKeyring.ActiveKeyID(), activeKey.Kid)
+	assert.Equal(t, "sig", activeKey.Use)
+	assert.NotNil(t, activeKey.X, "Expected the public key of the Ed25519 key")
+
+	// Assert: Access tokens name the published key in their header
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Jwks", LastName: "User",
+			Email:    fmt.Sprintf("jwks.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("jwksuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+	var accessToken string
+	for _, cookie := range cookies {
+		if cookie.Name == "access_token" {
+			accessToken = cookie.Value
+		}
+	}
+
+	encodedHeader, _, _ := strings.Cut(accessToken, ".")
+	rawHeader, err := base64.RawURLEncoding.DecodeString(encodedHeader)
+	assert.NoError(t, err)
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	assert.NoError(t, json.Unmarshal(rawHeader, &header))
+	assert.Equal(t, string(activeKey.Alg), header.Alg)
+	assert.Equal(t, activeKey.Kid, header.Kid)
+}
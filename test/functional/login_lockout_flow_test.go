@@ -0,0 +1,139 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/errorcodes"
+	"github.com/stretchr/testify/assert"
+)
+
+// loginFromIP attempts a login on behalf of the given client IP
+func loginFromIP(t *testing.T, client *http.Client, baseURL, ip, email, password string) *http.Response {
+	body, err := json.Marshal(map[string]any{
+		"data": &domain.LoginUserDTO{Email: email, Password: password},
+	})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, baseURL+loginEndpoint, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Real-IP", ip)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func assertAccountLocked(t *testing.T, resp *http.Response) {
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	assert.NoError(t, err, "Expected a numeric Retry-After header")
+	assert.Positive(t, retryAfter)
+
+	var errorResponse apitypes.ApiErrorResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&errorResponse))
+	if assert.NotEmpty(t, errorResponse.Errors) {
+		assert.Equal(t, string(errorcodes.CodeAccountLocked), errorResponse.Errors[0].Code)
+	}
+}
+
+// assertInvalidCredentials checks the response given to wrong credentials and locked accounts
+func assertInvalidCredentials(t *testing.T, resp *http.Response) {
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Retry-After"))
+
+	var errorResponse apitypes.ApiErrorResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&errorResponse))
+	if assert.NotEmpty(t, errorResponse.Errors) {
+		assert.Equal(t, "invalid email or password", errorResponse.Errors[0].Message)
+	}
+}
+
+func TestLoginLockout_Account(t *testing.T) {
+	// Arrange: A server that locks an account after three failures
+	server := httptest.NewServer(newTestApplication(db, testApplicationOptions{
+		loginThrottlePolicy: &domain.LoginThrottlePolicy{
+			MaxAccountFailures: 3,
+			MaxIPFailures:      1000,
+			IPFailureWindow:    time.Hour,
+			BaseLockout:        time.Minute,
+			MaxLockout:         time.Hour,
+		},
+	}).Routes())
+	defer server.Close()
+	client := server.Client()
+
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Locked", LastName: "User",
+			Email:    fmt.Sprintf("locked.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("lockeduser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	signupAndGetCookies(t, client, server.URL, createUserDTO)
+
+	// Act: Fail below the threshold
+	for i := 0; i < 2; i++ {
+		resp := loginFromIP(t, client, server.URL, "198.51.100.10", createUserDTO.Email, "wrongpassword")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	// Act: The failure reaching the threshold locks the account
+	lockingResp := loginFromIP(t, client, server.URL, "198.51.100.10", createUserDTO.Email, "wrongpassword")
+	defer lockingResp.Body.Close()
+	assertInvalidCredentials(t, lockingResp)
+
+	// Assert: The correct password is rejected while locked, from any IP, exactly like an
+	// unknown email address
+	lockedResp := loginFromIP(t, client, server.URL, "198.51.100.11", createUserDTO.Email, createUserDTO.Password)
+	defer lockedResp.Body.Close()
+	assertInvalidCredentials(t, lockedResp)
+
+	unknownResp := loginFromIP(t, client, server.URL, "198.51.100.11", "nobody"+createUserDTO.Email, createUserDTO.Password)
+	defer unknownResp.Body.Close()
+	assertInvalidCredentials(t, unknownResp)
+}
+
+func TestLoginLockout_IP(t *testing.T) {
+	// Arrange: A server that locks an IP after three failures, whatever the account
+	server := httptest.NewServer(newTestApplication(db, testApplicationOptions{
+		loginThrottlePolicy: &domain.LoginThrottlePolicy{
+			MaxAccountFailures: 1000,
+			MaxIPFailures:      3,
+			IPFailureWindow:    time.Hour,
+			BaseLockout:        time.Minute,
+			MaxLockout:         time.Hour,
+		},
+	}).Routes())
+	defer server.Close()
+	client := server.Client()
+
+	// The IP must be unique across runs against the same database.
+	seed := time.Now().UnixNano()
+	ip := fmt.Sprintf("10.%d.%d.%d", seed%250, seed/250%250, seed/62500%250)
+
+	// Act: Spray unknown accounts from one IP
+	for i := 0; i < 2; i++ {
+		resp := loginFromIP(t, client, server.URL, ip, fmt.Sprintf("nobody%d@example.com", i), "wrongpassword")
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	lockingResp := loginFromIP(t, client, server.URL, ip, "nobody2@example.com", "wrongpassword")
+	defer lockingResp.Body.Close()
+
+	// Assert
+	assertAccountLocked(t, lockingResp)
+}
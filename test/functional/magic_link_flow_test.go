@@ -0,0 +1,130 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// lastMagicLinkTokenTo returns the token of the last login link sent to the address, or "".
+func lastMagicLinkTokenTo(t *testing.T, address string) string {
+	email := lastEmailTo(t, address)
+	if email == nil || email.Subject != "Your login link" {
+		return ""
+	}
+	match := emailTokenPattern.FindStringSubmatch(email.Body)
+	if !assert.Len(t, match, 2, "Expected a token in the login link") {
+		return ""
+	}
+	return match[1]
+}
+
+func TestMagicLink(t *testing.T) {
+	// Arrange
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Magic", LastName: "User",
+			Email:    fmt.Sprintf("magic.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("magicuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	user, _ := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	// Act: Request a link
+	requestResp := postJSON(t, client, testServerURL+magicLinkEndpoint, &domain.MagicLinkRequestDTO{Email: createUserDTO.Email})
+	requestResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, requestResp.StatusCode)
+	rawToken := lastMagicLinkTokenTo(t, createUserDTO.Email)
+	assert.NotEmpty(t, rawToken)
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM user_tokens WHERE token_hash = $1`, rawToken), "Expected the token to be stored hashed")
+
+	// Act: Follow the link
+	loginResp := postJSON(t, client, testServerURL+magicLinkVerifyEndpoint, &domain.MagicLinkLoginDTO{Token: rawToken})
+	loginResp.Body.Close()
+
+	// Assert: A session starts like with a password, and the address is verified
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	assert.NotNil(t, cookieNamed(loginResp.Cookies(), domain.AccessTokenCookie))
+	assert.NotNil(t, cookieNamed(loginResp.Cookies(), domain.RefreshTokenCookie))
+	assert.Equal(t, *user.Id, *getProfile(t, client, testServerURL, loginResp.Cookies()).Id)
+	assert.Equal(t, 1, countRows(t, `SELECT COUNT(*) FROM users WHERE id = $1 AND email_verified_at IS NOT NULL`, *user.Id))
+
+	// Act & Assert: The link works once
+	reuseResp := postJSON(t, client, testServerURL+magicLinkVerifyEndpoint, &domain.MagicLinkLoginDTO{Token: rawToken})
+	reuseResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, reuseResp.StatusCode)
+}
+
+func TestMagicLink_UnknownEmail(t *testing.T) {
+	// Arrange
+	email := fmt.Sprintf("magic.nobody%d@example.com", time.Now().UnixNano())
+	client := testServer.Client()
+
+	// Act
+	resp := postJSON(t, client, testServerURL+magicLinkEndpoint, &domain.MagicLinkRequestDTO{Email: email})
+	resp.Body.Close()
+
+	// Assert: Accepted like for an account, without any email
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Nil(t, lastEmailTo(t, email))
+}
+
+func TestMagicLink_RateLimits(t *testing.T) {
+	// Arrange: A server allowing 2 links per address and 4 requests per IP
+	app := newTestApplication(db, testApplicationOptions{
+		magicLinkPolicy: &domain.MagicLinkPolicy{TokenTTL: time.Minute, Window: time.Hour, MaxPerEmail: 2, MaxPerIP: 4},
+	})
+	server := httptest.NewServer(app.Routes())
+	defer server.Close()
+	client := server.Client()
+
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Limited", LastName: "User",
+			Email:    fmt.Sprintf("magic.limited%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("magiclimited%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	signupAndGetCookies(t, client, server.URL, createUserDTO)
+
+	// Requests come from an IP of their own, as other tests request links too.
+	ip := "198.51.100.20"
+	requestLink := func(email string) int {
+		body, err := json.Marshal(map[string]any{"data": &domain.MagicLinkRequestDTO{Email: email}})
+		assert.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, server.URL+magicLinkEndpoint, bytes.NewBuffer(body))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Real-IP", ip)
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// Act & Assert: Past the limit of the address, requests are accepted but send nothing
+	assert.Equal(t, http.StatusAccepted, requestLink(createUserDTO.Email))
+	assert.Equal(t, http.StatusAccepted, requestLink(createUserDTO.Email))
+	lastToken := lastMagicLinkTokenTo(t, createUserDTO.Email)
+	assert.Equal(t, http.StatusAccepted, requestLink(createUserDTO.Email))
+	assert.Equal(t, lastToken, lastMagicLinkTokenTo(t, createUserDTO.Email), "Expected no link past the limit of the address")
+
+	// Act & Assert: Past the limit of the IP, requests are refused, whatever the address
+	assert.Equal(t, http.StatusAccepted, requestLink(fmt.Sprintf("magic.other%s@example.com", uniqueSuffix)))
+	assert.Equal(t, http.StatusTooManyRequests, requestLink(fmt.Sprintf("magic.another%s@example.com", uniqueSuffix)))
+}
@@ -0,0 +1,282 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/oidc"
+	"github.com/floroz/go-social/internal/oidc/oidctest"
+	"github.com/floroz/go-social/internal/totp"
+	"github.com/stretchr/testify/assert"
+)
+
+// oidcTestServer is an API server with the "mock" provider configured, served by provider.
+type oidcTestServer struct {
+	*httptest.Server
+	provider *oidctest.Server
+	// client does not follow redirections, to inspect every step of the flow.
+	client *http.Client
+}
+
+func newOIDCTestServer(t *testing.T) *oidcTestServer {
+	provider := oidctest.NewServer()
+	t.Cleanup(provider.Close)
+
+	// The callback URL of the provider names the API server, which needs the provider.
+	var handler http.Handler
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { handler.ServeHTTP(w, r) }))
+	t.Cleanup(server.Close)
+
+	app := newTestApplication(db, testApplicationOptions{
+		oidcProviders: []interfaces.OIDCProvider{oidc.NewProvider(provider.Config("mock", oidc.CallbackURL(server.URL, "mock")), nil)},
+	})
+	handler = app.Routes()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	return &oidcTestServer{Server: server, provider: provider, client: client}
+}
+
+// startLogin starts a login and follows the provider back to the callback URL, which it
+// returns along with the state cookie.
+func (s *oidcTestServer) startLogin(t *testing.T) (string, *http.Cookie) {
+	startResp, err := s.client.Get(s.URL + oidcEndpoint + "/mock")
+	assert.NoError(t, err)
+	startResp.Body.Close()
+	assert.Equal(t, http.StatusFound, startResp.StatusCode)
+	stateCookie := cookieNamed(startResp.Cookies(), domain.OIDCStateCookie)
+	assert.NotNil(t, stateCookie)
+	assert.True(t, stateCookie.HttpOnly)
+
+	authorizeResp, err := s.client.Get(startResp.Header.Get("Location"))
+	assert.NoError(t, err)
+	authorizeResp.Body.Close()
+	assert.Equal(t, http.StatusFound, authorizeResp.StatusCode)
+
+	callbackURL := authorizeResp.Header.Get("Location")
+	assert.True(t, strings.HasPrefix(callbackURL, s.URL+oidcEndpoint+"/mock/callback"))
+
+	return callbackURL, stateCookie
+}
+
+// login completes a whole login and returns the response of the callback.
+func (s *oidcTestServer) login(t *testing.T) *http.Response {
+	callbackURL, stateCookie := s.startLogin(t)
+
+	req, err := http.NewRequest(http.MethodGet, callbackURL, nil)
+	assert.NoError(t, err)
+	req.AddCookie(stateCookie)
+
+	resp, err := s.client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func getProfile(t *testing.T, client *http.Client, baseURL string, cookies []*http.Cookie) apitypes.User {
+	resp := doWithCookies(t, client, http.MethodGet, baseURL+"/api/v1/users", cookies)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var profile apitypes.GetUserProfileSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&profile))
+	return profile.Data
+}
+
+func TestOIDCLogin_CreatesPasswordlessUser(t *testing.T) {
+	// Arrange
+	server := newOIDCTestServer(t)
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	email := fmt.Sprintf("oidc.new%s@example.com", uniqueSuffix)
+	server.provider.SetIdentity(oidctest.Identity{
+		Subject: "new-" + uniqueSuffix, Email: email, EmailVerified: true,
+		GivenName: "Olivia", FamilyName: "Dell", PreferredUsername: "olivia.dell",
+	})
+
+	// Act
+	resp := server.login(t)
+	resp.Body.Close()
+
+	// Assert: The browser is sent to the frontend, logged in
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, env.GetAppURL()+"/", resp.Header.Get("Location"))
+	assert.NotNil(t, cookieNamed(resp.Cookies(), domain.AccessTokenCookie))
+
+	profile := getProfile(t, server.client, server.URL, resp.Cookies())
+	assert.Equal(t, email, string(profile.Email))
+	assert.Equal(t, "Olivia", profile.FirstName)
+	assert.True(t, strings.HasPrefix(profile.Username, "oliviadell"))
+	assert.Equal(t, 1, countRows(t, `SELECT COUNT(*) FROM users WHERE id = $1 AND password IS NULL AND email_verified_at IS NOT NULL`, *profile.Id))
+
+	// Assert: Logging in again uses the same user
+	againResp := server.login(t)
+	againResp.Body.Close()
+	assert.Equal(t, http.StatusFound, againResp.StatusCode)
+	assert.Equal(t, *profile.Id, *getProfile(t, server.client, server.URL, againResp.Cookies()).Id)
+	assert.Equal(t, 1, countRows(t, `SELECT COUNT(*) FROM user_identities WHERE user_id = $1`, *profile.Id))
+
+	// Assert: The user has no password to log in with
+	passwordResp := postJSON(t, server.client, server.URL+loginEndpoint, &domain.LoginUserDTO{Email: email, Password: "password123"})
+	passwordResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, passwordResp.StatusCode)
+}
+
+func TestOIDCLogin_LinksVerifiedEmail(t *testing.T) {
+	// Arrange: A user with a verified email address
+	server := newOIDCTestServer(t)
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Linked", LastName: "User",
+			Email:    fmt.Sprintf("linked.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("linkeduser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	user, _ := signupAndGetCookies(t, server.client, server.URL, createUserDTO)
+	server.provider.SetIdentity(oidctest.Identity{Subject: "linked-" + uniqueSuffix, Email: createUserDTO.Email, EmailVerified: true})
+
+	// Act & Assert: The address is not verified yet
+	unverifiedResp := server.login(t)
+	unverifiedResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, unverifiedResp.StatusCode)
+
+	_, err := db.Exec(`UPDATE users SET email_verified_at = NOW() WHERE id = $1`, *user.Id)
+	assert.NoError(t, err)
+
+	// Act
+	resp := server.login(t)
+	resp.Body.Close()
+
+	// Assert: The identity is linked to the existing user, who keeps their password
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, *user.Id, *getProfile(t, server.client, server.URL, resp.Cookies()).Id)
+	loginAndGetCookies(t, server.client, createUserDTO.Email, createUserDTO.Password, "password-login")
+}
+
+func TestOIDCLogin_TwoFactorChallengeCookie(t *testing.T) {
+	// Arrange: A user who logged in with the provider and enabled two-factor authentication
+	server := newOIDCTestServer(t)
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	server.provider.SetIdentity(oidctest.Identity{
+		Subject: "mfa-" + uniqueSuffix, Email: fmt.Sprintf("oidc.mfa%s@example.com", uniqueSuffix), EmailVerified: true,
+	})
+	firstResp := server.login(t)
+	firstResp.Body.Close()
+	cookies := firstResp.Cookies()
+
+	enrollResp := postJSONWithCookies(t, server.client, server.URL+twoFactorEnrollEndpoint, cookies, nil)
+	defer enrollResp.Body.Close()
+	var enrollment apitypes.TOTPEnrollmentSuccessResponse
+	assert.NoError(t, json.NewDecoder(enrollResp.Body).Decode(&enrollment))
+	step := totp.Step(time.Now())
+	code, err := totp.GenerateCode(enrollment.Data.Secret, step)
+	assert.NoError(t, err)
+	confirmResp := postJSONWithCookies(t, server.client, server.URL+twoFactorConfirmEndpoint, cookies, &domain.ConfirmTOTPDTO{Code: code})
+	confirmResp.Body.Close()
+	assert.Equal(t, http.StatusOK, confirmResp.StatusCode)
+
+	// Act
+	resp := server.login(t)
+	resp.Body.Close()
+
+	// Assert: The browser is sent to the two-factor page, with the challenge in a cookie only
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, env.GetAppURL()+"/login/mfa", resp.Header.Get("Location"))
+	assert.Nil(t, cookieNamed(resp.Cookies(), domain.AccessTokenCookie))
+	challengeCookie := cookieNamed(resp.Cookies(), domain.MFAChallengeCookie)
+	if !assert.NotNil(t, challengeCookie) {
+		return
+	}
+	assert.True(t, challengeCookie.HttpOnly)
+
+	// Act: Complete the login with a code only
+	nextCode, err := totp.GenerateCode(enrollment.Data.Secret, step+1)
+	assert.NoError(t, err)
+	mfaResp := postJSONWithCookies(t, server.client, server.URL+mfaLoginEndpoint, []*http.Cookie{challengeCookie}, &domain.VerifyMFAChallengeDTO{Code: nextCode})
+	mfaResp.Body.Close()
+
+	// Assert: The user is logged in and the challenge cookie is deleted
+	assert.Equal(t, http.StatusOK, mfaResp.StatusCode)
+	assert.NotNil(t, cookieNamed(mfaResp.Cookies(), domain.AccessTokenCookie))
+	if deleted := cookieNamed(mfaResp.Cookies(), domain.MFAChallengeCookie); assert.NotNil(t, deleted) {
+		assert.Equal(t, -1, deleted.MaxAge)
+	}
+}
+
+func TestOIDCLogin_RejectsUnverifiedProviderEmail(t *testing.T) {
+	// Arrange
+	server := newOIDCTestServer(t)
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	email := fmt.Sprintf("oidc.unverified%s@example.com", uniqueSuffix)
+	server.provider.SetIdentity(oidctest.Identity{Subject: "unverified-" + uniqueSuffix, Email: email, EmailVerified: false})
+
+	// Act
+	resp := server.login(t)
+	resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Nil(t, cookieNamed(resp.Cookies(), domain.AccessTokenCookie))
+	assert.Equal(t, 0, countRows(t, `SELECT COUNT(*) FROM users WHERE email = $1`, email))
+}
+
+func TestOIDCLogin_RejectsForeignState(t *testing.T) {
+	// Arrange: A login started in another browser
+	server := newOIDCTestServer(t)
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	server.provider.SetIdentity(oidctest.Identity{Subject: "foreign-" + uniqueSuffix, Email: fmt.Sprintf("oidc.foreign%s@example.com", uniqueSuffix), EmailVerified: true})
+	callbackURL, _ := server.startLogin(t)
+	_, ownStateCookie := server.startLogin(t)
+
+	// Act & Assert: Without the state cookie, or with the one of another login
+	withoutCookieResp, err := server.client.Get(callbackURL)
+	assert.NoError(t, err)
+	withoutCookieResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, withoutCookieResp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, callbackURL, nil)
+	assert.NoError(t, err)
+	req.AddCookie(ownStateCookie)
+	otherCookieResp, err := server.client.Do(req)
+	assert.NoError(t, err)
+	otherCookieResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, otherCookieResp.StatusCode)
+	assert.Nil(t, cookieNamed(otherCookieResp.Cookies(), domain.AccessTokenCookie))
+
+	// Act & Assert: A provider error ends the login
+	errorResp, err := server.client.Get(server.URL + oidcEndpoint + "/mock/callback?" + url.Values{"error": {"access_denied"}}.Encode())
+	assert.NoError(t, err)
+	errorResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, errorResp.StatusCode)
+}
+
+func TestOIDCProviders(t *testing.T) {
+	// Arrange
+	server := newOIDCTestServer(t)
+
+	// Act
+	resp, err := server.client.Get(server.URL + oidcEndpoint)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var providers apitypes.OIDCProvidersSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&providers))
+	assert.Equal(t, []string{"mock"}, providers.Data)
+
+	unknownResp, err := server.client.Get(server.URL + oidcEndpoint + "/unknown")
+	assert.NoError(t, err)
+	unknownResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, unknownResp.StatusCode)
+}
@@ -0,0 +1,94 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func postJSON(t *testing.T, client *http.Client, url string, data any) *http.Response {
+	body, err := json.Marshal(map[string]any{"data": data})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestPasswordReset(t *testing.T) {
+	// Arrange: Sign up a user, which also opens a session
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Reset", LastName: "User",
+			Email:    fmt.Sprintf("reset.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("resetuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	// Act: Request a reset and pick the token from the email
+	forgotResp := postJSON(t, client, testServerURL+forgotPasswordEndpoint, &domain.ForgotPasswordDTO{Email: createUserDTO.Email})
+	forgotResp.Body.Close()
+	assert.Equal(t, http.StatusAccepted, forgotResp.StatusCode)
+
+	email := lastEmailTo(t, createUserDTO.Email)
+	if !assert.NotNil(t, email, "Expected a password reset email") {
+		return
+	}
+	match := emailTokenPattern.FindStringSubmatch(email.Body)
+	if !assert.Len(t, match, 2, "Expected a reset link in the email body") {
+		return
+	}
+	resetToken := match[1]
+
+	resetResp := postJSON(t, client, testServerURL+resetPasswordEndpoint, &domain.ResetPasswordDTO{Token: resetToken, Password: "newpassword123"})
+	resetResp.Body.Close()
+
+	// Assert: The password changed and the existing session was revoked
+	assert.Equal(t, http.StatusNoContent, resetResp.StatusCode)
+
+	sessionsResp := doWithCookies(t, client, http.MethodGet, testServerURL+sessionsEndpoint, cookies)
+	sessionsResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, sessionsResp.StatusCode, "Expected sessions opened before the reset to be revoked")
+
+	oldLoginResp := postJSON(t, client, testServerURL+loginEndpoint, &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password})
+	oldLoginResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, oldLoginResp.StatusCode, "Expected the old password to be rejected")
+
+	loginAndGetCookies(t, client, createUserDTO.Email, "newpassword123", "test-agent")
+
+	// Assert: The token cannot be used twice
+	reuseResp := postJSON(t, client, testServerURL+resetPasswordEndpoint, &domain.ResetPasswordDTO{Token: resetToken, Password: "anotherpassword123"})
+	reuseResp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, reuseResp.StatusCode)
+}
+
+func TestForgotPassword_UnknownEmail(t *testing.T) {
+	// Act
+	resp := postJSON(t, testServer.Client(), testServerURL+forgotPasswordEndpoint, &domain.ForgotPasswordDTO{Email: "nobody@example.com"})
+	defer resp.Body.Close()
+
+	// Assert: Same response as for an existing account
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestResetPassword_InvalidToken(t *testing.T) {
+	// Act
+	resp := postJSON(t, testServer.Client(), testServerURL+resetPasswordEndpoint, &domain.ResetPasswordDTO{Token: "invalid", Password: "newpassword123"})
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,123 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// doWithBearer sends a request authenticated with "Authorization: Bearer <token>"
+func doWithBearer(t *testing.T, client *http.Client, method, url, token string, data any) *http.Response {
+	var body []byte
+	if data != nil {
+		var err error
+		body, err = json.Marshal(map[string]any{"data": data})
+		assert.NoError(t, err)
+	}
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestPersonalAccessTokens(t *testing.T) {
+	// Arrange: Sign up a user
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Token", LastName: "User",
+			Email:    fmt.Sprintf("token.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("tokenuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	// Act: Create a read-only token for posts
+	createResp := postJSONWithCookies(t, client, testServerURL+personalAccessTokensEndpoint, cookies, &domain.CreatePersonalAccessTokenDTO{
+		Name:   "reader",
+		Scopes: []domain.Scope{domain.ScopePostsRead},
+	})
+	defer createResp.Body.Close()
+	assert.Equal(t, http.StatusCreated, createResp.StatusCode)
+
+	var created apitypes.CreatePersonalAccessTokenSuccessResponse
+	assert.NoError(t, json.NewDecoder(createResp.Body).Decode(&created))
+	rawToken := created.Data.Token
+	assert.NotEmpty(t, rawToken)
+
+	// Assert: The token is usable within its scopes only
+	listPostsResp := doWithBearer(t, client, http.MethodGet, testServerURL+postsEndpoint, rawToken, nil)
+	listPostsResp.Body.Close()
+	assert.Equal(t, http.StatusOK, listPostsResp.StatusCode)
+
+	createPostResp := doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, rawToken, &domain.CreatePostDTO{
+		EditablePostFields: domain.EditablePostFields{Content: "Written with a read-only token"},
+	})
+	createPostResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, createPostResp.StatusCode)
+
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", rawToken, nil)
+	profileResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, profileResp.StatusCode)
+
+	// Assert: A token cannot manage tokens
+	tokenListResp := doWithBearer(t, client, http.MethodGet, testServerURL+personalAccessTokensEndpoint, rawToken, nil)
+	tokenListResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, tokenListResp.StatusCode)
+
+	// Assert: The owner sees the token without its value
+	listResp := doWithCookies(t, client, http.MethodGet, testServerURL+personalAccessTokensEndpoint, cookies)
+	defer listResp.Body.Close()
+	assert.Equal(t, http.StatusOK, listResp.StatusCode)
+
+	var listed apitypes.ListPersonalAccessTokensSuccessResponse
+	assert.NoError(t, json.NewDecoder(listResp.Body).Decode(&listed))
+	if assert.Len(t, listed.Data, 1) {
+		assert.Equal(t, created.Data.Id, listed.Data[0].Id)
+		assert.NotNil(t, listed.Data[0].LastUsedAt, "Expected the last use to be recorded")
+	}
+
+	// Act: Revoke the token
+	revokeURL := fmt.Sprintf("%s%s/%d", testServerURL, personalAccessTokensEndpoint, created.Data.Id)
+	revokeResp := doWithCookies(t, client, http.MethodDelete, revokeURL, cookies)
+	revokeResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, revokeResp.StatusCode)
+
+	// Assert: The revoked token is rejected
+	revokedResp := doWithBearer(t, client, http.MethodGet, testServerURL+postsEndpoint, rawToken, nil)
+	revokedResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, revokedResp.StatusCode)
+}
+
+func TestPersonalAccessTokens_ReservedUsername(t *testing.T) {
+	// Arrange: A user named after the tokens route would be shadowed by it
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Token", LastName: "User",
+			Email:    fmt.Sprintf("tokens%s@example.com", uniqueSuffix),
+			Username: "tokens",
+		},
+		Password: "password123",
+	}
+
+	// Act
+	resp := postJSON(t, testServer.Client(), testServerURL+signupEndpoint, createUserDTO)
+	defer resp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
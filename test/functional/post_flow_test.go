@@ -47,9 +47,7 @@ func TestCreatePost(t *testing.T) {
 	assert.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
 	// Add auth cookies
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
-	}
+	addAuthCookies(req, cookies)
 	client := testServer.Client()
 
 	// Act: Perform create post request
@@ -105,9 +103,7 @@ func TestGetPost(t *testing.T) {
 	createBody, _ := json.Marshal(createPayload)
 	createReq, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(createBody))
 	createReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		createReq.AddCookie(cookie)
-	}
+	addAuthCookies(createReq, cookies)
 	client := testServer.Client()
 	createResp, _ := client.Do(createReq)
 	assert.Equal(t, http.StatusCreated, createResp.StatusCode)
@@ -119,9 +115,7 @@ func TestGetPost(t *testing.T) {
 	// --- Test Case 1: Get existing post ---
 	getReq, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, createdPostId), nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getReq.AddCookie(cookie)
-	} // Add auth cookies
+	addAuthCookies(getReq, cookies) // Add auth cookies
 
 	// Act: Perform get post request
 	getResp, err := client.Do(getReq)
@@ -145,9 +139,7 @@ func TestGetPost(t *testing.T) {
 	nonExistentId := int64(999999)
 	getNonExistentReq, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, nonExistentId), nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getNonExistentReq.AddCookie(cookie)
-	}
+	addAuthCookies(getNonExistentReq, cookies)
 
 	// Act: Perform get non-existent post request
 	getNonExistentResp, err := client.Do(getNonExistentReq)
@@ -196,9 +188,7 @@ func TestListPosts(t *testing.T) {
 	body1, _ := json.Marshal(payload1)
 	req1, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(body1))
 	req1.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		req1.AddCookie(cookie)
-	}
+	addAuthCookies(req1, cookies)
 	resp1, _ := client.Do(req1)
 	assert.Equal(t, http.StatusCreated, resp1.StatusCode)
 	resp1.Body.Close()
@@ -209,9 +199,7 @@ func TestListPosts(t *testing.T) {
 	body2, _ := json.Marshal(payload2)
 	req2, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(body2))
 	req2.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		req2.AddCookie(cookie)
-	}
+	addAuthCookies(req2, cookies)
 	resp2, _ := client.Do(req2)
 	assert.Equal(t, http.StatusCreated, resp2.StatusCode)
 	resp2.Body.Close()
@@ -219,9 +207,7 @@ func TestListPosts(t *testing.T) {
 	// --- Test Case: List posts ---
 	listReq, err := http.NewRequest(http.MethodGet, testServerURL+postsEndpoint, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		listReq.AddCookie(cookie)
-	} // Add auth cookies
+	addAuthCookies(listReq, cookies) // Add auth cookies
 
 	// Act: Perform list posts request
 	listResp, err := client.Do(listReq)
@@ -281,9 +267,7 @@ func TestUpdatePost(t *testing.T) {
 	createBody, _ := json.Marshal(createPayload)
 	createReq, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(createBody))
 	createReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		createReq.AddCookie(cookie)
-	}
+	addAuthCookies(createReq, cookies)
 	createResp, _ := client.Do(createReq)
 	assert.Equal(t, http.StatusCreated, createResp.StatusCode)
 	var createRespData apitypes.CreatePostSuccessResponse
@@ -300,9 +284,7 @@ func TestUpdatePost(t *testing.T) {
 	updateReq, err := http.NewRequest(http.MethodPut, updateUrl, bytes.NewBuffer(updateBody))
 	assert.NoError(t, err)
 	updateReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		updateReq.AddCookie(cookie)
-	}
+	addAuthCookies(updateReq, cookies)
 
 	// Act: Perform update post request
 	updateResp, err := client.Do(updateReq)
@@ -332,9 +314,7 @@ func TestUpdatePost(t *testing.T) {
 	updateNonExistentUrl := fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, nonExistentId)
 	updateNonExistentHttpReq, _ := http.NewRequest(http.MethodPut, updateNonExistentUrl, bytes.NewBuffer(updateNonExistentBody))
 	updateNonExistentHttpReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		updateNonExistentHttpReq.AddCookie(cookie)
-	}
+	addAuthCookies(updateNonExistentHttpReq, cookies)
 
 	// Act & Assert
 	updateNonExistentResp, err := client.Do(updateNonExistentHttpReq)
@@ -367,9 +347,7 @@ func TestUpdatePost(t *testing.T) {
 	updateOtherBody, _ := json.Marshal(updateOtherPayload)
 	updateOtherHttpReq, _ := http.NewRequest(http.MethodPut, updateUrl, bytes.NewBuffer(updateOtherBody)) // Use original post URL
 	updateOtherHttpReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range otherCookies {
-		updateOtherHttpReq.AddCookie(cookie)
-	} // Use OTHER user's cookies
+	addAuthCookies(updateOtherHttpReq, otherCookies) // Use OTHER user's cookies
 
 	// Assert
 	updateOtherResp, err := client.Do(updateOtherHttpReq)
@@ -409,9 +387,7 @@ func TestDeletePost(t *testing.T) {
 	createBody, _ := json.Marshal(createPayload)
 	createReq, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(createBody))
 	createReq.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		createReq.AddCookie(cookie)
-	}
+	addAuthCookies(createReq, cookies)
 	createResp, _ := client.Do(createReq)
 	assert.Equal(t, http.StatusCreated, createResp.StatusCode)
 	var createRespData apitypes.CreatePostSuccessResponse
@@ -423,9 +399,7 @@ func TestDeletePost(t *testing.T) {
 	deleteUrl := fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, createdPostId)
 	deleteReq, err := http.NewRequest(http.MethodDelete, deleteUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		deleteReq.AddCookie(cookie)
-	}
+	addAuthCookies(deleteReq, cookies)
 
 	// Act: Perform delete post request
 	deleteResp, err := client.Do(deleteReq)
@@ -438,9 +412,7 @@ func TestDeletePost(t *testing.T) {
 	// Assert: Verify post is actually deleted (GET should return 404)
 	getReq, err := http.NewRequest(http.MethodGet, deleteUrl, nil)
 	assert.NoError(t, err)
-	for _, cookie := range cookies {
-		getReq.AddCookie(cookie)
-	}
+	addAuthCookies(getReq, cookies)
 	getResp, err := client.Do(getReq)
 	assert.NoError(t, err)
 	if getResp != nil {
@@ -452,9 +424,7 @@ func TestDeletePost(t *testing.T) {
 	nonExistentId := int64(999999)
 	deleteNonExistentUrl := fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, nonExistentId)
 	deleteNonExistentReq, _ := http.NewRequest(http.MethodDelete, deleteNonExistentUrl, nil)
-	for _, cookie := range cookies {
-		deleteNonExistentReq.AddCookie(cookie)
-	}
+	addAuthCookies(deleteNonExistentReq, cookies)
 
 	// Act & Assert
 	deleteNonExistentResp, err := client.Do(deleteNonExistentReq)
@@ -471,9 +441,7 @@ func TestDeletePost(t *testing.T) {
 	createBody2, _ := json.Marshal(createPayload2)
 	createReq2, _ := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(createBody2))
 	createReq2.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		createReq2.AddCookie(cookie)
-	}
+	addAuthCookies(createReq2, cookies)
 	createResp2, _ := client.Do(createReq2)
 	assert.Equal(t, http.StatusCreated, createResp2.StatusCode)
 	var createRespData2 apitypes.CreatePostSuccessResponse
@@ -496,9 +464,7 @@ func TestDeletePost(t *testing.T) {
 	// Act: Try to delete the first user's post with the second user's cookies
 	deleteOtherUrl := fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, postToKeepId)
 	deleteOtherHttpReq, _ := http.NewRequest(http.MethodDelete, deleteOtherUrl, nil)
-	for _, cookie := range otherCookies {
-		deleteOtherHttpReq.AddCookie(cookie)
-	} // Use OTHER user's cookies
+	addAuthCookies(deleteOtherHttpReq, otherCookies) // Use OTHER user's cookies
 
 	// Assert
 	deleteOtherResp, err := client.Do(deleteOtherHttpReq)
@@ -536,9 +502,7 @@ func TestCreatePost_ValidationErrors(t *testing.T) {
 	reqEmpty, err := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(bodyEmpty))
 	assert.NoError(t, err)
 	reqEmpty.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies {
-		reqEmpty.AddCookie(cookie)
-	}
+	addAuthCookies(reqEmpty, cookies)
 
 	// Act: Perform create post request with empty content
 	respEmpty, err := client.Do(reqEmpty)
@@ -585,9 +549,7 @@ func TestCreatePost_ValidationErrors(t *testing.T) {
 	reqLong, err := http.NewRequest(http.MethodPost, testServerURL+postsEndpoint, bytes.NewBuffer(bodyLong))
 	assert.NoError(t, err)
 	reqLong.Header.Set("Content-Type", "application/json")
-	for _, cookie := range cookies { // Reuse cookies from setup
-		reqLong.AddCookie(cookie)
-	}
+	addAuthCookies(reqLong, cookies) // Reuse cookies from setup
 
 	// Act: Perform create post request with long content
 	respLong, err := client.Do(reqLong)
@@ -0,0 +1,75 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicProfileFlow(t *testing.T) {
+	// Arrange: An author with three posts, one of them deleted, and a reader
+	client := testServer.Client()
+	_, authorToken := signupWithRole(t, client, "profileauthor", domain.RoleUser)
+	_, readerToken := signupWithRole(t, client, "profilereader", domain.RoleUser)
+
+	username := currentUsername(t, client, authorToken)
+
+	var postIds []int64
+	for i := range 3 {
+		resp := doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, authorToken, &apitypes.CreatePostRequest{Content: fmt.Sprintf("Post %d", i)})
+		var created apitypes.CreatePostSuccessResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		resp.Body.Close()
+		postIds = append(postIds, *created.Data.Id)
+	}
+	deleteResp := doWithBearer(t, client, http.MethodDelete, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, postIds[0]), authorToken, nil)
+	deleteResp.Body.Close()
+
+	// Act: The reader views the author's profile
+	profileResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+username, readerToken, nil)
+	defer profileResp.Body.Close()
+
+	// Assert: Counts are included, private fields are not
+	assert.Equal(t, http.StatusOK, profileResp.StatusCode)
+	var raw map[string]map[string]any
+	assert.NoError(t, json.NewDecoder(profileResp.Body).Decode(&raw))
+	assert.Equal(t, username, raw["data"]["username"])
+	assert.Equal(t, float64(2), raw["data"]["post_count"])
+	assert.Equal(t, float64(0), raw["data"]["comment_count"])
+	assert.NotContains(t, raw["data"], "email")
+	assert.NotContains(t, raw["data"], "last_login")
+
+	// Act & Assert: The author's posts are listed newest first and paginated
+	postsResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/"+username+"/posts?limit=1", readerToken, nil)
+	defer postsResp.Body.Close()
+	assert.Equal(t, http.StatusOK, postsResp.StatusCode)
+	var firstPage apitypes.ListPostsSuccessResponse
+	assert.NoError(t, json.NewDecoder(postsResp.Body).Decode(&firstPage))
+	if assert.Len(t, firstPage.Data, 1) {
+		assert.Equal(t, postIds[2], *firstPage.Data[0].Id)
+	}
+	assert.NotNil(t, firstPage.NextCursor)
+	link := postsResp.Header.Get("Link")
+	assert.Contains(t, link, `rel="next"`)
+
+	postsResp = doWithBearer(t, client, http.MethodGet, testServerURL+strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`), readerToken, nil)
+	defer postsResp.Body.Close()
+	var secondPage apitypes.ListPostsSuccessResponse
+	assert.NoError(t, json.NewDecoder(postsResp.Body).Decode(&secondPage))
+	if assert.Len(t, secondPage.Data, 1) {
+		assert.Equal(t, postIds[1], *secondPage.Data[0].Id)
+	}
+	assert.Nil(t, secondPage.NextCursor)
+	assert.Empty(t, postsResp.Header.Get("Link"))
+
+	// Act & Assert: Unknown users are not found
+	missingResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users/nobody-here/posts", readerToken, nil)
+	missingResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+}
@@ -0,0 +1,119 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// signupWithRole signs up a user, assigns the role directly in the database as an operator
+// would, then logs in so that the access token carries the role.
+func signupWithRole(t *testing.T, client *http.Client, name string, role domain.Role) (int64, string) {
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Rbac", LastName: "User",
+			Email:    fmt.Sprintf("%s%s@example.com", name, uniqueSuffix),
+			Username: fmt.Sprintf("%s%s", name, uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	user, _ := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	_, err := db.Exec(`UPDATE users SET role = $1 WHERE id = $2`, role, *user.Id)
+	assert.NoError(t, err)
+
+	loginResp := postJSONForTokens(t, client, testServerURL+loginEndpoint, &domain.LoginUserDTO{Email: createUserDTO.Email, Password: createUserDTO.Password})
+	defer loginResp.Body.Close()
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+
+	return *user.Id, decodeTokens(t, loginResp).Token
+}
+
+func TestRoleBasedAccessControl(t *testing.T) {
+	// Arrange: An author with a post and a comment, and users of every role
+	client := testServer.Client()
+	authorId, authorToken := signupWithRole(t, client, "author", domain.RoleUser)
+	_, userToken := signupWithRole(t, client, "bystander", domain.RoleUser)
+	moderatorId, moderatorToken := signupWithRole(t, client, "moderator", domain.RoleModerator)
+	_, adminToken := signupWithRole(t, client, "admin", domain.RoleAdmin)
+
+	createPostResp := doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, authorToken, &apitypes.CreatePostRequest{Content: "Buy cheap watches!"})
+	defer createPostResp.Body.Close()
+	var createdPost apitypes.CreatePostSuccessResponse
+	assert.NoError(t, json.NewDecoder(createPostResp.Body).Decode(&createdPost))
+	postURL := fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, *createdPost.Data.Id)
+
+	createCommentResp := doWithBearer(t, client, http.MethodPost, postURL+"/comments", authorToken, &apitypes.CreateCommentRequest{Content: "Really cheap!"})
+	defer createCommentResp.Body.Close()
+	var createdComment apitypes.CreateCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(createCommentResp.Body).Decode(&createdComment))
+	commentURL := fmt.Sprintf("%s/comments/%d", postURL, *createdComment.Data.Id)
+
+	// Act & Assert: Users cannot touch content they do not own
+	forbiddenResp := doWithBearer(t, client, http.MethodPut, postURL, userToken, &apitypes.UpdatePostRequest{Content: "hijacked"})
+	forbiddenResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+	forbiddenResp = doWithBearer(t, client, http.MethodGet, testServerURL+moderationLogEndpoint, userToken, nil)
+	forbiddenResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+	// Act & Assert: A moderator edits the comment and deletes the post
+	editResp := doWithBearer(t, client, http.MethodPut, commentURL, moderatorToken, &apitypes.UpdateCommentRequest{Content: "[removed by a moderator]"})
+	defer editResp.Body.Close()
+	assert.Equal(t, http.StatusOK, editResp.StatusCode)
+	var editedComment apitypes.UpdateCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(editResp.Body).Decode(&editedComment))
+	assert.Equal(t, authorId, *editedComment.Data.UserId, "Expected the comment to keep its author")
+
+	deleteResp := doWithBearer(t, client, http.MethodDelete, postURL, moderatorToken, nil)
+	deleteResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode)
+
+	// Assert: Both overrides are in the moderation log, newest first
+	logResp := doWithBearer(t, client, http.MethodGet, testServerURL+moderationLogEndpoint+"?limit=100", moderatorToken, nil)
+	defer logResp.Body.Close()
+	assert.Equal(t, http.StatusOK, logResp.StatusCode)
+	var moderationLog apitypes.ListModerationLogSuccessResponse
+	assert.NoError(t, json.NewDecoder(logResp.Body).Decode(&moderationLog))
+
+	var moderatorEntries []apitypes.ModerationLogEntry
+	for _, entry := range moderationLog.Data {
+		if entry.ActorId == moderatorId {
+			moderatorEntries = append(moderatorEntries, entry)
+		}
+	}
+	if assert.Len(t, moderatorEntries, 2) {
+		assert.Equal(t, apitypes.ModerationLogEntryAction("delete"), moderatorEntries[0].Action)
+		assert.Equal(t, "Buy cheap watches!", moderatorEntries[0].PreviousContent)
+		assert.Equal(t, apitypes.ModerationLogEntryAction("update"), moderatorEntries[1].Action)
+		assert.Equal(t, "Really cheap!", moderatorEntries[1].PreviousContent)
+		assert.Equal(t, authorId, moderatorEntries[1].TargetUserId)
+	}
+
+	// Act & Assert: Only admins manage roles, and a new role signs the user out
+	roleURL := fmt.Sprintf("%s/api/v1/admin/users/%d/role", testServerURL, authorId)
+	forbiddenResp = doWithBearer(t, client, http.MethodPut, roleURL, moderatorToken, &domain.UpdateUserRoleDTO{Role: domain.RoleAdmin})
+	forbiddenResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+	roleResp := doWithBearer(t, client, http.MethodPut, roleURL, adminToken, &domain.UpdateUserRoleDTO{Role: domain.RoleModerator})
+	defer roleResp.Body.Close()
+	assert.Equal(t, http.StatusOK, roleResp.StatusCode)
+	var promoted apitypes.UpdateUserRoleSuccessResponse
+	assert.NoError(t, json.NewDecoder(roleResp.Body).Decode(&promoted))
+	if assert.NotNil(t, promoted.Data.Role) {
+		assert.Equal(t, apitypes.UserRole("moderator"), *promoted.Data.Role)
+	}
+
+	revokedResp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/users", authorToken, nil)
+	revokedResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, revokedResp.StatusCode)
+}
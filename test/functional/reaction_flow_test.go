@@ -0,0 +1,137 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func getPostWithBearer(t *testing.T, client *http.Client, token string, postId int64) apitypes.Post {
+	resp := doWithBearer(t, client, http.MethodGet, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, postId), token, nil)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var post apitypes.GetPostSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&post))
+	return post.Data
+}
+
+func TestPostReactionFlow(t *testing.T) {
+	// Arrange: Alice posts, Bob reads it
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicereact", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobreact", domain.RoleUser)
+	postId := createPostWithBearer(t, client, aliceToken, "React to this")
+	reactionsURL := fmt.Sprintf("%s%s/%d/reactions/", testServerURL, postsEndpoint, postId)
+
+	// Act: Bob likes the post twice and loves it, Alice likes it
+	for _, reaction := range []struct{ token, reactionType string }{
+		{bobToken, "like"}, {bobToken, "like"}, {bobToken, "love"}, {aliceToken, "like"},
+	} {
+		resp := doWithBearer(t, client, http.MethodPut, reactionsURL+reaction.reactionType, reaction.token, nil)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	// Assert: One reaction of each type per user, and each sees their own
+	post := getPostWithBearer(t, client, bobToken, postId)
+	assert.Equal(t, map[string]int64{"like": 2, "love": 1}, *post.ReactionCounts)
+	assert.ElementsMatch(t, []string{"like", "love"}, *post.MyReactions)
+	post = getPostWithBearer(t, client, aliceToken, postId)
+	assert.Equal(t, []string{"like"}, *post.MyReactions)
+
+	// Act: Bob takes back his love
+	resp := doWithBearer(t, client, http.MethodDelete, reactionsURL+"love", bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Assert: The love is gone, and cannot be removed twice
+	post = getPostWithBearer(t, client, bobToken, postId)
+	assert.Equal(t, map[string]int64{"like": 2}, *post.ReactionCounts)
+	assert.Equal(t, []string{"like"}, *post.MyReactions)
+	resp = doWithBearer(t, client, http.MethodDelete, reactionsURL+"love", bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Assert: Types outside the reaction set are rejected
+	resp = doWithBearer(t, client, http.MethodPut, reactionsURL+"meh", bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestConcurrentPostReactions(t *testing.T) {
+	// Arrange: A post and users reacting to it at the same time, each twice
+	client := testServer.Client()
+	_, authorToken := signupWithRole(t, client, "concreact", domain.RoleUser)
+	postId := createPostWithBearer(t, client, authorToken, "Popular post")
+	const users = 10
+	tokens := make([]string, users)
+	for i := range tokens {
+		_, tokens[i] = signupWithRole(t, client, fmt.Sprintf("concreact%d", i), domain.RoleUser)
+	}
+	reactionURL := fmt.Sprintf("%s%s/%d/reactions/like", testServerURL, postsEndpoint, postId)
+
+	// Act
+	var wg sync.WaitGroup
+	for _, token := range append(tokens, tokens...) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := doWithBearer(t, client, http.MethodPut, reactionURL, token, nil)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		}()
+	}
+	wg.Wait()
+
+	// Assert: Each user is counted once
+	post := getPostWithBearer(t, client, authorToken, postId)
+	assert.Equal(t, map[string]int64{"like": users}, *post.ReactionCounts)
+	assert.Empty(t, *post.MyReactions)
+}
+
+func TestCommentReactionFlow(t *testing.T) {
+	// Arrange: Alice comments on her post, Bob blocks Carol
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicecmtreact", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobcmtreact", domain.RoleUser)
+	_, carolToken := signupWithRole(t, client, "carolcmtreact", domain.RoleUser)
+	postId := createPostWithBearer(t, client, aliceToken, "Post with a comment")
+	commentsURL := fmt.Sprintf("%s%s/%d/comments", testServerURL, postsEndpoint, postId)
+	resp := doWithBearer(t, client, http.MethodPost, commentsURL, aliceToken, &apitypes.CreateCommentRequest{Content: "First!"})
+	var created apitypes.CreateCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	resp.Body.Close()
+	commentId := *created.Data.Id
+
+	// Act: Bob laughs at the comment
+	resp = doWithBearer(t, client, http.MethodPut, fmt.Sprintf("%s/%d/reactions/laugh", commentsURL, commentId), bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Assert: The reaction shows on the comment
+	resp = doWithBearer(t, client, http.MethodGet, fmt.Sprintf("%s/%d", commentsURL, commentId), bobToken, nil)
+	var comment apitypes.GetCommentSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&comment))
+	resp.Body.Close()
+	assert.Equal(t, map[string]int64{"laugh": 1}, *comment.Data.ReactionCounts)
+	assert.Equal(t, []string{"laugh"}, *comment.Data.MyReactions)
+
+	// Assert: The comment is not found under another post
+	otherPostId := createPostWithBearer(t, client, aliceToken, "Another post")
+	resp = doWithBearer(t, client, http.MethodPut, fmt.Sprintf("%s%s/%d/comments/%d/reactions/laugh", testServerURL, postsEndpoint, otherPostId, commentId), bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Assert: Users blocked by the author cannot react
+	resp = doWithBearer(t, client, http.MethodPost, testServerURL+"/api/v1/users/"+currentUsername(t, client, carolToken)+"/block", aliceToken, nil)
+	resp.Body.Close()
+	resp = doWithBearer(t, client, http.MethodPut, fmt.Sprintf("%s/%d/reactions/like", commentsURL, commentId), carolToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
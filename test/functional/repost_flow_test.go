@@ -0,0 +1,84 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepostFlow(t *testing.T) {
+	// Arrange: Bob follows Alice, who posts
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicerepost", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobrepost", domain.RoleUser)
+	resp := doWithBearer(t, client, http.MethodPost, testServerURL+"/api/v1/users/"+currentUsername(t, client, aliceToken)+"/follow", bobToken, nil)
+	resp.Body.Close()
+	originalId := createPostWithBearer(t, client, aliceToken, "Worth sharing")
+	repostURL := fmt.Sprintf("%s%s/%d/repost", testServerURL, postsEndpoint, originalId)
+
+	// Act: Bob reposts the post
+	resp = doWithBearer(t, client, http.MethodPost, repostURL, bobToken, nil)
+	var created apitypes.CreatePostSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	resp.Body.Close()
+
+	// Assert: The repost embeds the post
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, apitypes.PostKind("repost"), *created.Data.Kind)
+	assert.Equal(t, originalId, *created.Data.ReferencedPost.Id)
+	assert.Equal(t, "Worth sharing", *created.Data.ReferencedPost.Content)
+	assert.False(t, *created.Data.ReferencedPost.Deleted)
+	repostId := *created.Data.Id
+
+	// Assert: A post is reposted once per user
+	resp = doWithBearer(t, client, http.MethodPost, repostURL, bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	// Act: Alice quotes her post
+	resp = doWithBearer(t, client, http.MethodPost, testServerURL+postsEndpoint, aliceToken, &apitypes.CreatePostRequest{Content: "Still true", QuotedPostId: &originalId})
+	var quote apitypes.CreatePostSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&quote))
+	resp.Body.Close()
+
+	// Assert: The quote post embeds the post
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, apitypes.PostKind("quote"), *quote.Data.Kind)
+	assert.Equal(t, originalId, *quote.Data.ReferencedPost.Id)
+
+	// Act: Alice deletes the post
+	resp = doWithBearer(t, client, http.MethodDelete, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, originalId), aliceToken, nil)
+	resp.Body.Close()
+
+	// Assert: The repost and the quote post show a tombstone, on their own and in the feed
+	repost := getPostWithBearer(t, client, bobToken, repostId)
+	assert.Equal(t, originalId, *repost.ReferencedPost.Id)
+	assert.True(t, *repost.ReferencedPost.Deleted)
+	assert.Nil(t, repost.ReferencedPost.Content)
+	resp = doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/feed/home", bobToken, nil)
+	var feed apitypes.HomeFeedSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&feed))
+	resp.Body.Close()
+	assert.Len(t, feed.Data, 2)
+	for _, post := range feed.Data {
+		assert.True(t, *post.ReferencedPost.Deleted)
+	}
+
+	// Act: Bob undoes his repost
+	resp = doWithBearer(t, client, http.MethodDelete, repostURL, bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Assert: The repost is gone, and cannot be undone twice
+	resp = doWithBearer(t, client, http.MethodGet, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, repostId), bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doWithBearer(t, client, http.MethodDelete, repostURL, bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
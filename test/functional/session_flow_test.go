@@ -0,0 +1,129 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// loginAndGetCookies logs in an existing user and returns the session cookies
+func loginAndGetCookies(t *testing.T, client *http.Client, email, password, userAgent string) []*http.Cookie {
+	body, err := json.Marshal(map[string]any{"data": &domain.LoginUserDTO{Email: email, Password: password}})
+	assert.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, testServerURL+loginEndpoint, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Expected status 200 OK for login")
+
+	return resp.Cookies()
+}
+
+func doWithCookies(t *testing.T, client *http.Client, method, url string, cookies []*http.Cookie) *http.Response {
+	req, err := http.NewRequest(method, url, nil)
+	assert.NoError(t, err)
+	addAuthCookies(req, cookies)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestSessions(t *testing.T) {
+	// Arrange: Sign up (first session) and log in from a second device
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Session", LastName: "User",
+			Email:    fmt.Sprintf("session.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("sessionuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, firstDeviceCookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+	secondDeviceCookies := loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "second-device")
+	thirdDeviceCookies := loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "third-device")
+
+	// Act: List sessions from the second device
+	listResp := doWithCookies(t, client, http.MethodGet, testServerURL+sessionsEndpoint, secondDeviceCookies)
+	defer listResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, listResp.StatusCode)
+	var listData apitypes.ListSessionsSuccessResponse
+	assert.NoError(t, json.NewDecoder(listResp.Body).Decode(&listData))
+	assert.Len(t, listData.Data, 3, "Expected one session per device")
+
+	var currentSession, firstDeviceSession *apitypes.Session
+	for i := range listData.Data {
+		session := &listData.Data[i]
+		if session.Current {
+			currentSession = session
+		} else if session.UserAgent != "second-device" && session.UserAgent != "third-device" {
+			firstDeviceSession = session
+		}
+	}
+	if assert.NotNil(t, currentSession, "Expected the calling session to be flagged as current") {
+		assert.Equal(t, "second-device", currentSession.UserAgent)
+		assert.NotEmpty(t, currentSession.IpAddress)
+	}
+
+	// Act: Revoke the first device's session
+	if assert.NotNil(t, firstDeviceSession) {
+		revokeResp := doWithCookies(t, client, http.MethodDelete, fmt.Sprintf("%s%s/%s", testServerURL, sessionsEndpoint, firstDeviceSession.Id), secondDeviceCookies)
+		defer revokeResp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, revokeResp.StatusCode)
+	}
+
+	// Assert: The revoked device is rejected immediately, even with an unexpired access token
+	profileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", firstDeviceCookies)
+	defer profileResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, profileResp.StatusCode)
+
+	// Act: Log out everywhere else from the second device
+	revokeOthersResp := doWithCookies(t, client, http.MethodDelete, testServerURL+sessionsEndpoint, secondDeviceCookies)
+	defer revokeOthersResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, revokeOthersResp.StatusCode)
+
+	// Assert: Third device is logged out, the current one still works
+	thirdProfileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", thirdDeviceCookies)
+	defer thirdProfileResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, thirdProfileResp.StatusCode)
+
+	secondProfileResp := doWithCookies(t, client, http.MethodGet, testServerURL+"/api/v1/users", secondDeviceCookies)
+	defer secondProfileResp.Body.Close()
+	assert.Equal(t, http.StatusOK, secondProfileResp.StatusCode)
+}
+
+func TestRevokeSession_InvalidID(t *testing.T) {
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Session", LastName: "User",
+			Email:    fmt.Sprintf("session.invalid%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("sessioninvalid%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	resp := doWithCookies(t, client, http.MethodDelete, testServerURL+sessionsEndpoint+"/not-a-uuid", cookies)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = doWithCookies(t, client, http.MethodDelete, testServerURL+sessionsEndpoint+"/00000000-0000-0000-0000-000000000000", cookies)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
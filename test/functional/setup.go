@@ -7,12 +7,23 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/floroz/go-social/cmd/api"
+	"github.com/floroz/go-social/cmd/middlewares"
 	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/blobstore"
+	"github.com/floroz/go-social/internal/cursor"
 	"github.com/floroz/go-social/internal/domain"
 	"github.com/floroz/go-social/internal/env"
+	"github.com/floroz/go-social/internal/interfaces"
+	"github.com/floroz/go-social/internal/jwtkeys"
+	"github.com/floroz/go-social/internal/mailer"
 	"github.com/floroz/go-social/internal/repositories"
 	"github.com/floroz/go-social/internal/services"
 	"github.com/golang-migrate/migrate/v4"
@@ -23,12 +34,48 @@ import (
 )
 
 const (
-	signupEndpoint  = "/api/v1/auth/signup"
-	loginEndpoint   = "/api/v1/auth/login"
-	logoutEndpoint  = "/api/v1/auth/logout"
-	healthzEndpoint = "/api/healthz"
+	signupEndpoint   = "/api/v1/auth/signup"
+	loginEndpoint    = "/api/v1/auth/login"
+	logoutEndpoint   = "/api/v1/auth/logout"
+	refreshEndpoint  = "/api/v1/auth/refresh"
+	sessionsEndpoint = "/api/v1/auth/sessions"
+	healthzEndpoint  = "/api/healthz"
+	jwksEndpoint     = "/api/.well-known/jwks.json"
+
+	forgotPasswordEndpoint     = "/api/v1/auth/password/forgot"
+	resetPasswordEndpoint      = "/api/v1/auth/password/reset"
+	verifyEmailEndpoint        = "/api/v1/auth/verify-email"
+	resendVerificationEndpoint = "/api/v1/auth/verify-email/resend"
+
+	mfaLoginEndpoint         = "/api/v1/auth/login/mfa"
+	twoFactorEndpoint        = "/api/v1/auth/2fa"
+	twoFactorEnrollEndpoint  = "/api/v1/auth/2fa/enroll"
+	twoFactorConfirmEndpoint = "/api/v1/auth/2fa/confirm"
+	twoFactorDisableEndpoint = "/api/v1/auth/2fa/disable"
+	recoveryCodesEndpoint    = "/api/v1/auth/2fa/recovery-codes"
+
+	oidcEndpoint = "/api/v1/auth/oidc"
+
+	personalAccessTokensEndpoint = "/api/v1/users/tokens"
+	changePasswordEndpoint       = "/api/v1/users/password"
+	changeEmailEndpoint          = "/api/v1/users/email"
+	confirmEmailChangeEndpoint   = "/api/v1/users/email/confirm"
+	deleteAccountEndpoint        = "/api/v1/users"
+
+	magicLinkEndpoint       = "/api/v1/auth/magic-link"
+	magicLinkVerifyEndpoint = "/api/v1/auth/magic-link/verify"
+
+	moderationLogEndpoint    = "/api/v1/admin/moderation-log"
+	impersonationLogEndpoint = "/api/v1/admin/impersonation-log"
+	impersonationsEndpoint   = "/api/v1/admin/impersonations"
 )
 
+// mailLogFile collects the emails sent by the test server, one JSON message per line.
+var mailLogFile = filepath.Join(os.TempDir(), fmt.Sprintf("go-social-mail-%d.jsonl", time.Now().UnixNano()))
+
+// blobStoreDir holds the files uploaded to the test server.
+var blobStoreDir = filepath.Join(os.TempDir(), fmt.Sprintf("go-social-blobs-%d", time.Now().UnixNano()))
+
 func runMigrations(db *sql.DB, migrationsDir string) error {
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
@@ -52,33 +99,123 @@ func runMigrations(db *sql.DB, migrationsDir string) error {
 func startTestAPIServer(db *sql.DB) *httptest.Server {
 	env.MustLoadEnv("../../.env.local")
 
+	app := newTestApplication(db, testApplicationOptions{})
+
+	testServer := httptest.NewServer(app.Routes())
+
+	log.Info().Msgf("Started test server on %s", testServer.URL)
+
+	return testServer
+}
+
+// testKeyring signs the access tokens of every test application, so that tokens issued by
+// one test server are accepted by the others.
+var testKeyring = mustNewTestKeyring()
+
+func mustNewTestKeyring() *jwtkeys.Keyring {
+	keyring, err := jwtkeys.NewEphemeralKeyring()
+	if err != nil {
+		panic(err)
+	}
+	return keyring
+}
+
+// testApplicationOptions overrides the policies of a test application
+type testApplicationOptions struct {
+	emailVerificationPolicy *domain.EmailVerificationPolicy
+	loginThrottlePolicy     *domain.LoginThrottlePolicy
+	accountDeletionPolicy   *domain.AccountDeletionPolicy
+	oidcProviders           []interfaces.OIDCProvider
+	magicLinkPolicy         *domain.MagicLinkPolicy
+}
+
+// newTestApplication wires the application against the test database
+func newTestApplication(db *sql.DB, options testApplicationOptions) *api.Application {
+	// Email verification is not enforced by default so that tests can act right after signup.
+	if options.emailVerificationPolicy == nil {
+		options.emailVerificationPolicy = domain.NewEmailVerificationPolicy()
+	}
+	// Every test logs in from the same IP, so the per-IP limit is raised to keep tests independent.
+	if options.loginThrottlePolicy == nil {
+		options.loginThrottlePolicy = domain.DefaultLoginThrottlePolicy()
+		options.loginThrottlePolicy.MaxIPFailures = 1000
+	}
+	if options.accountDeletionPolicy == nil {
+		options.accountDeletionPolicy = domain.DefaultAccountDeletionPolicy()
+	}
+	// Like the login limit, the per-IP limit is raised as every test requests from the same IP.
+	if options.magicLinkPolicy == nil {
+		options.magicLinkPolicy = domain.DefaultMagicLinkPolicy()
+		options.magicLinkPolicy.MaxPerIP = 1000
+	}
+
+	cursors := cursor.NewCodec([]byte("functional-test-cursor-key"))
+
 	userRepo := repositories.NewUserRepository(db)
-	userService := services.NewUserService(userRepo)
+	blockRepo := repositories.NewBlockRepository(db)
+	userService := services.NewUserService(userRepo, blockRepo, cursors)
+	moderationLogRepo := repositories.NewModerationLogRepository(db)
+	authorizer := services.NewAuthorizer(userRepo, moderationLogRepo)
 
+	reactionRepo := repositories.NewReactionRepository(db)
 	commentRepo := repositories.NewCommentRepository(db)
-	commentService := services.NewCommentService(commentRepo)
-
 	postRepo := repositories.NewPostRepository(db)
-	postService := services.NewPostService(postRepo, commentRepo)
+	commentService := services.NewCommentService(commentRepo, postRepo, blockRepo, reactionRepo, authorizer, cursors)
+
+	postService := services.NewPostService(postRepo, commentRepo, blockRepo, reactionRepo, authorizer, cursors)
+
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	ipLoginFailureRepo := repositories.NewIPLoginFailureRepository(db)
+	impersonationRepo := repositories.NewImpersonationSessionRepository(db)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, sessionRepo, impersonationRepo, ipLoginFailureRepo, options.loginThrottlePolicy, testKeyring)
 
-	authService := services.NewAuthService(userRepo)
+	testMailer := mailer.NewLogMailer(mailLogFile)
+	userTokenRepo := repositories.NewUserTokenRepository(db)
+	passwordResetService := services.NewPasswordResetService(userRepo, userTokenRepo, refreshTokenRepo, sessionRepo, testMailer)
+	emailVerificationService := services.NewEmailVerificationService(userRepo, userTokenRepo, testMailer, options.emailVerificationPolicy)
+	totpRepo := repositories.NewTOTPRepository(db)
+	recoveryCodeRepo := repositories.NewRecoveryCodeRepository(db)
+	twoFactorService := services.NewTwoFactorService(userRepo, totpRepo, recoveryCodeRepo, userTokenRepo, options.loginThrottlePolicy, "GoSocial")
+	personalAccessTokenRepo := repositories.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenService := services.NewPersonalAccessTokenService(userRepo, personalAccessTokenRepo)
+	adminService := services.NewAdminService(userRepo, sessionRepo, refreshTokenRepo, moderationLogRepo)
+	avatarService := services.NewAvatarService(userRepo, blobstore.NewLocalBlobStore(blobStoreDir), domain.DefaultAvatarPolicy(), "/api/v1/media/")
+	accountService := services.NewAccountService(userRepo, userTokenRepo, sessionRepo, personalAccessTokenRepo, testMailer, avatarService, options.loginThrottlePolicy, options.accountDeletionPolicy)
+	magicLinkService := services.NewMagicLinkService(userRepo, userTokenRepo, repositories.NewMagicLinkRequestRepository(db), testMailer, options.magicLinkPolicy)
+	oidcService := services.NewOIDCService(options.oidcProviders, userRepo, repositories.NewUserIdentityRepository(db), repositories.NewOIDCLoginStateRepository(db))
+	impersonationService := services.NewImpersonationService(userRepo, impersonationRepo, repositories.NewImpersonationAuditLogRepository(db), domain.DefaultImpersonationPolicy())
+	followService := services.NewFollowService(userRepo, repositories.NewFollowRepository(db), blockRepo, cursors)
+	blockService := services.NewBlockService(userRepo, blockRepo, repositories.NewMuteRepository(db))
+	reactionService := services.NewReactionService(reactionRepo, postRepo, commentRepo, blockRepo, domain.DefaultReactionSet())
+	feedService := services.NewFeedService(repositories.NewTimelineRepository(db), postRepo, blockRepo, reactionRepo, cursors)
+	tagService := services.NewTagService(repositories.NewTagRepository(db), postRepo, blockRepo, reactionRepo, cursors, domain.DefaultTagPolicy())
 
 	// Create the application instance (Config is not strictly needed by httptest)
 	// If your app initialization *requires* config, load it here.
 	// For now, assuming it's not critical for route setup.
-	app := &api.Application{
+	return &api.Application{
 		// Config:         &api.Config{}, // Pass empty or load if needed
-		UserService:    userService,
-		PostService:    postService,
-		CommentService: commentService,
-		AuthService:    authService,
+		UserService:                userService,
+		PostService:                postService,
+		CommentService:             commentService,
+		AuthService:                authService,
+		PasswordResetService:       passwordResetService,
+		EmailVerificationService:   emailVerificationService,
+		TwoFactorService:           twoFactorService,
+		PersonalAccessTokenService: personalAccessTokenService,
+		AdminService:               adminService,
+		AccountService:             accountService,
+		OIDCService:                oidcService,
+		MagicLinkService:           magicLinkService,
+		ImpersonationService:       impersonationService,
+		AvatarService:              avatarService,
+		FollowService:              followService,
+		BlockService:               blockService,
+		FeedService:                feedService,
+		TagService:                 tagService,
+		ReactionService:            reactionService,
 	}
-
-	testServer := httptest.NewServer(app.Routes())
-
-	log.Info().Msgf("Started test server on %s", testServer.URL)
-
-	return testServer
 }
 
 // signupAndGetCookies signs up a user and returns the user data (as apitypes.User) and cookies
@@ -108,9 +245,10 @@ func signupAndGetCookies(t *testing.T, client *http.Client, baseURL string, crea
 
 	assert.Equal(t, http.StatusCreated, resp.StatusCode, "Expected status 201 Created for signup")
 	assert.NotEmpty(t, resp.Cookies(), "Expected cookies to be set on signup")
-	if assert.Len(t, resp.Cookies(), 2, "Expected 2 cookies (access & refresh)") {
+	if assert.Len(t, resp.Cookies(), 3, "Expected 3 cookies (access, refresh & CSRF)") {
 		assert.Contains(t, cookieNames, "refresh_token", "Expected refresh_token cookie")
 		assert.Contains(t, cookieNames, "access_token", "Expected access_token cookie")
+		assert.Contains(t, cookieNames, domain.CSRFTokenCookie, "Expected csrf_token cookie")
 	}
 
 	// Decode into the API response structure which contains apitypes.User
@@ -123,3 +261,44 @@ func signupAndGetCookies(t *testing.T, client *http.Client, baseURL string, crea
 
 	return &signupResponse.Data, resp.Cookies()
 }
+
+// addAuthCookies adds the cookies to the request and echoes the CSRF token in its header, as the frontend does
+func addAuthCookies(req *http.Request, cookies []*http.Cookie) {
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+		if cookie.Name == domain.CSRFTokenCookie {
+			req.Header.Set(middlewares.CSRFHeader, cookie.Value)
+		}
+	}
+}
+
+// cookieNamed returns the cookie with the given name, or nil
+func cookieNamed(cookies []*http.Cookie, name string) *http.Cookie {
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+// emailTokenPattern extracts the token from links sent by email
+var emailTokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// lastEmailTo returns the most recent email the test server sent to the given address
+func lastEmailTo(t *testing.T, address string) *domain.EmailMessage {
+	content, err := os.ReadFile(mailLogFile)
+	if !assert.NoError(t, err, "Expected the mail log file to exist") {
+		return nil
+	}
+
+	var last *domain.EmailMessage
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var message domain.EmailMessage
+		if err := json.Unmarshal([]byte(line), &message); err == nil && message.To == address {
+			last = &message
+		}
+	}
+
+	return last
+}
@@ -0,0 +1,73 @@
+package integration_tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func listTagPostIds(t *testing.T, client *http.Client, token, tag string) []int64 {
+	resp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/tags/"+url.PathEscape(tag)+"/posts", token, nil)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var page apitypes.ListTagPostsSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	postIds := make([]int64, 0, len(page.Data))
+	for _, post := range page.Data {
+		postIds = append(postIds, *post.Id)
+	}
+	return postIds
+}
+
+func TestTagFlow(t *testing.T) {
+	// Arrange: Alice and Bob post about the same tags, in different cases and scripts
+	client := testServer.Client()
+	_, aliceToken := signupWithRole(t, client, "alicetags", domain.RoleUser)
+	_, bobToken := signupWithRole(t, client, "bobtags", domain.RoleUser)
+	first := createPostWithBearer(t, client, aliceToken, "Learning #TagFlowGo and #タグフロー")
+	second := createPostWithBearer(t, client, bobToken, "More #tagflowgo, #tagflowgo")
+	third := createPostWithBearer(t, client, aliceToken, "Nothing to see")
+
+	// Assert: The posts are found by their tags, newest first
+	assert.Equal(t, []int64{second, first}, listTagPostIds(t, client, bobToken, "TAGFLOWGO"))
+	assert.Equal(t, []int64{first}, listTagPostIds(t, client, bobToken, "#タグフロー"))
+
+	// Act: Alice moves a tag from her first post to her third
+	for _, edit := range []struct {
+		postId  int64
+		content string
+	}{
+		{first, "Learning #タグフロー"},
+		{third, "Now about #tagflowgo"},
+	} {
+		resp := doWithBearer(t, client, http.MethodPut, fmt.Sprintf("%s%s/%d", testServerURL, postsEndpoint, edit.postId), aliceToken, &apitypes.UpdatePostRequest{Content: edit.content})
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	// Assert: The tag links follow the edits
+	assert.Equal(t, []int64{third, second}, listTagPostIds(t, client, bobToken, "tagflowgo"))
+	assert.Equal(t, []int64{first}, listTagPostIds(t, client, bobToken, "タグフロー"))
+
+	// Assert: The tag trends with the number of posts using it
+	resp := doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/tags/trending?limit=100", bobToken, nil)
+	var trending apitypes.TrendingTagsSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&trending))
+	resp.Body.Close()
+	assert.Contains(t, trending.Data, apitypes.TrendingTag{Tag: "tagflowgo", PostCount: 2})
+	assert.Contains(t, trending.Data, apitypes.TrendingTag{Tag: "タグフロー", PostCount: 1})
+
+	// Assert: Posts of blocked users are left out, and invalid tags are rejected
+	resp = doWithBearer(t, client, http.MethodPost, testServerURL+"/api/v1/users/"+currentUsername(t, client, aliceToken)+"/block", bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, []int64{second}, listTagPostIds(t, client, bobToken, "tagflowgo"))
+	resp = doWithBearer(t, client, http.MethodGet, testServerURL+"/api/v1/tags/123/posts", bobToken, nil)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,146 @@
+package integration_tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/floroz/go-social/internal/apitypes"
+	"github.com/floroz/go-social/internal/domain"
+	"github.com/floroz/go-social/internal/totp"
+	"github.com/stretchr/testify/assert"
+)
+
+func postJSONWithCookies(t *testing.T, client *http.Client, url string, cookies []*http.Cookie, data any) *http.Response {
+	var body []byte
+	if data != nil {
+		var err error
+		body, err = json.Marshal(map[string]any{"data": data})
+		assert.NoError(t, err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	addAuthCookies(req, cookies)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+// loginForChallenge logs in a user with two-factor authentication and returns the challenge token.
+func loginForChallenge(t *testing.T, client *http.Client, email, password string) string {
+	resp := postJSON(t, client, testServerURL+loginEndpoint, &domain.LoginUserDTO{Email: email, Password: password})
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode, "Expected a two-factor challenge")
+	assert.Empty(t, resp.Cookies(), "Expected no session before the second factor")
+
+	var challengeResponse apitypes.MFAChallengeSuccessResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&challengeResponse))
+	assert.True(t, challengeResponse.Data.MfaRequired)
+
+	return challengeResponse.Data.ChallengeToken
+}
+
+func TestTwoFactorAuthentication(t *testing.T) {
+	// Arrange: Sign up a user
+	uniqueSuffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	createUserDTO := &domain.CreateUserDTO{
+		EditableUserField: domain.EditableUserField{
+			FirstName: "Totp", LastName: "User",
+			Email:    fmt.Sprintf("totp.user%s@example.com", uniqueSuffix),
+			Username: fmt.Sprintf("totpuser%s", uniqueSuffix),
+		},
+		Password: "password123",
+	}
+	client := testServer.Client()
+	_, cookies := signupAndGetCookies(t, client, testServerURL, createUserDTO)
+
+	// Act: Enroll and confirm with a first code
+	enrollResp := postJSONWithCookies(t, client, testServerURL+twoFactorEnrollEndpoint, cookies, nil)
+	defer enrollResp.Body.Close()
+	assert.Equal(t, http.StatusOK, enrollResp.StatusCode)
+
+	var enrollment apitypes.TOTPEnrollmentSuccessResponse
+	assert.NoError(t, json.NewDecoder(enrollResp.Body).Decode(&enrollment))
+	assert.Contains(t, enrollment.Data.OtpauthUri, "otpauth://totp/")
+	secret := enrollment.Data.Secret
+
+	step := totp.Step(time.Now())
+	code, err := totp.GenerateCode(secret, step)
+	assert.NoError(t, err)
+
+	confirmResp := postJSONWithCookies(t, client, testServerURL+twoFactorConfirmEndpoint, cookies, &domain.ConfirmTOTPDTO{Code: code})
+	defer confirmResp.Body.Close()
+	assert.Equal(t, http.StatusOK, confirmResp.StatusCode)
+
+	var recoveryCodes apitypes.RecoveryCodesSuccessResponse
+	assert.NoError(t, json.NewDecoder(confirmResp.Body).Decode(&recoveryCodes))
+	if !assert.Len(t, recoveryCodes.Data.RecoveryCodes, 10) {
+		return
+	}
+
+	// Assert: Logging in now requires the second factor
+	challengeToken := loginForChallenge(t, client, createUserDTO.Email, createUserDTO.Password)
+
+	// The code used for the confirmation cannot be replayed.
+	replayResp := postJSON(t, client, testServerURL+mfaLoginEndpoint, &domain.VerifyMFAChallengeDTO{ChallengeToken: challengeToken, Code: code})
+	replayResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, replayResp.StatusCode)
+
+	// The next code, still within the accepted clock drift, completes the login.
+	nextCode, err := totp.GenerateCode(secret, step+1)
+	assert.NoError(t, err)
+	mfaResp := postJSON(t, client, testServerURL+mfaLoginEndpoint, &domain.VerifyMFAChallengeDTO{ChallengeToken: challengeToken, Code: nextCode})
+	mfaResp.Body.Close()
+	assert.Equal(t, http.StatusOK, mfaResp.StatusCode)
+	assert.Len(t, mfaResp.Cookies(), 3, "Expected the auth cookies once the second factor is verified")
+
+	// The challenge is single-use.
+	reuseResp := postJSON(t, client, testServerURL+mfaLoginEndpoint, &domain.VerifyMFAChallengeDTO{ChallengeToken: challengeToken, Code: nextCode})
+	reuseResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, reuseResp.StatusCode)
+
+	// Assert: A recovery code works once
+	recoveryCode := recoveryCodes.Data.RecoveryCodes[0]
+	recoveryResp := postJSON(t, client, testServerURL+mfaLoginEndpoint, &domain.VerifyMFAChallengeDTO{
+		ChallengeToken: loginForChallenge(t, client, createUserDTO.Email, createUserDTO.Password),
+		Code:           recoveryCode,
+	})
+	recoveryResp.Body.Close()
+	assert.Equal(t, http.StatusOK, recoveryResp.StatusCode)
+
+	recoveryReuseResp := postJSON(t, client, testServerURL+mfaLoginEndpoint, &domain.VerifyMFAChallengeDTO{
+		ChallengeToken: loginForChallenge(t, client, createUserDTO.Email, createUserDTO.Password),
+		Code:           recoveryCode,
+	})
+	recoveryReuseResp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, recoveryReuseResp.StatusCode)
+
+	statusResp := doWithCookies(t, client, http.MethodGet, testServerURL+twoFactorEndpoint, cookies)
+	defer statusResp.Body.Close()
+	var status apitypes.TwoFactorStatusSuccessResponse
+	assert.NoError(t, json.NewDecoder(statusResp.Body).Decode(&status))
+	assert.True(t, status.Data.Enabled)
+	assert.Equal(t, 9, status.Data.RecoveryCodesRemaining)
+
+	// Act: Regenerating recovery codes and disabling require the password
+	wrongPasswordResp := postJSONWithCookies(t, client, testServerURL+recoveryCodesEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: "wrongpassword"})
+	wrongPasswordResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, wrongPasswordResp.StatusCode)
+
+	regenerateResp := postJSONWithCookies(t, client, testServerURL+recoveryCodesEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: createUserDTO.Password})
+	regenerateResp.Body.Close()
+	assert.Equal(t, http.StatusOK, regenerateResp.StatusCode)
+
+	disableResp := postJSONWithCookies(t, client, testServerURL+twoFactorDisableEndpoint, cookies, &domain.PasswordConfirmationDTO{Password: createUserDTO.Password})
+	disableResp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, disableResp.StatusCode)
+
+	// Assert: Login no longer asks for a second factor
+	loginAndGetCookies(t, client, createUserDTO.Email, createUserDTO.Password, "test-agent")
+}